@@ -1,13 +1,17 @@
 package main
 
 import (
+	"context"
 	"os"
 	"runtime/debug"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/app"
 	"github.com/krisch/crm-backend/internal/configs"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/pkg/postgres"
 
 	"github.com/sirupsen/logrus"
@@ -78,4 +82,119 @@ func main() {
 
 		time.Sleep(time.Second * 5)
 	}
+
+	if opt.STATISTIC_BACKFILL {
+		backfillStatistics(opt)
+	}
+
+	if opt.STORAGE_GC {
+		collectStorageGarbage(opt)
+	}
+
+	if opt.STORAGE_USAGE_RECOMPUTE {
+		recomputeStorageUsage(opt)
+	}
+}
+
+func backfillStatistics(opt *configs.Configs) {
+	logrus.Debug("backfilling statistic...")
+
+	var projectUUID *uuid.UUID
+	if opt.STATISTIC_BACKFILL_PROJECT != "" {
+		uid, err := uuid.Parse(opt.STATISTIC_BACKFILL_PROJECT)
+		if err != nil {
+			logrus.Error(err)
+			return
+		}
+
+		projectUUID = &uid
+	}
+
+	var from time.Time
+	to := time.Now().AddDate(0, 0, -1)
+
+	if opt.STATISTIC_BACKFILL_FROM != "" {
+		t, err := time.ParseInLocation(time.DateOnly, opt.STATISTIC_BACKFILL_FROM, time.Local)
+		if err != nil {
+			logrus.Error(err)
+			return
+		}
+
+		from = t
+	}
+
+	if opt.STATISTIC_BACKFILL_TO != "" {
+		t, err := time.ParseInLocation(time.DateOnly, opt.STATISTIC_BACKFILL_TO, time.Local)
+		if err != nil {
+			logrus.Error(err)
+			return
+		}
+
+		to = t
+	}
+
+	a, err := app.InitApp(helpers.FakeName(), opt.DB_CREDS, false, opt.REDIS_CREDS)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	n, err := a.StatisticsService.Backfill(context.Background(), projectUUID, from, to)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	logrus.Infof("statistic backfill done, %d snapshots", n)
+}
+
+func recomputeStorageUsage(opt *configs.Configs) {
+	logrus.Debug("recomputing storage usage...")
+
+	a, err := app.InitApp(helpers.FakeName(), opt.DB_CREDS, false, opt.REDIS_CREDS)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	n, err := a.S3PrivateService.RecomputeUsage(context.Background())
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	logrus.Infof("storage usage recomputed for %d federations", n)
+}
+
+func collectStorageGarbage(opt *configs.Configs) {
+	logrus.Debug("collecting storage garbage...")
+
+	a, err := app.InitApp(helpers.FakeName(), opt.DB_CREDS, false, opt.REDIS_CREDS)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	conf := s3.GCConf{
+		DryRun:    opt.STORAGE_GC_DRY_RUN,
+		Retention: time.Duration(opt.STORAGE_GC_RETENTION) * 24 * time.Hour,
+		Grace:     time.Duration(opt.STORAGE_GC_GRACE) * time.Hour,
+		Rate:      opt.STORAGE_GC_RATE,
+	}
+
+	report, err := a.S3PrivateService.CollectGarbage(context.Background(), conf)
+	report.Log(conf.DryRun)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	report, err = a.S3Service.CollectGarbage(context.Background(), conf)
+	report.Log(conf.DryRun)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	logrus.Info("storage garbage collected")
 }
@@ -55,6 +55,10 @@ func main() {
 
 	opt.Debug()
 
+	if err := opt.Validate(); err != nil {
+		log.Fatalf("invalid configs: %v", err)
+	}
+
 	if _, err := os.Stat("/tmp"); os.IsNotExist(err) {
 		err := os.Mkdir("/tmp", os.ModePerm)
 		logrus.Error(err)
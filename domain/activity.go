@@ -13,4 +13,7 @@ var (
 	ActivityTaskTeamArray      = ActivityType(6)
 	ActivityTaskWasDeleted     = ActivityType(8)
 	ActivityTaskFileWasDeleted = ActivityType(9)
+	ActivityTaskApprovalVote   = ActivityType(10)
+	ActivityTaskFromComment    = ActivityType(11)
+	ActivityTaskCommentToTask  = ActivityType(12)
 )
@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const AnalyticsMaxDays = 366
+
+// AnalyticsFilter selects tasks of the project for flow analytics. From and
+// To bound the period, tasks created after To are not counted.
+type AnalyticsFilter struct {
+	ProjectUUID uuid.UUID
+	Tag         *string
+	Priority    *int
+	ImplementBy *string
+	From        time.Time
+	To          time.Time
+}
+
+// StatusSegment is the time the task spent in one status, To is nil while
+// the task is still in it.
+type StatusSegment struct {
+	Status int
+	From   time.Time
+	To     *time.Time
+}
+
+// StatusHistory restores the statuses of the task from its Stops. A task is
+// created in StatusUnknown, a task without stops has been in its current
+// status since creation.
+func StatusHistory(t Task) []StatusSegment {
+	stops := append([]Stop{}, t.Stops...)
+	sort.SliceStable(stops, func(i, j int) bool {
+		return stops[i].CreatedAt.Before(stops[j].CreatedAt)
+	})
+
+	if len(stops) == 0 {
+		return []StatusSegment{{Status: t.Status, From: t.CreatedAt}}
+	}
+
+	segments := []StatusSegment{{Status: StatusUnknown, From: t.CreatedAt}}
+
+	for _, stop := range stops {
+		last := &segments[len(segments)-1]
+		if stop.StatusID == last.Status {
+			continue
+		}
+
+		at := stop.CreatedAt
+		last.To = &at
+		segments = append(segments, StatusSegment{Status: stop.StatusID, From: stop.CreatedAt})
+	}
+
+	return segments
+}
+
+// StatusAt returns the status of the task at the moment, false if the task
+// did not exist yet.
+func StatusAt(segments []StatusSegment, at time.Time) (int, bool) {
+	status, found := 0, false
+
+	for _, s := range segments {
+		if s.From.After(at) {
+			break
+		}
+
+		status, found = s.Status, true
+	}
+
+	return status, found
+}
+
+// Percentiles are durations in hours.
+type Percentiles struct {
+	Count int
+	Avg   float64
+	P50   float64
+	P75   float64
+	P85   float64
+	P95   float64
+}
+
+// NewPercentiles uses the nearest rank method.
+func NewPercentiles(hours []float64) Percentiles {
+	if len(hours) == 0 {
+		return Percentiles{}
+	}
+
+	sorted := append([]float64{}, hours...)
+	sort.Float64s(sorted)
+
+	rank := func(p float64) float64 {
+		i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		return roundHours(sorted[lo.Clamp(i, 0, len(sorted)-1)])
+	}
+
+	return Percentiles{
+		Count: len(sorted),
+		Avg:   roundHours(lo.Sum(sorted) / float64(len(sorted))),
+		P50:   rank(50),
+		P75:   rank(75),
+		P85:   rank(85),
+		P95:   rank(95),
+	}
+}
+
+type StatusTime struct {
+	Status   int
+	Tasks    int
+	Hours    float64
+	AvgHours float64
+}
+
+type ThroughputWeek struct {
+	Start time.Time
+	Done  int
+}
+
+// FlowDay is a point of the cumulative flow diagram: tasks by status at the
+// end of the day.
+type FlowDay struct {
+	Date     time.Time
+	ByStatus map[int]int
+}
+
+// AgingItem is an open task already taken into work. Age is counted from the
+// first move to StatusInWork, InStatus from the last status change.
+type AgingItem struct {
+	UUID        uuid.UUID
+	ID          int
+	Name        string
+	Status      int
+	ImplementBy string
+	StartedAt   time.Time
+	AgeHours    float64
+	InStatus    float64
+}
+
+type Analytics struct {
+	From  time.Time
+	To    time.Time
+	Tasks int
+
+	TimeInStatus []StatusTime
+	LeadTime     Percentiles
+	CycleTime    Percentiles
+	Throughput   []ThroughputWeek
+	Flow         []FlowDay
+	Aging        []AgingItem
+}
+
+// BuildAnalytics computes flow metrics of the tasks for the period. Lead time
+// is from creation to StatusDone, cycle time from the first move to
+// StatusInWork to StatusDone, both for tasks done inside the period.
+func BuildAnalytics(tasks []Task, from, to, now time.Time) Analytics {
+	if to.After(now) {
+		to = now
+	}
+
+	res := Analytics{
+		From:  from,
+		To:    to,
+		Tasks: len(tasks),
+	}
+
+	inStatus := make(map[int]*StatusTime)
+	lead := []float64{}
+	cycle := []float64{}
+	done := make(map[time.Time]int)
+	histories := make([][]StatusSegment, 0, len(tasks))
+
+	for _, t := range tasks {
+		segments := StatusHistory(t)
+		histories = append(histories, segments)
+
+		for _, s := range segments {
+			end := to
+			if s.To != nil && s.To.Before(to) {
+				end = *s.To
+			}
+
+			start := s.From
+			if start.Before(from) {
+				start = from
+			}
+
+			if !end.After(start) {
+				continue
+			}
+
+			st, ok := inStatus[s.Status]
+			if !ok {
+				st = &StatusTime{Status: s.Status}
+				inStatus[s.Status] = st
+			}
+
+			st.Tasks++
+			st.Hours += end.Sub(start).Hours()
+		}
+
+		last := segments[len(segments)-1]
+		started, isStarted := startedAt(segments)
+
+		if last.Status == StatusDone {
+			if last.From.Before(from) || last.From.After(to) {
+				continue
+			}
+
+			lead = append(lead, last.From.Sub(t.CreatedAt).Hours())
+			if isStarted {
+				cycle = append(cycle, last.From.Sub(started).Hours())
+			}
+
+			done[WeekStart(last.From.In(now.Location()))]++
+
+			continue
+		}
+
+		if isStarted && isWorkInProgress(last.Status) {
+			res.Aging = append(res.Aging, AgingItem{
+				UUID:        t.UUID,
+				ID:          t.ID,
+				Name:        t.Name,
+				Status:      last.Status,
+				ImplementBy: t.ImplementBy,
+				StartedAt:   started,
+				AgeHours:    roundHours(now.Sub(started).Hours()),
+				InStatus:    roundHours(now.Sub(last.From).Hours()),
+			})
+		}
+	}
+
+	res.TimeInStatus = make([]StatusTime, 0, len(inStatus))
+	for _, st := range inStatus {
+		st.AvgHours = roundHours(st.Hours / float64(st.Tasks))
+		st.Hours = roundHours(st.Hours)
+		res.TimeInStatus = append(res.TimeInStatus, *st)
+	}
+
+	sort.Slice(res.TimeInStatus, func(i, j int) bool {
+		return res.TimeInStatus[i].Status < res.TimeInStatus[j].Status
+	})
+
+	res.LeadTime = NewPercentiles(lead)
+	res.CycleTime = NewPercentiles(cycle)
+
+	for week := WeekStart(from.In(now.Location())); !week.After(to); week = week.AddDate(0, 0, 7) {
+		res.Throughput = append(res.Throughput, ThroughputWeek{Start: week, Done: done[week]})
+	}
+
+	y, m, d := from.In(now.Location()).Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, now.Location()); !day.After(to); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		if end.After(to) {
+			end = to
+		}
+
+		point := FlowDay{Date: day, ByStatus: make(map[int]int)}
+
+		for _, segments := range histories {
+			if status, ok := StatusAt(segments, end); ok {
+				point.ByStatus[status]++
+			}
+		}
+
+		res.Flow = append(res.Flow, point)
+	}
+
+	sort.Slice(res.Aging, func(i, j int) bool {
+		return res.Aging[i].AgeHours > res.Aging[j].AgeHours
+	})
+
+	return res
+}
+
+// startedAt returns the first move of the task to StatusInWork.
+func startedAt(segments []StatusSegment) (time.Time, bool) {
+	s, found := lo.Find(segments, func(s StatusSegment) bool {
+		return s.Status == StatusInWork
+	})
+
+	return s.From, found
+}
+
+func isWorkInProgress(status int) bool {
+	return lo.IndexOf([]int{StatusUnknown, StatusNew, StatusDone, StatusCancel}, status) == -1
+}
+
+func roundHours(h float64) float64 {
+	return math.Round(h*10) / 10
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatusHistory(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC).Add(time.Duration(h) * time.Hour)
+	}
+
+	task := Task{
+		CreatedAt: at(0),
+		Status:    StatusDone,
+		Stops: []Stop{
+			{CreatedAt: at(30), StatusID: StatusDone},
+			{CreatedAt: at(10), StatusID: StatusInWork},
+			{CreatedAt: at(20), StatusID: StatusInWork},
+		},
+	}
+
+	segments := StatusHistory(task)
+	if len(segments) != 3 || segments[1].Status != StatusInWork || !segments[1].To.Equal(at(30)) || segments[2].To != nil {
+		t.Fatalf("StatusHistory() = %+v", segments)
+	}
+
+	if status, ok := StatusAt(segments, at(15)); !ok || status != StatusInWork {
+		t.Errorf("StatusAt() = %v %v", status, ok)
+	}
+
+	if _, ok := StatusAt(segments, at(-1)); ok {
+		t.Error("StatusAt() before creation found")
+	}
+
+	if got := StatusHistory(Task{CreatedAt: at(0), Status: StatusHold}); len(got) != 1 || got[0].Status != StatusHold {
+		t.Errorf("StatusHistory() without stops = %+v", got)
+	}
+}
+
+func TestBuildAnalytics(t *testing.T) {
+	monday := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time {
+		return monday.Add(time.Duration(h) * time.Hour)
+	}
+
+	tasks := []Task{
+		{CreatedAt: at(0), Status: StatusDone, Stops: []Stop{
+			{CreatedAt: at(10), StatusID: StatusInWork},
+			{CreatedAt: at(20), StatusID: StatusDone},
+		}},
+		{CreatedAt: at(0), Status: StatusDone, Stops: []Stop{
+			{CreatedAt: at(100), StatusID: StatusInWork},
+			{CreatedAt: at(200), StatusID: StatusDone},
+		}},
+		{CreatedAt: at(0), Status: StatusNeedReview, Stops: []Stop{
+			{CreatedAt: at(5), StatusID: StatusInWork},
+			{CreatedAt: at(50), StatusID: StatusNeedReview},
+		}},
+		{CreatedAt: at(0), Status: StatusNew, Stops: []Stop{
+			{CreatedAt: at(1), StatusID: StatusNew},
+		}},
+	}
+
+	now := at(24 * 14)
+	a := BuildAnalytics(tasks, monday, now.AddDate(0, 0, 10), now)
+
+	if a.LeadTime.Count != 2 || a.LeadTime.P50 != 20 || a.LeadTime.P95 != 200 {
+		t.Errorf("lead time = %+v", a.LeadTime)
+	}
+
+	if a.CycleTime.Count != 2 || a.CycleTime.P50 != 10 || a.CycleTime.Avg != 55 {
+		t.Errorf("cycle time = %+v", a.CycleTime)
+	}
+
+	if len(a.Throughput) != 3 || a.Throughput[0].Done != 1 || a.Throughput[1].Done != 1 || a.Throughput[2].Done != 0 {
+		t.Errorf("throughput = %+v", a.Throughput)
+	}
+
+	if len(a.Flow) != 15 || a.Flow[0].ByStatus[StatusDone] != 1 || a.Flow[14].ByStatus[StatusDone] != 2 {
+		t.Errorf("flow = %+v", a.Flow)
+	}
+
+	if len(a.Aging) != 1 || a.Aging[0].AgeHours != float64(24*14-5) || a.Aging[0].InStatus != float64(24*14-50) {
+		t.Errorf("aging = %+v", a.Aging)
+	}
+
+	for _, st := range a.TimeInStatus {
+		if st.Status == StatusInWork && (st.Tasks != 3 || st.Hours != 10+100+45) {
+			t.Errorf("time in work = %+v", st)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	ApprovalRuleAny    = "any"
+	ApprovalRuleAll    = "all"
+	ApprovalRuleQuorum = "quorum"
+
+	ApprovalPending  = "pending"
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+	ApprovalCanceled = "canceled"
+
+	VoteApprove = "approve"
+	VoteReject  = "reject"
+)
+
+var (
+	ErrNotApprover    = errors.New("вы не участвуете в согласовании")
+	ErrApprovalClosed = errors.New("согласование завершено")
+	ErrApprovalActive = errors.New("задача на согласовании, завершить ее можно только голосованием")
+)
+
+func GetApprovalRules() []string {
+	return []string{ApprovalRuleAny, ApprovalRuleAll, ApprovalRuleQuorum}
+}
+
+// ApprovalPolicy describes who reviews tasks of the project moved to
+// StatusNeedReview and how many approvals are enough.
+type ApprovalPolicy struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Approvers []string
+	Groups    []uuid.UUID
+	Rule      string
+	Quorum    int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewApprovalPolicy(federationUUID, companyUUID, projectUUID uuid.UUID, me Me, approvers []string, groups []uuid.UUID, rule string, quorum int) (*ApprovalPolicy, error) {
+	p := &ApprovalPolicy{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		ProjectUUID:    projectUUID,
+		CreatedBy:      me.Email,
+		CreatedByUUID:  me.UUID,
+		Approvers: lo.Uniq(lo.Map(approvers, func(email string, _ int) string {
+			return strings.ToLower(strings.TrimSpace(email))
+		})),
+		Groups: lo.Uniq(groups),
+		Rule:   rule,
+		Quorum: quorum,
+	}
+
+	return p, p.Validate()
+}
+
+func (p ApprovalPolicy) Validate() error {
+	if lo.IndexOf(GetApprovalRules(), p.Rule) == -1 {
+		return errors.New("правило должно быть any, all или quorum")
+	}
+
+	if len(lo.WithoutEmpty(p.Approvers)) == 0 && len(p.Groups) == 0 {
+		return errors.New("не указаны согласующие")
+	}
+
+	if p.Rule == ApprovalRuleQuorum && p.Quorum < 1 {
+		return errors.New("кворум должен быть больше 0")
+	}
+
+	return nil
+}
+
+// Required returns the number of approvals needed out of total approvers.
+func (p ApprovalPolicy) Required(total int) int {
+	switch p.Rule {
+	case ApprovalRuleAll:
+		return total
+	case ApprovalRuleQuorum:
+		return min(p.Quorum, total)
+	}
+
+	return min(1, total)
+}
+
+// TaskApproval is one review round of a task, it starts when the task moves
+// to StatusNeedReview. Approvers are resolved from the policy at the start,
+// so later changes of the policy or groups do not affect the round.
+type TaskApproval struct {
+	UUID       uuid.UUID
+	TaskUUID   uuid.UUID
+	PolicyUUID uuid.UUID
+
+	Rule      string
+	Required  int
+	Approvers []string
+	Status    string
+	Votes     []ApprovalVote
+
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+type ApprovalVote struct {
+	UUID         uuid.UUID
+	ApprovalUUID uuid.UUID
+	TaskUUID     uuid.UUID
+	Email        string
+	UserUUID     uuid.UUID
+	Decision     string
+	Comment      string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewTaskApproval(taskUUID uuid.UUID, p ApprovalPolicy, approvers []string) (*TaskApproval, error) {
+	approvers = lo.WithoutEmpty(lo.Uniq(approvers))
+	if len(approvers) == 0 {
+		return nil, errors.New("нет согласующих с доступом к задаче")
+	}
+
+	return &TaskApproval{
+		UUID:       uuid.New(),
+		TaskUUID:   taskUUID,
+		PolicyUUID: p.UUID,
+		Rule:       p.Rule,
+		Required:   p.Required(len(approvers)),
+		Approvers:  approvers,
+		Status:     ApprovalPending,
+		Votes:      []ApprovalVote{},
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// Vote records the decision of the approver, a repeated vote replaces the
+// previous one while the round is pending. Rejection needs a comment.
+func (a *TaskApproval) Vote(me Me, decision, comment string) (vote ApprovalVote, err error) {
+	if a.Status != ApprovalPending {
+		return vote, ErrApprovalClosed
+	}
+
+	if lo.IndexOf(a.Approvers, me.Email) == -1 {
+		return vote, ErrNotApprover
+	}
+
+	if decision != VoteApprove && decision != VoteReject {
+		return vote, errors.New("решение должно быть approve или reject")
+	}
+
+	comment = strings.TrimSpace(comment)
+	if decision == VoteReject && comment == "" {
+		return vote, errors.New("при отклонении необходимо указать причину")
+	}
+
+	now := time.Now()
+
+	_, i, found := lo.FindIndexOf(a.Votes, func(v ApprovalVote) bool {
+		return v.Email == me.Email
+	})
+
+	if found {
+		vote = a.Votes[i]
+	} else {
+		vote = ApprovalVote{
+			UUID:         uuid.New(),
+			ApprovalUUID: a.UUID,
+			TaskUUID:     a.TaskUUID,
+			Email:        me.Email,
+			UserUUID:     me.UUID,
+			CreatedAt:    now,
+		}
+	}
+
+	vote.Decision = decision
+	vote.Comment = comment
+	vote.UpdatedAt = now
+
+	if found {
+		a.Votes[i] = vote
+	} else {
+		a.Votes = append(a.Votes, vote)
+	}
+
+	a.Status = a.Decide()
+	if a.Status != ApprovalPending {
+		a.ResolvedAt = &now
+	}
+
+	return vote, nil
+}
+
+// Decide returns approved once the required number of approvals is reached
+// and rejected once it can not be reached anymore.
+func (a TaskApproval) Decide() string {
+	approves := 0
+	rejects := 0
+
+	for _, v := range a.Votes {
+		switch v.Decision {
+		case VoteApprove:
+			approves++
+		case VoteReject:
+			rejects++
+		}
+	}
+
+	if approves >= a.Required {
+		return ApprovalApproved
+	}
+
+	if rejects > len(a.Approvers)-a.Required {
+		return ApprovalRejected
+	}
+
+	return ApprovalPending
+}
+
+// Pending returns approvers who have not voted yet.
+func (a TaskApproval) Pending() []string {
+	voted := lo.Map(a.Votes, func(v ApprovalVote, _ int) string {
+		return v.Email
+	})
+
+	return lo.Without(a.Approvers, voted...)
+}
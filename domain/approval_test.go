@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestApprovalDecide(t *testing.T) {
+	approvers := []string{"a@a.ru", "b@b.ru", "c@c.ru"}
+
+	tests := []struct {
+		name   string
+		rule   string
+		quorum int
+		votes  []string
+		want   string
+	}{
+		{"any approve", ApprovalRuleAny, 0, []string{VoteApprove}, ApprovalApproved},
+		{"any one reject", ApprovalRuleAny, 0, []string{VoteReject}, ApprovalPending},
+		{"any all reject", ApprovalRuleAny, 0, []string{VoteReject, VoteReject, VoteReject}, ApprovalRejected},
+		{"all one reject", ApprovalRuleAll, 0, []string{VoteApprove, VoteReject}, ApprovalRejected},
+		{"all approve", ApprovalRuleAll, 0, []string{VoteApprove, VoteApprove, VoteApprove}, ApprovalApproved},
+		{"2 of 3 pending", ApprovalRuleQuorum, 2, []string{VoteApprove, VoteReject}, ApprovalPending},
+		{"2 of 3 approve", ApprovalRuleQuorum, 2, []string{VoteApprove, VoteReject, VoteApprove}, ApprovalApproved},
+		{"2 of 3 reject", ApprovalRuleQuorum, 2, []string{VoteReject, VoteReject}, ApprovalRejected},
+		{"quorum above total", ApprovalRuleQuorum, 5, []string{VoteApprove, VoteApprove, VoteApprove}, ApprovalApproved},
+	}
+
+	for _, tt := range tests {
+		p, err := NewApprovalPolicy(uuid.New(), uuid.New(), uuid.New(), Me{}, approvers, nil, tt.rule, tt.quorum)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		a, err := NewTaskApproval(uuid.New(), *p, approvers)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		for i, decision := range tt.votes {
+			_, err = a.Vote(Me{Email: approvers[i]}, decision, "причина")
+			if err != nil {
+				t.Fatalf("%s: vote %d: %v", tt.name, i, err)
+			}
+		}
+
+		if a.Status != tt.want {
+			t.Errorf("%s: status = %s, want %s", tt.name, a.Status, tt.want)
+		}
+
+		if (a.ResolvedAt != nil) != (tt.want != ApprovalPending) {
+			t.Errorf("%s: resolved at = %v", tt.name, a.ResolvedAt)
+		}
+	}
+}
+
+func TestApprovalVote(t *testing.T) {
+	p, _ := NewApprovalPolicy(uuid.New(), uuid.New(), uuid.New(), Me{}, []string{" A@a.ru "}, nil, ApprovalRuleAll, 0)
+	if p.Approvers[0] != "a@a.ru" {
+		t.Errorf("approvers = %v", p.Approvers)
+	}
+
+	a, _ := NewTaskApproval(uuid.New(), *p, []string{"a@a.ru", "b@b.ru"})
+
+	if _, err := a.Vote(Me{Email: "c@c.ru"}, VoteApprove, ""); !errors.Is(err, ErrNotApprover) {
+		t.Errorf("vote of stranger = %v", err)
+	}
+
+	if _, err := a.Vote(Me{Email: "a@a.ru"}, VoteReject, " "); err == nil {
+		t.Error("reject without comment accepted")
+	}
+
+	first, _ := a.Vote(Me{Email: "a@a.ru"}, VoteApprove, "")
+	second, _ := a.Vote(Me{Email: "a@a.ru"}, VoteApprove, "ok")
+
+	if len(a.Votes) != 1 || first.UUID != second.UUID || a.Votes[0].Comment != "ok" {
+		t.Errorf("repeated vote = %+v", a.Votes)
+	}
+
+	if pending := a.Pending(); len(pending) != 1 || pending[0] != "b@b.ru" {
+		t.Errorf("pending = %v", pending)
+	}
+
+	_, _ = a.Vote(Me{Email: "b@b.ru"}, VoteApprove, "")
+	if _, err := a.Vote(Me{Email: "b@b.ru"}, VoteReject, "поздно"); !errors.Is(err, ErrApprovalClosed) {
+		t.Errorf("vote after approval = %v", err)
+	}
+
+	if _, err := NewApprovalPolicy(uuid.New(), uuid.New(), uuid.New(), Me{}, nil, nil, ApprovalRuleAny, 0); err == nil {
+		t.Error("policy without approvers accepted")
+	}
+
+	if _, err := NewApprovalPolicy(uuid.New(), uuid.New(), uuid.New(), Me{}, []string{"a@a.ru"}, nil, ApprovalRuleQuorum, 0); err == nil {
+		t.Error("quorum 0 accepted")
+	}
+}
@@ -2,10 +2,13 @@ package domain
 
 import (
 	"errors"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
 )
 
 type Comment struct {
@@ -21,7 +24,9 @@ type Comment struct {
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	EditedAt  *time.Time
 	DeletedAt *time.Time
+	DeletedBy *string
 
 	Files []File
 
@@ -31,6 +36,13 @@ type Comment struct {
 	Likes     map[string]int64
 	UserLikes []UserLike
 
+	Reactions     Reactions
+	UserReactions []Reaction
+
+	Poll *Poll
+
+	ConvertedTaskUUID *uuid.UUID
+
 	Pin bool
 
 	Meta map[string]interface{}
@@ -53,6 +65,8 @@ func NewComment(createdBy string, taskUUID, replyUUID uuid.UUID, emails []string
 
 		Likes:     make(map[string]int64),
 		UserLikes: []UserLike{},
+
+		Reactions: make(Reactions),
 	}
 
 	errs, ok := helpers.ValidationStruct(comment)
@@ -62,3 +76,46 @@ func NewComment(createdBy string, taskUUID, replyUUID uuid.UUID, emails []string
 
 	return comment
 }
+
+// TaskNameLimit is the max length of the task name, see Task.Name.
+const TaskNameLimit = 100
+
+// TaskName is the name of the task created from the comment: the first
+// non-empty line of the text cut to TaskNameLimit.
+func (c Comment) TaskName() string {
+	for _, line := range strings.Split(c.Comment, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		runes := []rune(line)
+		if len(runes) > TaskNameLimit {
+			return strings.TrimSpace(string(runes[:TaskNameLimit-1])) + "…"
+		}
+
+		return line
+	}
+
+	return ""
+}
+
+// Mentioned returns the sorted emails of the people mentioned in the comment.
+func (c Comment) Mentioned() []string {
+	emails := lo.Keys(c.People)
+	sort.Strings(emails)
+
+	return emails
+}
+
+// CommentRevision is a version of the comment text. The first revision is
+// the original text, it is stored on the first edit.
+type CommentRevision struct {
+	UUID        uuid.UUID
+	CommentUUID uuid.UUID
+	TaskUUID    uuid.UUID
+	Version     int
+	Comment     string
+	EditedBy    string
+	CreatedAt   time.Time
+}
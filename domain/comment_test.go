@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCommentTaskName(t *testing.T) {
+	long := strings.Repeat("я", TaskNameLimit+10)
+
+	tests := []struct {
+		name    string
+		comment string
+		want    string
+	}{
+		{"single line", "Починить экспорт", "Починить экспорт"},
+		{"first non-empty line", "\n  \n  Починить экспорт  \nподробности", "Починить экспорт"},
+		{"long line is cut", long, strings.Repeat("я", TaskNameLimit-1) + "…"},
+		{"empty", " \n ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Comment{Comment: tt.comment}.TaskName()
+			if got != tt.want {
+				t.Errorf("TaskName() = %q, want %q", got, tt.want)
+			}
+
+			if l := len([]rune(got)); l > TaskNameLimit {
+				t.Errorf("TaskName() length = %d", l)
+			}
+		})
+	}
+}
+
+func TestCommentMentioned(t *testing.T) {
+	c := Comment{People: map[string]int64{"b@a.ru": 1, "a@a.ru": 2}}
+
+	if got := c.Mentioned(); !reflect.DeepEqual(got, []string{"a@a.ru", "b@a.ru"}) {
+		t.Errorf("Mentioned() = %v", got)
+	}
+
+	if got := (Comment{}).Mentioned(); len(got) != 0 {
+		t.Errorf("Mentioned() of empty = %v", got)
+	}
+}
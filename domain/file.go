@@ -1,11 +1,27 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Uploaded files stay pending until the antivirus checks them, only clean
+// files are given out.
+const (
+	FileScanPending  = "pending"
+	FileScanClean    = "clean"
+	FileScanInfected = "infected"
+)
+
+var (
+	ErrFileScanPending = errors.New("файл проверяется антивирусом")
+	ErrFileInfected    = errors.New("файл заблокирован: обнаружен вирус")
+
+	ErrStorageQuotaExceeded = errors.New("превышена квота хранилища федерации")
+)
+
 type File struct {
 	Name string    `json:"name"`
 	Ext  string    `json:"ext"`
@@ -13,6 +29,49 @@ type File struct {
 	UUID uuid.UUID `json:"uuid"`
 	URL  string    `json:"url"`
 
+	PreviewURL string `json:"preview_url"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	DurationMs int64  `json:"duration_ms"`
+
+	ScanStatus string `json:"scan_status"`
+	SHA256     string `json:"sha256"`
+
 	CreatedAt time.Time `json:"created_at"`
 	CreatedBy uuid.UUID `json:"created_by"`
+
+	Version int  `json:"version"`
+	Current bool `json:"current"`
+}
+
+// FileSearchHit is the file found by its text, the snippet marks the matched
+// words with <mark>.
+type FileSearchHit struct {
+	File File
+
+	TaskUUID uuid.UUID
+	TaskID   int
+	TaskName string
+	Snippet  string
+}
+
+// StorageUsage is the stored bytes of the federation against its quota with
+// the breakdown by projects and mime types. Zero quota is unlimited.
+type StorageUsage struct {
+	FederationUUID uuid.UUID
+	Quota          int64
+	Bytes          int64
+	Files          int
+
+	Projects []StorageUsageItem
+	Types    []StorageUsageItem
+}
+
+type StorageUsageItem struct {
+	CompanyUUID uuid.UUID
+	ProjectUUID uuid.UUID
+	MimeType    string
+
+	Bytes int64
+	Files int
 }
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InboundMapping maps an incoming JSON document to task attributes. Every
+// value is a JSONPath-like expression ("$.order.id", "$.items[0].name"), a
+// template with {{ }} placeholders ("Заказ #{{$.order.id}}") or a constant.
+type InboundMapping struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Tags        string            `json:"tags"`
+	Priority    string            `json:"priority"`
+	Fields      map[string]string `json:"fields"`
+	DedupeKey   string            `json:"dedupe_key"`
+}
+
+type InboundWebhook struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Name     string `validate:"lte=100,gte=3"  ru:"название"`
+	Token    string
+	Mapping  InboundMapping
+	IsActive bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func NewInboundWebhook(federationUUID, companyUUID, projectUUID uuid.UUID, me Me, name string, mapping InboundMapping) *InboundWebhook {
+	return &InboundWebhook{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		ProjectUUID:    projectUUID,
+		CreatedBy:      me.Email,
+		CreatedByUUID:  me.UUID,
+		Name:           name,
+		Token:          NewInboundToken(),
+		Mapping:        mapping,
+		IsActive:       true,
+	}
+}
+
+func NewInboundToken() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// InboundTask is the result of applying InboundMapping to a payload.
+type InboundTask struct {
+	Name        string
+	Description string
+	Tags        []string
+	Priority    *int
+	Fields      map[string]interface{}
+	DedupeKey   string
+}
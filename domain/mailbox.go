@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Mailbox is an IMAP inbox polled for new mail. New threads become tasks in
+// the project, replies become comments. Domain is the IMAP server host:port,
+// port 993 is dialed with TLS, any other port is upgraded with STARTTLS.
+type Mailbox struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Email    string `validate:"email"  ru:"email"`
+	Password string `validate:"required"  ru:"пароль"`
+	Domain   string `validate:"hostname_port"  ru:"сервер"`
+	IsActive bool
+
+	UIDValidity   uint32
+	LastUID       uint32
+	LastFetchedAt *time.Time
+	LastError     string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func NewMailbox(federationUUID, companyUUID, projectUUID uuid.UUID, me Me, email, password, domain string) *Mailbox {
+	return &Mailbox{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		ProjectUUID:    projectUUID,
+		CreatedBy:      me.Email,
+		CreatedByUUID:  me.UUID,
+		Email:          strings.ToLower(strings.TrimSpace(email)),
+		Password:       password,
+		Domain:         strings.TrimSpace(domain),
+		IsActive:       true,
+	}
+}
+
+// MailMessage is a parsed incoming email.
+type MailMessage struct {
+	UID        uint32
+	MessageID  string
+	InReplyTo  []string
+	Sender     string
+	SenderName string
+	Subject    string
+	BodyText   string
+	ReceivedAt time.Time
+
+	Attachments []MailAttachment
+}
+
+type MailAttachment struct {
+	Name    string
+	Content []byte
+}
+
+// BodyHash identifies the message when the sender did not set Message-ID.
+func (m MailMessage) BodyHash() string {
+	h := sha256.Sum256([]byte(m.Sender + "\n" + m.Subject + "\n" + m.BodyText))
+
+	return hex.EncodeToString(h[:])
+}
+
+var (
+	mailTaskTag    = regexp.MustCompile(`\[#(\d+)\]`)
+	mailSubjectPre = regexp.MustCompile(`(?i)^\s*((re|fw|fwd|ответ|отв|пересл)(\[\d+\])?\s*:\s*)+`)
+	mailQuoteStart = regexp.MustCompile(`(?i)^(-+\s*(original message|исходное сообщение|forwarded message|пересылаемое сообщение)\s*-+|on .+ wrote:|.+ (написал|пишет)\(?а?\)?:)$`)
+)
+
+// MailTaskID returns the task id from the "[#123]" subject tag.
+func MailTaskID(subject string) (int, bool) {
+	m := mailTaskTag.FindStringSubmatch(subject)
+	if m == nil {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// MailSubject strips reply prefixes and task tags from the subject.
+func MailSubject(subject string) string {
+	subject = mailTaskTag.ReplaceAllString(subject, "")
+	subject = mailSubjectPre.ReplaceAllString(subject, "")
+
+	return strings.Join(strings.Fields(subject), " ")
+}
+
+// MailReplyText cuts the quoted previous message from the reply body.
+func MailReplyText(body string) string {
+	lines := strings.Split(body, "\n")
+
+	res := make([]string, 0, len(lines))
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if mailQuoteStart.MatchString(trimmed) {
+			break
+		}
+
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+
+		res = append(res, line)
+	}
+
+	return strings.TrimSpace(strings.Join(res, "\n"))
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "testing"
+
+func TestMailSubject(t *testing.T) {
+	tests := map[string]string{
+		"Заказ":                     "Заказ",
+		"Re: Заказ":                 "Заказ",
+		"RE: Fwd:  Заказ  [#15]":    "Заказ",
+		"Ответ: [#15] Заказ":        "Заказ",
+		"Re[2]: Re: Заказ по счету": "Заказ по счету",
+		"Отчет: итоги":              "Отчет: итоги",
+	}
+
+	for subject, want := range tests {
+		if got := MailSubject(subject); got != want {
+			t.Errorf("MailSubject(%q) = %q, want %q", subject, got, want)
+		}
+	}
+
+	if id, ok := MailTaskID("Re: [#15] Заказ"); !ok || id != 15 {
+		t.Errorf("MailTaskID() = %v %v", id, ok)
+	}
+
+	if _, ok := MailTaskID("Заказ #15"); ok {
+		t.Error("MailTaskID() found id without tag")
+	}
+}
+
+func TestMailReplyText(t *testing.T) {
+	body := "Спасибо, принято\n\nOn Mon, 3 Jun 2024 at 10:00, Ivan <ivan@example.org> wrote:\n> Заказ готов"
+	if got := MailReplyText(body); got != "Спасибо, принято" {
+		t.Errorf("MailReplyText() = %q", got)
+	}
+
+	body = "Да\n> вопрос\nи еще\n\n-----Original Message-----\nFrom: a@a.ru"
+	if got := MailReplyText(body); got != "Да\nи еще" {
+		t.Errorf("MailReplyText() = %q", got)
+	}
+}
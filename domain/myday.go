@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MyDayTasksLimit = 100
+	MyDayLimit      = 10
+)
+
+// MyDay is the home screen of the user: open tasks with a deadline up to the
+// end of the day, reminders of the day, unread notifications by kind, recent
+// mentions and recently opened tasks.
+type MyDay struct {
+	Date time.Time
+
+	Overdue   []Task
+	DueToday  []Task
+	Reminders []Reminder
+
+	Notifications      map[string]int
+	NotificationsTotal int
+
+	Mentions       []Mention
+	RecentlyOpened []OpenedTask
+}
+
+type Mention struct {
+	CommentUUID uuid.UUID
+	TaskUUID    uuid.UUID
+	TaskName    string
+	Comment     string
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+// OpenedTask is a task with the time the user first opened it.
+type OpenedTask struct {
+	UUID     uuid.UUID
+	ID       int
+	Name     string
+	Status   int
+	OpenedAt time.Time
+}
+
+// SplitDueTasks splits tasks with a deadline into overdue at the moment and
+// due later today, both sorted by deadline.
+func SplitDueTasks(tasks []Task, now time.Time) (overdue, today []Task) {
+	overdue, today = []Task{}, []Task{}
+
+	y, m, d := now.Date()
+	end := time.Date(y, m, d+1, 0, 0, 0, 0, now.Location())
+
+	for _, t := range tasks {
+		switch {
+		case t.FinishTo == nil || !t.FinishTo.Before(end):
+		case t.FinishTo.Before(now):
+			overdue = append(overdue, t)
+		default:
+			today = append(today, t)
+		}
+	}
+
+	for _, items := range [][]Task{overdue, today} {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].FinishTo.Before(*items[j].FinishTo)
+		})
+	}
+
+	return overdue, today
+}
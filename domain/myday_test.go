@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplitDueTasks(t *testing.T) {
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+	at := func(h int) *time.Time {
+		v := now.Add(time.Duration(h) * time.Hour)
+		return &v
+	}
+
+	tasks := []Task{
+		{ID: 1, FinishTo: at(5)},
+		{ID: 2, FinishTo: at(-30)},
+		{ID: 3, FinishTo: at(12)},
+		{ID: 4},
+		{ID: 5, FinishTo: at(-1)},
+		{ID: 6, FinishTo: at(1)},
+	}
+
+	overdue, today := SplitDueTasks(tasks, now)
+
+	if len(overdue) != 2 || overdue[0].ID != 2 || overdue[1].ID != 5 {
+		t.Errorf("overdue = %+v", overdue)
+	}
+
+	if len(today) != 2 || today[0].ID != 6 || today[1].ID != 1 {
+		t.Errorf("today = %+v", today)
+	}
+}
@@ -39,6 +39,8 @@ type PermissionRules struct {
 	TaskCreate           bool `json:"task_create"`
 	TaskDelete           bool `json:"task_delete"`
 	TaskPatch            bool `json:"task_patch"`
+	CommentAudit         bool `json:"comment_audit"`
+	WebhookManage        bool `json:"webhook_manage"`
 }
 
 func (j *PermissionRules) Scan(value interface{}) error {
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	PollOptionsMin      = 2
+	PollOptionsMax      = 10
+	PollOptionMaxLength = 200
+)
+
+var (
+	ErrPollClosed        = errors.New("голосование завершено")
+	ErrPollInvalidOption = errors.New("вариант ответа не найден")
+	ErrPollSingleChoice  = errors.New("можно выбрать только один вариант")
+)
+
+// Poll is attached to a comment, the text of the comment is the question.
+// Votes are stored apart and are loaded with the comment.
+type Poll struct {
+	Options  []PollOption `json:"options"`
+	Multiple bool         `json:"multiple"`
+	ClosesAt *time.Time   `json:"closes_at,omitempty"`
+
+	Votes []PollVote `json:"-"`
+}
+
+type PollOption struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+type PollVote struct {
+	Email     string
+	Options   []int
+	CreatedAt time.Time
+}
+
+func NewPoll(options []string, multiple bool, closesAt *time.Time, now time.Time) (*Poll, error) {
+	options = lo.Map(options, func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	})
+
+	if len(options) < PollOptionsMin || len(options) > PollOptionsMax {
+		return nil, errors.New("в голосовании должно быть от 2 до 10 вариантов")
+	}
+
+	for _, o := range options {
+		if o == "" || len([]rune(o)) > PollOptionMaxLength {
+			return nil, errors.New("вариант ответа должен быть от 1 до 200 символов")
+		}
+	}
+
+	if len(lo.Uniq(options)) != len(options) {
+		return nil, errors.New("варианты ответа повторяются")
+	}
+
+	if closesAt != nil && !closesAt.After(now) {
+		return nil, errors.New("время завершения голосования уже прошло")
+	}
+
+	return &Poll{
+		Options: lo.Map(options, func(item string, i int) PollOption {
+			return PollOption{ID: i + 1, Text: item}
+		}),
+		Multiple: multiple,
+		ClosesAt: closesAt,
+	}, nil
+}
+
+func (p Poll) Closed(now time.Time) bool {
+	return p.ClosesAt != nil && !p.ClosesAt.After(now)
+}
+
+// CheckVote validates the options chosen by the user, no options withdraws
+// the vote.
+func (p Poll) CheckVote(options []int, now time.Time) ([]int, error) {
+	if p.Closed(now) {
+		return nil, ErrPollClosed
+	}
+
+	options = lo.Uniq(options)
+
+	if !p.Multiple && len(options) > 1 {
+		return nil, ErrPollSingleChoice
+	}
+
+	for _, id := range options {
+		if !lo.ContainsBy(p.Options, func(o PollOption) bool { return o.ID == id }) {
+			return nil, ErrPollInvalidOption
+		}
+	}
+
+	return options, nil
+}
+
+// Results counts votes by option id.
+func (p Poll) Results() map[int]int {
+	res := make(map[int]int, len(p.Options))
+	for _, v := range p.Votes {
+		for _, id := range v.Options {
+			res[id]++
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReactionsToggle(t *testing.T) {
+	r := make(Reactions)
+
+	if added, err := r.Toggle("👍", "a@a.ru", 1); !added || err != nil {
+		t.Fatalf("Toggle() = %v %v", added, err)
+	}
+
+	r.Toggle("🎉", "a@a.ru", 2)
+	r.Toggle("🎉", "b@a.ru", 3)
+
+	if emojis := r.Emojis(); len(emojis) != 2 || emojis[0] != "🎉" {
+		t.Errorf("Emojis() = %v", emojis)
+	}
+
+	if added, _ := r.Toggle("👍", "a@a.ru", 4); added || len(r) != 1 {
+		t.Errorf("Toggle() again = %v %v", added, r)
+	}
+
+	for _, emoji := range []string{"", "ok", "👍 ", "1"} {
+		if _, err := r.Toggle(emoji, "a@a.ru", 5); err == nil {
+			t.Errorf("Toggle(%q) accepted", emoji)
+		}
+	}
+
+	if !ValidReaction("👨‍👩‍👧") {
+		t.Error("ValidReaction() rejects joined emoji")
+	}
+}
+
+func TestPollCheckVote(t *testing.T) {
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+	closes := now.Add(time.Hour)
+
+	if _, err := NewPoll([]string{"да"}, false, nil, now); err == nil {
+		t.Error("NewPoll() with one option")
+	}
+
+	if _, err := NewPoll([]string{"да", " да "}, false, nil, now); err == nil {
+		t.Error("NewPoll() with duplicates")
+	}
+
+	p, err := NewPoll([]string{"да", "нет", "не знаю"}, false, &closes, now)
+	if err != nil || p.Options[2].ID != 3 {
+		t.Fatalf("NewPoll() = %+v %v", p, err)
+	}
+
+	if _, err := p.CheckVote([]int{1, 2}, now); err != ErrPollSingleChoice {
+		t.Errorf("CheckVote() multiple = %v", err)
+	}
+
+	if _, err := p.CheckVote([]int{4}, now); err != ErrPollInvalidOption {
+		t.Errorf("CheckVote() unknown = %v", err)
+	}
+
+	if got, err := p.CheckVote([]int{2, 2}, now); err != nil || len(got) != 1 {
+		t.Errorf("CheckVote() = %v %v", got, err)
+	}
+
+	if _, err := p.CheckVote([]int{1}, closes); err != ErrPollClosed {
+		t.Errorf("CheckVote() closed = %v", err)
+	}
+
+	p.Votes = []PollVote{{Options: []int{1}}, {Options: []int{1}}, {Options: []int{3}}}
+	if res := p.Results(); res[1] != 2 || res[3] != 1 || res[2] != 0 {
+		t.Errorf("Results() = %v", res)
+	}
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProgressVelocityDays = 14
+	ProgressMaxDays      = AnalyticsMaxDays
+)
+
+// EpicProgress is rolled up over the leaf tasks of the subtree, tasks with
+// children of their own are containers and are not counted. Canceled tasks
+// are out of scope. Weights come from the WeightField of the task fields or
+// are 1 when no field is given.
+type EpicProgress struct {
+	UUID        uuid.UUID
+	WeightField string
+
+	Total       int
+	Done        int
+	Unestimated int
+
+	Weight     float64
+	DoneWeight float64
+	Percent    float64
+
+	Burndown []BurndownDay
+	Velocity float64
+	Forecast *time.Time
+}
+
+// BurndownDay is the weight left at the end of the day.
+type BurndownDay struct {
+	Date      time.Time
+	Remaining float64
+}
+
+// TaskWeight returns the numeric value of the field, false if the task has
+// no estimate.
+func TaskWeight(t Task, field string) (float64, bool) {
+	if field == "" {
+		return 1, true
+	}
+
+	switch v := t.Fields[field].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// BuildEpicProgress computes progress of the epic from its descendants.
+// Velocity is the weight done per day over the last ProgressVelocityDays,
+// the forecast divides the remaining weight by it.
+func BuildEpicProgress(epic Task, descendants []Task, field string, now time.Time) EpicProgress {
+	res := EpicProgress{
+		UUID:        epic.UUID,
+		WeightField: field,
+	}
+
+	parents := make(map[string]bool)
+	for _, t := range descendants {
+		for _, p := range t.Path {
+			if p != t.UUID.String() {
+				parents[p] = true
+			}
+		}
+	}
+
+	type leaf struct {
+		weight   float64
+		segments []StatusSegment
+	}
+
+	leaves := []leaf{}
+	start := epic.CreatedAt
+	velocityFrom := now.AddDate(0, 0, -ProgressVelocityDays)
+	var lastDone *time.Time
+	doneRecently := 0.0
+
+	for _, t := range descendants {
+		if parents[t.UUID.String()] || t.Status == StatusCancel {
+			continue
+		}
+
+		weight, ok := TaskWeight(t, field)
+		if !ok {
+			res.Unestimated++
+		}
+
+		segments := StatusHistory(t)
+		leaves = append(leaves, leaf{weight: weight, segments: segments})
+
+		res.Total++
+		res.Weight += weight
+
+		if t.CreatedAt.Before(start) {
+			start = t.CreatedAt
+		}
+
+		last := segments[len(segments)-1]
+		if last.Status != StatusDone {
+			continue
+		}
+
+		res.Done++
+		res.DoneWeight += weight
+
+		if last.From.After(velocityFrom) {
+			doneRecently += weight
+		}
+
+		if lastDone == nil || last.From.After(*lastDone) {
+			at := last.From
+			lastDone = &at
+		}
+	}
+
+	if res.Weight > 0 {
+		res.Percent = math.Round(res.DoneWeight/res.Weight*1000) / 10
+	}
+
+	y, m, d := start.In(now.Location()).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+
+	if first := now.AddDate(0, 0, -ProgressMaxDays); day.Before(first) {
+		y, m, d = first.Date()
+		day = time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	}
+
+	for ; !day.After(now); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		if end.After(now) {
+			end = now
+		}
+
+		point := BurndownDay{Date: day}
+
+		for _, l := range leaves {
+			status, ok := StatusAt(l.segments, end)
+			if ok && status != StatusDone {
+				point.Remaining += l.weight
+			}
+		}
+
+		res.Burndown = append(res.Burndown, point)
+	}
+
+	res.Velocity = math.Round(doneRecently/ProgressVelocityDays*100) / 100
+	remaining := res.Weight - res.DoneWeight
+
+	switch {
+	case res.Total > 0 && remaining <= 0:
+		res.Forecast = lastDone
+	case doneRecently > 0:
+		days := remaining / (doneRecently / ProgressVelocityDays)
+		forecast := now.Add(time.Duration(days * float64(24*time.Hour)))
+		res.Forecast = &forecast
+	}
+
+	return res
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildEpicProgress(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	epic := Task{UUID: uuid.New(), CreatedAt: now.AddDate(0, 0, -10)}
+	story := uuid.New()
+
+	task := func(status int, points interface{}, doneDaysAgo int, path ...uuid.UUID) Task {
+		t := Task{
+			UUID:      uuid.New(),
+			Status:    status,
+			CreatedAt: now.AddDate(0, 0, -10),
+			Fields:    map[string]interface{}{},
+		}
+
+		if points != nil {
+			t.Fields["sp"] = points
+		}
+
+		if status == StatusDone {
+			t.Stops = []Stop{{CreatedAt: now.AddDate(0, 0, -doneDaysAgo), StatusID: StatusDone}}
+		}
+
+		for _, p := range path {
+			t.Path = append(t.Path, p.String())
+		}
+
+		t.Path = append(t.Path, t.UUID.String())
+
+		return t
+	}
+
+	descendants := []Task{
+		{UUID: story, Path: []string{epic.UUID.String(), story.String()}, CreatedAt: epic.CreatedAt},
+		task(StatusDone, 3.0, 7, epic.UUID, story),
+		task(StatusDone, "2", 3, epic.UUID, story),
+		task(StatusInWork, 5.0, 0, epic.UUID),
+		task(StatusNew, nil, 0, epic.UUID),
+		task(StatusCancel, 8.0, 0, epic.UUID),
+	}
+
+	p := BuildEpicProgress(epic, descendants, "sp", now)
+
+	if p.Total != 4 || p.Done != 2 || p.Unestimated != 1 || p.Weight != 10 || p.DoneWeight != 5 || p.Percent != 50 {
+		t.Errorf("progress = %+v", p)
+	}
+
+	if len(p.Burndown) != 11 || p.Burndown[0].Remaining != 10 || p.Burndown[10].Remaining != 5 {
+		t.Errorf("burndown = %+v", p.Burndown)
+	}
+
+	if p.Forecast == nil || !p.Forecast.Equal(now.AddDate(0, 0, 14)) {
+		t.Errorf("forecast = %v, velocity = %v", p.Forecast, p.Velocity)
+	}
+
+	if p = BuildEpicProgress(epic, descendants, "", now); p.Weight != 4 || p.Unestimated != 0 {
+		t.Errorf("progress without field = %+v", p)
+	}
+}
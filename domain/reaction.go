@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	ReactionMaxLength = 32
+	ReactionsMax      = 20
+)
+
+var (
+	ErrInvalidReaction  = errors.New("реакция должна быть эмодзи")
+	ErrTooManyReactions = errors.New("слишком много разных реакций")
+)
+
+// Reactions of a comment are unix micro times of reacting users by emoji.
+type Reactions map[string]map[string]int64
+
+// Reaction is one emoji of a comment with the users who chose it.
+type Reaction struct {
+	Emoji string
+	Users []UserLike
+}
+
+// ValidReaction accepts a short string without letters, digits and spaces,
+// emoji with modifiers and joiners are several runes.
+func ValidReaction(emoji string) bool {
+	if emoji == "" || len(emoji) > ReactionMaxLength || !utf8.ValidString(emoji) {
+		return false
+	}
+
+	for _, r := range emoji {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Toggle adds the reaction of the user or removes it when it is already
+// set, true if added.
+func (r Reactions) Toggle(emoji, email string, at int64) (bool, error) {
+	if !ValidReaction(emoji) {
+		return false, ErrInvalidReaction
+	}
+
+	users, ok := r[emoji]
+	if !ok {
+		if len(r) >= ReactionsMax {
+			return false, ErrTooManyReactions
+		}
+
+		users = make(map[string]int64)
+		r[emoji] = users
+	}
+
+	if _, ok := users[email]; ok {
+		delete(users, email)
+		if len(users) == 0 {
+			delete(r, emoji)
+		}
+
+		return false, nil
+	}
+
+	users[email] = at
+
+	return true, nil
+}
+
+// Emojis returns the emojis by the number of users, the first used first.
+func (r Reactions) Emojis() []string {
+	first := func(users map[string]int64) int64 {
+		var at int64
+		for _, v := range users {
+			if at == 0 || v < at {
+				at = v
+			}
+		}
+
+		return at
+	}
+
+	emojis := make([]string, 0, len(r))
+	for emoji := range r {
+		emojis = append(emojis, emoji)
+	}
+
+	sort.Slice(emojis, func(i, j int) bool {
+		a, b := r[emojis[i]], r[emojis[j]]
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+
+		return first(a) < first(b)
+	})
+
+	return emojis
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ProjectStatisticSnapshot is the project counters at the end of the day.
+// FieldStatistics is nil for days rebuilt by the backfill, values of task
+// fields are not versioned.
+type ProjectStatisticSnapshot struct {
+	ProjectUUID uuid.UUID
+	Day         time.Time
+
+	TasksTotal         int
+	TasksActiveTotal   int
+	TasksFinishedTotal int
+	TasksCanceledTotal int
+	TasksDeletedTotal  int
+
+	FieldStatistics []FieldStatistic
+}
+
+type FieldStatistic struct {
+	Hash   string  `json:"hash"`
+	Name   string  `json:"name"`
+	Count  int     `json:"count"`
+	Total  int     `json:"total"`
+	Filled float64 `json:"filled"`
+}
+
+// Day truncates the time to the start of its day.
+func Day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// BuildStatisticHistory restores daily counters of the project from the
+// dates of the tasks, the same rules as the current project statistic: an
+// active task is not deleted and not on hold, done or canceled. The status of
+// a day is taken from the task Stops.
+func BuildStatisticHistory(projectUUID uuid.UUID, tasks []Task, from, to time.Time) []ProjectStatisticSnapshot {
+	histories := lo.Map(tasks, func(t Task, _ int) []StatusSegment {
+		return StatusHistory(t)
+	})
+
+	items := []ProjectStatisticSnapshot{}
+
+	for day := Day(from); !day.After(to); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		s := ProjectStatisticSnapshot{ProjectUUID: projectUUID, Day: day}
+
+		for i, t := range tasks {
+			if !t.CreatedAt.Before(end) {
+				continue
+			}
+
+			s.TasksTotal++
+
+			if t.DeletedAt != nil && t.DeletedAt.Before(end) {
+				s.TasksDeletedTotal++
+				continue
+			}
+
+			if t.FinishedAt != nil && t.FinishedAt.Before(end) {
+				s.TasksFinishedTotal++
+			}
+
+			status, _ := StatusAt(histories[i], end)
+
+			switch status {
+			case StatusCancel:
+				s.TasksCanceledTotal++
+			case StatusHold, StatusDone:
+			default:
+				s.TasksActiveTotal++
+			}
+		}
+
+		items = append(items, s)
+	}
+
+	return items
+}
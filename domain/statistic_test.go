@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildStatisticHistory(t *testing.T) {
+	day := func(d, h int) time.Time {
+		return time.Date(2024, 6, d, h, 0, 0, 0, time.UTC)
+	}
+
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tasks := []Task{
+		{CreatedAt: day(1, 10), Status: StatusInWork},
+		{CreatedAt: day(1, 12), Status: StatusDone, FinishedAt: ptr(day(2, 15)), Stops: []Stop{
+			{CreatedAt: day(1, 13), StatusID: StatusInWork},
+			{CreatedAt: day(2, 15), StatusID: StatusDone},
+		}},
+		{CreatedAt: day(2, 9), Status: StatusCancel, Stops: []Stop{{CreatedAt: day(3, 9), StatusID: StatusCancel}}},
+		{CreatedAt: day(2, 9), Status: StatusNew, DeletedAt: ptr(day(3, 18))},
+	}
+
+	items := BuildStatisticHistory(uuid.New(), tasks, day(1, 23), day(3, 1))
+
+	want := [][5]int{
+		// total, active, finished, canceled, deleted
+		{2, 2, 0, 0, 0},
+		{4, 3, 1, 0, 0},
+		{4, 1, 1, 1, 1},
+	}
+
+	if len(items) != len(want) {
+		t.Fatalf("len = %d", len(items))
+	}
+
+	for i, w := range want {
+		s := items[i]
+		got := [5]int{s.TasksTotal, s.TasksActiveTotal, s.TasksFinishedTotal, s.TasksCanceledTotal, s.TasksDeletedTotal}
+
+		if got != w || !s.Day.Equal(day(i+1, 0)) {
+			t.Errorf("%s: got %v, want %v", s.Day, got, w)
+		}
+	}
+}
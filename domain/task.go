@@ -55,6 +55,10 @@ type Task struct {
 
 	People []string
 
+	Visibility   string
+	AccessUsers  []string
+	AccessGroups []uuid.UUID
+
 	Tags        []string
 	CompanyTags []Tag
 
@@ -80,14 +84,18 @@ type Task struct {
 
 	Stops []Stop
 
+	StartAt    *time.Time
 	FinishTo   *time.Time
 	FinishedAt *time.Time
+	Duration   int
 
 	FirstOpen map[string]time.Time
 
 	ChildrensTotal int
 	ChildrensUUID  []uuid.UUID
 
+	SourceCommentUUID *uuid.UUID
+
 	Activities      []Activity
 	ActivitiesTotal int64
 
@@ -186,6 +194,8 @@ func NewTask(
 
 		People: allPeople,
 
+		Visibility: TaskVisibilityProject,
+
 		Priority: priority,
 
 		FinishTo: finishTo,
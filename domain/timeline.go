@@ -0,0 +1,342 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrDependencyCycle = errors.New("зависимость образует цикл")
+
+type TaskDependency struct {
+	TaskUUID      uuid.UUID
+	DependsOnUUID uuid.UUID
+	ProjectUUID   uuid.UUID
+	CreatedBy     string
+	CreatedAt     time.Time
+}
+
+func NewTaskDependency(task, dependsOn Task, createdBy string) (dm TaskDependency, err error) {
+	if task.UUID == dependsOn.UUID {
+		return dm, errors.New("задача не может зависеть от самой себя")
+	}
+
+	if task.ProjectUUID != dependsOn.ProjectUUID {
+		return dm, errors.New("задачи должны быть в одном проекте")
+	}
+
+	return TaskDependency{
+		TaskUUID:      task.UUID,
+		DependsOnUUID: dependsOn.UUID,
+		ProjectUUID:   task.ProjectUUID,
+		CreatedBy:     createdBy,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// TimelineItem is a task on the gantt chart. Start and Finish are the planned
+// dates of the task itself, SpanStart and SpanFinish are rolled up over the
+// whole subtree.
+type TimelineItem struct {
+	UUID       uuid.UUID
+	ID         int
+	Name       string
+	ParentUUID *uuid.UUID
+	Path       []string
+	IsEpic     bool
+	Status     int
+
+	Start    *time.Time
+	Finish   *time.Time
+	Duration int
+
+	SpanStart  *time.Time
+	SpanFinish *time.Time
+
+	DependsOn []uuid.UUID
+}
+
+// IsWorkday reports whether the day is not a saturday or sunday.
+func IsWorkday(t time.Time) bool {
+	wd := t.Weekday()
+	return wd != time.Saturday && wd != time.Sunday
+}
+
+// ToWorkday moves a weekend date forward to the next monday.
+func ToWorkday(t time.Time) time.Time {
+	for !IsWorkday(t) {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t
+}
+
+// AddWorkdays shifts the date by n working days, skipping weekends.
+func AddWorkdays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if IsWorkday(t) {
+			n--
+		}
+	}
+
+	return t
+}
+
+// WorkdaysBetween counts working days from start up to finish.
+func WorkdaysBetween(start, finish time.Time) (n int) {
+	for start.Before(finish) {
+		start = start.AddDate(0, 0, 1)
+		if IsWorkday(start) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// PlannedDates returns the planned start and finish of the task. A missing
+// date is derived from the other one and the duration in working days.
+func (t Task) PlannedDates() (start, finish *time.Time) {
+	start, finish = t.StartAt, t.FinishTo
+
+	if t.Duration > 0 && start != nil && finish == nil {
+		f := AddWorkdays(*start, t.Duration)
+		finish = &f
+	}
+
+	if t.Duration > 0 && start == nil && finish != nil {
+		s := AddWorkdays(*finish, -t.Duration)
+		start = &s
+	}
+
+	return start, finish
+}
+
+func (t *Task) PatchSchedule(startAt, finishTo *time.Time, duration *int) error {
+	if startAt != nil {
+		t.SafeDirty("start_at", t.StartAt)
+		t.StartAt = startAt
+	}
+
+	if finishTo != nil {
+		t.SafeDirty("finish_to", t.FinishTo)
+		t.FinishTo = finishTo
+	}
+
+	if duration != nil {
+		if *duration < 0 || *duration > 3650 {
+			return errors.New("длительность должна быть от 0 до 3650 дней")
+		}
+
+		t.SafeDirty("duration", t.Duration)
+		t.Duration = *duration
+	}
+
+	if t.StartAt != nil && t.FinishTo != nil && t.FinishTo.Before(*t.StartAt) {
+		return errors.New("дата завершения раньше даты начала")
+	}
+
+	return nil
+}
+
+// BuildTimeline turns tasks of a project into gantt items ordered as given.
+func BuildTimeline(tasks []Task, deps []TaskDependency) []TimelineItem {
+	dependsOn := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range deps {
+		dependsOn[d.TaskUUID] = append(dependsOn[d.TaskUUID], d.DependsOnUUID)
+	}
+
+	items := make([]TimelineItem, 0, len(tasks))
+	index := make(map[string]int, len(tasks))
+
+	for _, t := range tasks {
+		start, finish := t.PlannedDates()
+
+		item := TimelineItem{
+			UUID:       t.UUID,
+			ID:         t.ID,
+			Name:       t.Name,
+			Path:       t.Path,
+			IsEpic:     t.IsEpic,
+			Status:     t.Status,
+			Start:      start,
+			Finish:     finish,
+			Duration:   t.Duration,
+			SpanStart:  start,
+			SpanFinish: finish,
+			DependsOn:  dependsOn[t.UUID],
+		}
+
+		if len(t.Path) >= 2 {
+			parent, err := uuid.Parse(t.Path[len(t.Path)-2])
+			if err == nil {
+				item.ParentUUID = &parent
+			}
+		}
+
+		if item.DependsOn == nil {
+			item.DependsOn = []uuid.UUID{}
+		}
+
+		index[t.UUID.String()] = len(items)
+		items = append(items, item)
+	}
+
+	// every task widens the span of all its ancestors
+	for _, item := range items {
+		for _, ancestor := range item.Path[:max(len(item.Path)-1, 0)] {
+			i, ok := index[ancestor]
+			if !ok {
+				continue
+			}
+
+			items[i].SpanStart = minTime(items[i].SpanStart, item.Start)
+			items[i].SpanFinish = maxTime(items[i].SpanFinish, item.Finish)
+		}
+	}
+
+	return items
+}
+
+// Reschedule moves successors of the changed task forward so that none of them
+// starts before all of its predecessors are finished. Weekends are skipped and
+// the length of every moved task in working days is kept. Tasks without a
+// start date are not scheduled. Returns moved tasks.
+func Reschedule(tasks map[uuid.UUID]*Task, deps []TaskDependency, changed uuid.UUID) (moved []uuid.UUID, err error) {
+	successors := make(map[uuid.UUID][]uuid.UUID)
+	predecessors := make(map[uuid.UUID][]uuid.UUID)
+
+	for _, d := range deps {
+		successors[d.DependsOnUUID] = append(successors[d.DependsOnUUID], d.TaskUUID)
+		predecessors[d.TaskUUID] = append(predecessors[d.TaskUUID], d.DependsOnUUID)
+	}
+
+	order, err := topoOrder(changed, successors)
+	if err != nil {
+		return moved, err
+	}
+
+	for _, uid := range order[1:] {
+		t, ok := tasks[uid]
+		if !ok || t.StartAt == nil {
+			continue
+		}
+
+		var earliest *time.Time
+		for _, p := range predecessors[uid] {
+			pt, ok := tasks[p]
+			if !ok {
+				continue
+			}
+
+			_, finish := pt.PlannedDates()
+			earliest = maxTime(earliest, finish)
+		}
+
+		if earliest == nil {
+			continue
+		}
+
+		start := ToWorkday(*earliest)
+		if !t.StartAt.Before(start) {
+			continue
+		}
+
+		length := t.Duration
+		if length == 0 && t.FinishTo != nil {
+			length = WorkdaysBetween(*t.StartAt, *t.FinishTo)
+		}
+
+		t.StartAt = &start
+		if t.FinishTo != nil || t.Duration > 0 {
+			finish := AddWorkdays(start, length)
+			t.FinishTo = &finish
+		}
+
+		moved = append(moved, uid)
+	}
+
+	return moved, nil
+}
+
+// CheckDependency returns ErrDependencyCycle when task → dependsOn would close
+// a cycle with existing dependencies.
+func CheckDependency(deps []TaskDependency, taskUUID, dependsOnUUID uuid.UUID) error {
+	successors := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range deps {
+		successors[d.DependsOnUUID] = append(successors[d.DependsOnUUID], d.TaskUUID)
+	}
+
+	successors[dependsOnUUID] = append(successors[dependsOnUUID], taskUUID)
+
+	_, err := topoOrder(dependsOnUUID, successors)
+
+	return err
+}
+
+// topoOrder returns the tasks reachable from root in topological order,
+// root first.
+func topoOrder(root uuid.UUID, successors map[uuid.UUID][]uuid.UUID) ([]uuid.UUID, error) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+
+	state := make(map[uuid.UUID]int)
+	order := []uuid.UUID{}
+
+	var visit func(uid uuid.UUID) error
+	visit = func(uid uuid.UUID) error {
+		switch state[uid] {
+		case visiting:
+			return ErrDependencyCycle
+		case done:
+			return nil
+		}
+
+		state[uid] = visiting
+		for _, next := range successors[uid] {
+			if err := visit(next); err != nil {
+				return err
+			}
+		}
+		state[uid] = done
+
+		order = append(order, uid)
+
+		return nil
+	}
+
+	if err := visit(root); err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	return order, nil
+}
+
+func minTime(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+
+	return a
+}
+
+func maxTime(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+
+	return a
+}
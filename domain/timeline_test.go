@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func day(s string) *time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return &t
+}
+
+func TestAddWorkdays(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		n    int
+		want string
+	}{
+		{"friday plus one", "2024-06-07", 1, "2024-06-10"},
+		{"monday plus five", "2024-06-03", 5, "2024-06-10"},
+		{"monday minus one", "2024-06-10", -1, "2024-06-07"},
+		{"zero", "2024-06-08", 0, "2024-06-08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AddWorkdays(*day(tt.from), tt.n)
+			if !got.Equal(*day(tt.want)) {
+				t.Errorf("AddWorkdays() = %v, want %v", got.Format("2006-01-02"), tt.want)
+			}
+
+			if tt.n > 0 && WorkdaysBetween(*day(tt.from), got) != tt.n {
+				t.Errorf("WorkdaysBetween() != %v", tt.n)
+			}
+		})
+	}
+}
+
+func TestBuildTimeline(t *testing.T) {
+	epic := Task{UUID: uuid.New(), IsEpic: true}
+	epic.Path = []string{epic.UUID.String()}
+
+	a := Task{UUID: uuid.New(), StartAt: day("2024-06-03"), Duration: 3}
+	a.Path = []string{epic.UUID.String(), a.UUID.String()}
+
+	b := Task{UUID: uuid.New(), StartAt: day("2024-06-05"), FinishTo: day("2024-06-14")}
+	b.Path = []string{epic.UUID.String(), a.UUID.String(), b.UUID.String()}
+
+	items := BuildTimeline([]Task{epic, a, b}, []TaskDependency{{TaskUUID: b.UUID, DependsOnUUID: a.UUID}})
+
+	if !items[1].Finish.Equal(*day("2024-06-06")) {
+		t.Errorf("finish = %v", items[1].Finish)
+	}
+
+	if items[2].ParentUUID == nil || *items[2].ParentUUID != a.UUID {
+		t.Errorf("parent = %v", items[2].ParentUUID)
+	}
+
+	if len(items[2].DependsOn) != 1 || items[2].DependsOn[0] != a.UUID {
+		t.Errorf("depends on = %v", items[2].DependsOn)
+	}
+
+	if items[0].Start != nil || !items[0].SpanStart.Equal(*day("2024-06-03")) || !items[0].SpanFinish.Equal(*day("2024-06-14")) {
+		t.Errorf("epic span = %v - %v", items[0].SpanStart, items[0].SpanFinish)
+	}
+}
+
+func TestReschedule(t *testing.T) {
+	a := &Task{UUID: uuid.New(), StartAt: day("2024-06-03"), Duration: 4}
+	b := &Task{UUID: uuid.New(), StartAt: day("2024-06-05"), FinishTo: day("2024-06-07")}
+	c := &Task{UUID: uuid.New(), StartAt: day("2024-06-20"), Duration: 1}
+	d := &Task{UUID: uuid.New()}
+
+	tasks := map[uuid.UUID]*Task{a.UUID: a, b.UUID: b, c.UUID: c, d.UUID: d}
+	deps := []TaskDependency{
+		{TaskUUID: b.UUID, DependsOnUUID: a.UUID},
+		{TaskUUID: c.UUID, DependsOnUUID: b.UUID},
+		{TaskUUID: d.UUID, DependsOnUUID: a.UUID},
+	}
+
+	moved, err := Reschedule(tasks, deps, a.UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(moved) != 1 || moved[0] != b.UUID {
+		t.Fatalf("moved = %v", moved)
+	}
+
+	// a finishes on friday, b keeps its two working days
+	if !b.StartAt.Equal(*day("2024-06-07")) || !b.FinishTo.Equal(*day("2024-06-11")) {
+		t.Errorf("b = %v - %v", b.StartAt, b.FinishTo)
+	}
+
+	if !c.StartAt.Equal(*day("2024-06-20")) {
+		t.Errorf("c moved to %v", c.StartAt)
+	}
+
+	err = CheckDependency(deps, a.UUID, c.UUID)
+	if !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("CheckDependency() = %v, want cycle", err)
+	}
+}
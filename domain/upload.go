@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUploadOffsetMismatch = errors.New("смещение загрузки не совпадает")
+	ErrUploadTooLarge       = errors.New("размер загрузки превышает допустимый")
+	ErrUploadExpired        = errors.New("срок загрузки истек")
+	ErrUploadFinished       = errors.New("загрузка уже завершена")
+	ErrUploadLocked         = errors.New("загрузка уже выполняется")
+)
+
+// UploadSession is the resumable (tus) upload of a task or comment file. The
+// chunks are staged until the whole file is received, then the file is stored
+// like a regular upload.
+type UploadSession struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	TaskUUID       uuid.UUID
+	CommentUUID    *uuid.UUID
+	CreatedBy      uuid.UUID
+
+	FileName string
+	Length   int64
+	Offset   int64
+
+	// FileUUID is the stored file, it is set when the upload is finished.
+	FileUUID *uuid.UUID
+
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ExpiresAt  time.Time
+	FinishedAt *time.Time
+}
+
+func NewUploadSession(federationUUID, taskUUID uuid.UUID, commentUUID *uuid.UUID, createdBy uuid.UUID, fileName string, length, maxLength int64, ttl time.Duration, now time.Time) (UploadSession, error) {
+	fileName = strings.TrimSpace(filepath.Base(fileName))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return UploadSession{}, errors.New("не указано имя файла")
+	}
+
+	if length <= 0 {
+		return UploadSession{}, errors.New("не указан размер загрузки")
+	}
+
+	if maxLength > 0 && length > maxLength {
+		return UploadSession{}, ErrUploadTooLarge
+	}
+
+	return UploadSession{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		TaskUUID:       taskUUID,
+		CommentUUID:    commentUUID,
+		CreatedBy:      createdBy,
+		FileName:       fileName,
+		Length:         length,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		ExpiresAt:      now.Add(ttl),
+	}, nil
+}
+
+// Ext is the extension of the file name, it is kept on the staged file so the
+// mime type is detected as for a regular upload.
+func (s UploadSession) Ext() string {
+	ext := filepath.Ext(s.FileName)
+	if len(ext) > 10 || strings.ContainsAny(ext, `/\`) {
+		return ""
+	}
+
+	return ext
+}
+
+func (s UploadSession) Completed() bool {
+	return s.Offset >= s.Length
+}
+
+func (s UploadSession) Finished() bool {
+	return s.FinishedAt != nil
+}
+
+func (s UploadSession) Expired(now time.Time) bool {
+	return !s.Finished() && now.After(s.ExpiresAt)
+}
+
+// CheckChunk validates the chunk sent at the offset, size is -1 when the
+// client does not send Content-Length.
+func (s UploadSession) CheckChunk(offset, size int64, now time.Time) error {
+	if s.Finished() {
+		return ErrUploadFinished
+	}
+
+	if s.Expired(now) {
+		return ErrUploadExpired
+	}
+
+	if offset != s.Offset {
+		return ErrUploadOffsetMismatch
+	}
+
+	if size > s.Length-s.Offset {
+		return ErrUploadTooLarge
+	}
+
+	return nil
+}
+
+// ParseUploadMetadata decodes the tus Upload-Metadata header: comma separated
+// pairs of the key and the base64 value.
+func ParseUploadMetadata(header string) (map[string]string, error) {
+	meta := make(map[string]string)
+
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(pair, " ")
+
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return meta, fmt.Errorf("неверные метаданные загрузки %s: %w", key, err)
+		}
+
+		meta[key] = string(decoded)
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUploadSession(t *testing.T) {
+	now := time.Date(2025, 7, 14, 12, 0, 0, 0, time.UTC)
+
+	s, err := NewUploadSession(uuid.New(), uuid.New(), nil, uuid.New(), "../scans/договор.PDF", 100, 1000, time.Hour, now)
+	if err != nil {
+		t.Fatalf("NewUploadSession() error = %v", err)
+	}
+
+	if s.FileName != "договор.PDF" || s.Ext() != ".PDF" || !s.ExpiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("NewUploadSession() = %+v", s)
+	}
+
+	if _, err = NewUploadSession(uuid.New(), uuid.New(), nil, uuid.New(), "a.pdf", 1001, 1000, time.Hour, now); !errors.Is(err, ErrUploadTooLarge) {
+		t.Errorf("NewUploadSession() too large error = %v", err)
+	}
+
+	for _, name := range []string{"", " ", "/"} {
+		if _, err = NewUploadSession(uuid.New(), uuid.New(), nil, uuid.New(), name, 1, 0, time.Hour, now); err == nil {
+			t.Errorf("NewUploadSession(%q) accepted", name)
+		}
+	}
+
+	if _, err = NewUploadSession(uuid.New(), uuid.New(), nil, uuid.New(), "a.pdf", 0, 0, time.Hour, now); err == nil {
+		t.Error("NewUploadSession() accepted empty length")
+	}
+}
+
+func TestUploadSessionCheckChunk(t *testing.T) {
+	now := time.Date(2025, 7, 14, 12, 0, 0, 0, time.UTC)
+	s := UploadSession{Length: 100, Offset: 40, ExpiresAt: now.Add(time.Hour)}
+
+	tests := []struct {
+		name   string
+		offset int64
+		size   int64
+		now    time.Time
+		want   error
+	}{
+		{"next chunk", 40, 60, now, nil},
+		{"unknown size", 40, -1, now, nil},
+		{"wrong offset", 0, 10, now, ErrUploadOffsetMismatch},
+		{"beyond length", 40, 61, now, ErrUploadTooLarge},
+		{"expired", 40, 10, now.Add(2 * time.Hour), ErrUploadExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.CheckChunk(tt.offset, tt.size, tt.now); !errors.Is(err, tt.want) {
+				t.Errorf("CheckChunk() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	finished := s
+	finished.FinishedAt = &now
+
+	if err := finished.CheckChunk(40, 10, now.Add(2*time.Hour)); !errors.Is(err, ErrUploadFinished) {
+		t.Errorf("CheckChunk() finished = %v", err)
+	}
+
+	if finished.Expired(now.Add(2 * time.Hour)) {
+		t.Error("Expired() is true for the finished upload")
+	}
+}
+
+func TestParseUploadMetadata(t *testing.T) {
+	meta, err := ParseUploadMetadata("filename ZG9nLnBkZg==, comment_uuid MTIz,is_confidential")
+	if err != nil {
+		t.Fatalf("ParseUploadMetadata() error = %v", err)
+	}
+
+	if meta["filename"] != "dog.pdf" || meta["comment_uuid"] != "123" {
+		t.Errorf("ParseUploadMetadata() = %v", meta)
+	}
+
+	if _, ok := meta["is_confidential"]; !ok {
+		t.Error("ParseUploadMetadata() lost the key without value")
+	}
+
+	if _, err = ParseUploadMetadata("filename !!!"); err == nil {
+		t.Error("ParseUploadMetadata() accepted invalid base64")
+	}
+}
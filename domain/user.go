@@ -35,7 +35,8 @@ type User struct {
 }
 
 type ProfilePreferences struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone       *string `json:"timezone,omitempty"`
+	WeeklyCapacity *int    `json:"weekly_capacity,omitempty"`
 }
 
 type ProfilePhotoDTO struct {
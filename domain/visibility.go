@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	TaskVisibilityProject = "project"
+	TaskVisibilityTeam    = "team"
+	TaskVisibilityACL     = "acl"
+)
+
+var ErrInvalidVisibility = errors.New("неизвестный режим видимости задачи")
+
+func GetTaskVisibilities() []string {
+	return []string{TaskVisibilityProject, TaskVisibilityTeam, TaskVisibilityACL}
+}
+
+// PatchVisibility changes who can see the task: every project user, the task
+// team (People) only, or the team plus the listed users and groups.
+func (t *Task) PatchVisibility(visibility string, users []string, groups []uuid.UUID) error {
+	if !lo.Contains(GetTaskVisibilities(), visibility) {
+		return ErrInvalidVisibility
+	}
+
+	if visibility != TaskVisibilityACL {
+		users, groups = nil, nil
+	}
+
+	t.Visibility = visibility
+	t.AccessUsers = lo.WithoutEmpty(lo.Uniq(users))
+	t.AccessGroups = lo.Uniq(groups)
+
+	t.SafeDirty("visibility", t.Visibility)
+
+	return nil
+}
+
+// VisibleTo reports whether the user with the email and groups can see the
+// task.
+func (t *Task) VisibleTo(email string, groups []uuid.UUID) bool {
+	switch t.Visibility {
+	case TaskVisibilityTeam:
+		return lo.Contains(t.People, email)
+	case TaskVisibilityACL:
+		return lo.Contains(t.People, email) || lo.Contains(t.AccessUsers, email) || len(lo.Intersect(t.AccessGroups, groups)) > 0
+	}
+
+	return true
+}
+
+// TaskVisibleSQL returns the condition selecting rows of the tasks table (or
+// alias) the user can see, the same rules as VisibleTo. Groups of the user
+// are taken from group_users.
+func TaskVisibleSQL(table, email string) (string, []interface{}) {
+	sql := fmt.Sprintf(`(%[1]s.visibility = 'project' OR ? = ANY (%[1]s.all_people) OR (%[1]s.visibility = 'acl' AND (? = ANY (%[1]s.access_users) OR %[1]s.access_groups && ARRAY(
+		SELECT gu.group_uuid FROM group_users gu JOIN users u ON u.uuid = gu.user_uuid WHERE u.email = ? AND gu.deleted_at IS NULL
+	))))`, table)
+
+	return sql, []interface{}{email, email, email}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestTaskVisibility(t *testing.T) {
+	group := uuid.New()
+
+	task := Task{People: []string{"a@a.ru"}}
+	if !task.VisibleTo("b@b.ru", nil) {
+		t.Error("task without visibility must be visible to the project")
+	}
+
+	if err := task.PatchVisibility("private", nil, nil); !errors.Is(err, ErrInvalidVisibility) {
+		t.Errorf("PatchVisibility() = %v", err)
+	}
+
+	_ = task.PatchVisibility(TaskVisibilityTeam, []string{"b@b.ru"}, []uuid.UUID{group})
+	if len(task.AccessUsers) != 0 || len(task.AccessGroups) != 0 {
+		t.Errorf("acl of team task = %v %v", task.AccessUsers, task.AccessGroups)
+	}
+
+	if !task.VisibleTo("a@a.ru", nil) || task.VisibleTo("b@b.ru", []uuid.UUID{group}) {
+		t.Error("team task must be visible to the team only")
+	}
+
+	_ = task.PatchVisibility(TaskVisibilityACL, []string{"b@b.ru", "b@b.ru", ""}, []uuid.UUID{group})
+	if len(task.AccessUsers) != 1 {
+		t.Errorf("AccessUsers = %v", task.AccessUsers)
+	}
+
+	tests := map[string]bool{
+		"a@a.ru": true,
+		"b@b.ru": true,
+		"c@c.ru": false,
+	}
+
+	for email, want := range tests {
+		if got := task.VisibleTo(email, nil); got != want {
+			t.Errorf("VisibleTo(%s) = %v, want %v", email, got, want)
+		}
+	}
+
+	if !task.VisibleTo("c@c.ru", []uuid.UUID{uuid.New(), group}) {
+		t.Error("acl task must be visible to the group")
+	}
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventTaskCreated       WebhookEvent = "task.created"
+	WebhookEventTaskUpdated       WebhookEvent = "task.updated"
+	WebhookEventTaskStatusChanged WebhookEvent = "task.status_changed"
+	WebhookEventTaskDeleted       WebhookEvent = "task.deleted"
+	WebhookEventCommentCreated    WebhookEvent = "comment.created"
+	WebhookEventReminderCreated   WebhookEvent = "reminder.created"
+)
+
+var WebhookEvents = []WebhookEvent{
+	WebhookEventTaskCreated,
+	WebhookEventTaskUpdated,
+	WebhookEventTaskStatusChanged,
+	WebhookEventTaskDeleted,
+	WebhookEventCommentCreated,
+	WebhookEventReminderCreated,
+}
+
+func IsWebhookEvent(event string) bool {
+	return lo.Contains(WebhookEvents, WebhookEvent(event))
+}
+
+const (
+	WebhookDeliveryPending = "pending"
+	WebhookDeliverySuccess = "success"
+	WebhookDeliveryFailed  = "failed"
+)
+
+type Webhook struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	URL    string
+	Secret string
+	Events []string
+
+	IsActive   bool
+	Failures   int
+	DisabledAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func NewWebhook(federationUUID uuid.UUID, me Me, url string, events []string) *Webhook {
+	return &Webhook{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CreatedBy:      me.Email,
+		CreatedByUUID:  me.UUID,
+		URL:            url,
+		Secret:         NewWebhookSecret(),
+		Events:         lo.Uniq(events),
+		IsActive:       true,
+	}
+}
+
+func NewWebhookSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+func (w Webhook) IsSubscribed(event WebhookEvent) bool {
+	return w.IsActive && lo.Contains(w.Events, string(event))
+}
+
+type WebhookDelivery struct {
+	UUID           uuid.UUID
+	WebhookUUID    uuid.UUID
+	FederationUUID uuid.UUID
+
+	Event   string
+	Payload json.RawMessage
+
+	Status       string
+	Attempts     int
+	ResponseCode int
+	ResponseBody string
+	Error        string
+
+	NextAttemptAt *time.Time
+	DeliveredAt   *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWebhookDelivery(wh Webhook, event WebhookEvent, payload json.RawMessage) *WebhookDelivery {
+	now := time.Now()
+
+	return &WebhookDelivery{
+		UUID:           uuid.New(),
+		WebhookUUID:    wh.UUID,
+		FederationUUID: wh.FederationUUID,
+		Event:          string(event),
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  &now,
+	}
+}
+
+type WebhookFilter struct {
+	FederationUUID uuid.UUID `json:"federation_uuid"`
+	Offset         *int      `json:"offset"`
+	Limit          *int      `json:"limit"`
+}
+
+type WebhookDeliveryFilter struct {
+	WebhookUUID uuid.UUID `json:"webhook_uuid"`
+	Status      *string   `json:"status"`
+	Offset      *int      `json:"offset"`
+	Limit       *int      `json:"limit"`
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	WorkloadRoleImplement   = "implement_by"
+	WorkloadRoleResponsible = "responsible_by"
+	WorkloadRoleCoWorker    = "co_workers_by"
+
+	DefaultWeeklyCapacity = 10
+)
+
+func GetWorkloadRoles() []string {
+	return []string{WorkloadRoleImplement, WorkloadRoleResponsible, WorkloadRoleCoWorker}
+}
+
+// Workload is the open tasks of one user. Capacity is the number of tasks
+// the user can finish in a week.
+type Workload struct {
+	Email    string
+	Capacity int
+
+	Total         int
+	Overdue       int
+	NoFinishTo    int
+	ByRole        map[string]int
+	ByStatus      map[int]int
+	ByPriority    map[int]int
+	Weeks         []WorkloadWeek
+	Later         int
+	OverAllocated bool
+}
+
+// WorkloadWeek holds tasks with FinishTo inside the week. Overdue tasks are
+// counted in the current week because they still have to be done.
+type WorkloadWeek struct {
+	Start         time.Time
+	Total         int
+	OverAllocated bool
+}
+
+// WeekStart returns monday 00:00 of the week in the location of t.
+func WeekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	y, m, d := t.AddDate(0, 0, -offset).Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// BuildWorkload groups open tasks by people in the given roles. A task is
+// counted once per user even if the user has several roles in it.
+func BuildWorkload(tasks []Task, roles []string, capacities map[string]int, now time.Time, weeks int) []Workload {
+	if len(roles) == 0 {
+		roles = GetWorkloadRoles()
+	}
+
+	current := WeekStart(now)
+	res := make(map[string]*Workload)
+
+	get := func(email string) *Workload {
+		w, ok := res[email]
+		if ok {
+			return w
+		}
+
+		capacity, ok := capacities[email]
+		if !ok || capacity <= 0 {
+			capacity = DefaultWeeklyCapacity
+		}
+
+		w = &Workload{
+			Email:      email,
+			Capacity:   capacity,
+			ByRole:     make(map[string]int),
+			ByStatus:   make(map[int]int),
+			ByPriority: make(map[int]int),
+			Weeks:      make([]WorkloadWeek, weeks),
+		}
+
+		for i := range w.Weeks {
+			w.Weeks[i].Start = current.AddDate(0, 0, 7*i)
+		}
+
+		res[email] = w
+
+		return w
+	}
+
+	for _, t := range tasks {
+		if t.Status == StatusDone || t.Status == StatusCancel {
+			continue
+		}
+
+		people := make(map[string][]string)
+		for _, role := range roles {
+			switch role {
+			case WorkloadRoleImplement:
+				people[t.ImplementBy] = append(people[t.ImplementBy], role)
+			case WorkloadRoleResponsible:
+				people[t.ResponsibleBy] = append(people[t.ResponsibleBy], role)
+			case WorkloadRoleCoWorker:
+				for _, email := range t.CoWorkersBy {
+					people[email] = append(people[email], role)
+				}
+			}
+		}
+
+		for email, userRoles := range people {
+			if email == "" {
+				continue
+			}
+
+			w := get(email)
+			w.Total++
+			w.ByStatus[t.Status]++
+			w.ByPriority[t.Priority]++
+
+			for _, role := range lo.Uniq(userRoles) {
+				w.ByRole[role]++
+			}
+
+			if t.FinishTo == nil {
+				w.NoFinishTo++
+				continue
+			}
+
+			if t.FinishTo.Before(now) {
+				w.Overdue++
+			}
+
+			week := 0
+			if t.FinishTo.After(current) {
+				week = int(math.Round(WeekStart(t.FinishTo.In(now.Location())).Sub(current).Hours() / (24 * 7)))
+			}
+
+			if week >= weeks {
+				w.Later++
+				continue
+			}
+
+			w.Weeks[week].Total++
+		}
+	}
+
+	items := make([]Workload, 0, len(res))
+	for _, w := range res {
+		for i := range w.Weeks {
+			w.Weeks[i].OverAllocated = w.Weeks[i].Total > w.Capacity
+			w.OverAllocated = w.OverAllocated || w.Weeks[i].OverAllocated
+		}
+
+		items = append(items, *w)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Total != items[j].Total {
+			return items[i].Total > items[j].Total
+		}
+
+		return items[i].Email < items[j].Email
+	})
+
+	return items
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekStart(t *testing.T) {
+	got := WeekStart(time.Date(2024, 6, 9, 15, 30, 0, 0, time.UTC))
+	if !got.Equal(time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("WeekStart() = %v", got)
+	}
+}
+
+func TestBuildWorkload(t *testing.T) {
+	now := time.Date(2024, 6, 5, 12, 0, 0, 0, time.UTC)
+
+	tasks := []Task{
+		{Status: StatusInWork, Priority: 10, ImplementBy: "a@a.ru", ResponsibleBy: "a@a.ru", FinishTo: day("2024-06-03")},
+		{Status: StatusNew, Priority: 20, ImplementBy: "a@a.ru", CoWorkersBy: []string{"b@b.ru"}, FinishTo: day("2024-06-12")},
+		{Status: StatusNew, Priority: 20, ImplementBy: "a@a.ru", FinishTo: day("2024-09-01")},
+		{Status: StatusHold, Priority: 10, ResponsibleBy: "b@b.ru"},
+		{Status: StatusDone, ImplementBy: "a@a.ru"},
+	}
+
+	items := BuildWorkload(tasks, nil, map[string]int{"a@a.ru": 1}, now, 4)
+	if len(items) != 2 {
+		t.Fatalf("len = %v", len(items))
+	}
+
+	a := items[0]
+	if a.Email != "a@a.ru" || a.Total != 3 || a.Overdue != 1 || a.Later != 1 {
+		t.Errorf("a = %+v", a)
+	}
+
+	if a.ByRole[WorkloadRoleImplement] != 3 || a.ByRole[WorkloadRoleResponsible] != 1 || a.ByPriority[20] != 2 {
+		t.Errorf("a breakdown = %v %v", a.ByRole, a.ByPriority)
+	}
+
+	if a.Weeks[0].Total != 1 || a.Weeks[1].Total != 1 || a.OverAllocated {
+		t.Errorf("a weeks = %+v", a.Weeks)
+	}
+
+	b := items[1]
+	if b.Capacity != DefaultWeeklyCapacity || b.Total != 2 || b.NoFinishTo != 1 || b.ByStatus[StatusHold] != 1 {
+		t.Errorf("b = %+v", b)
+	}
+
+	items = BuildWorkload(tasks, []string{WorkloadRoleImplement}, map[string]int{"a@a.ru": 1}, now, 1)
+	if len(items) != 1 || items[0].Weeks[0].Total != 1 || items[0].Later != 2 {
+		t.Errorf("implement only = %+v", items)
+	}
+
+	tasks = append(tasks, Task{Status: StatusNew, ImplementBy: "a@a.ru", FinishTo: day("2024-06-07")})
+
+	items = BuildWorkload(tasks, nil, map[string]int{"a@a.ru": 1}, now, 4)
+	if !items[0].Weeks[0].OverAllocated || !items[0].OverAllocated {
+		t.Errorf("a should be over allocated: %+v", items[0].Weeks)
+	}
+}
@@ -54,6 +54,19 @@ type ActivityTaskWasDeletedDTO struct {
 	Name string `json:"name"`
 }
 
+type ActivityTaskApprovalVoteDTO struct {
+	Decision string `json:"decision"`
+	Comment  string `json:"comment"`
+	Status   string `json:"status"`
+}
+
+type ActivityTaskCommentLinkDTO struct {
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+	TaskUUID    uuid.UUID `json:"task_uuid"`
+	TaskID      int       `json:"task_id"`
+	TaskName    string    `json:"task_name"`
+}
+
 type ActivityTaskFileWasDeletedDTO struct {
 	Name string `json:"name"`
 	Ext  string `json:"ext"`
@@ -153,6 +166,42 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
+	if dm.Type == int(domain.ActivityTaskApprovalVote) {
+		var p ActivityTaskApprovalVoteDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityTaskFromComment) || dm.Type == int(domain.ActivityTaskCommentToTask) {
+		var p ActivityTaskCommentLinkDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
 	return &ActivityDTO{
 		UUID:      dm.UUID,
 		CreatedBy: user,
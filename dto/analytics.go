@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type PercentilesDTO struct {
+	Count int     `json:"count"`
+	Avg   float64 `json:"avg"`
+	P50   float64 `json:"p50"`
+	P75   float64 `json:"p75"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+type StatusTimeDTO struct {
+	Status   int     `json:"status"`
+	Tasks    int     `json:"tasks"`
+	Hours    float64 `json:"hours"`
+	AvgHours float64 `json:"avg_hours"`
+}
+
+type ThroughputWeekDTO struct {
+	Start time.Time `json:"start"`
+	Done  int       `json:"done"`
+}
+
+type FlowDayDTO struct {
+	Date     time.Time   `json:"date"`
+	ByStatus map[int]int `json:"by_status"`
+}
+
+type AgingItemDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Status      int       `json:"status"`
+	ImplementBy string    `json:"implement_by"`
+	StartedAt   time.Time `json:"started_at"`
+	AgeHours    float64   `json:"age_hours"`
+	InStatus    float64   `json:"in_status_hours"`
+}
+
+// AnalyticsDTO durations are in hours.
+type AnalyticsDTO struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Tasks int       `json:"tasks"`
+
+	TimeInStatus []StatusTimeDTO     `json:"time_in_status"`
+	LeadTime     PercentilesDTO      `json:"lead_time"`
+	CycleTime    PercentilesDTO      `json:"cycle_time"`
+	Throughput   []ThroughputWeekDTO `json:"throughput"`
+	Flow         []FlowDayDTO        `json:"cumulative_flow"`
+	Aging        []AgingItemDTO      `json:"aging_wip"`
+}
+
+func NewAnalyticsDTO(dm domain.Analytics) AnalyticsDTO {
+	return AnalyticsDTO{
+		From:  dm.From,
+		To:    dm.To,
+		Tasks: dm.Tasks,
+		TimeInStatus: lo.Map(dm.TimeInStatus, func(item domain.StatusTime, _ int) StatusTimeDTO {
+			return StatusTimeDTO(item)
+		}),
+		LeadTime:  PercentilesDTO(dm.LeadTime),
+		CycleTime: PercentilesDTO(dm.CycleTime),
+		Throughput: lo.Map(dm.Throughput, func(item domain.ThroughputWeek, _ int) ThroughputWeekDTO {
+			return ThroughputWeekDTO(item)
+		}),
+		Flow: lo.Map(dm.Flow, func(item domain.FlowDay, _ int) FlowDayDTO {
+			return FlowDayDTO(item)
+		}),
+		Aging: lo.Map(dm.Aging, func(item domain.AgingItem, _ int) AgingItemDTO {
+			return AgingItemDTO(item)
+		}),
+	}
+}
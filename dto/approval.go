@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type ApprovalPolicyDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+
+	Approvers []string    `json:"approvers"`
+	Groups    []uuid.UUID `json:"groups"`
+	Rule      string      `json:"rule"`
+	Quorum    int         `json:"quorum"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewApprovalPolicyDTO(dm domain.ApprovalPolicy) ApprovalPolicyDTO {
+	return ApprovalPolicyDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		Approvers:   dm.Approvers,
+		Groups:      dm.Groups,
+		Rule:        dm.Rule,
+		Quorum:      dm.Quorum,
+		CreatedBy:   dm.CreatedBy,
+		CreatedAt:   dm.CreatedAt,
+	}
+}
+
+type TaskApprovalDTO struct {
+	UUID     uuid.UUID `json:"uuid"`
+	TaskUUID uuid.UUID `json:"task_uuid"`
+
+	Rule      string            `json:"rule"`
+	Required  int               `json:"required"`
+	Approvers []string          `json:"approvers"`
+	Pending   []string          `json:"pending"`
+	Status    string            `json:"status"`
+	Votes     []ApprovalVoteDTO `json:"votes"`
+
+	CreatedAt  time.Time  `json:"created_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+type ApprovalVoteDTO struct {
+	Email    string `json:"email"`
+	Decision string `json:"decision"`
+	Comment  string `json:"comment"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskApprovalDTO(dm domain.TaskApproval) TaskApprovalDTO {
+	return TaskApprovalDTO{
+		UUID:      dm.UUID,
+		TaskUUID:  dm.TaskUUID,
+		Rule:      dm.Rule,
+		Required:  dm.Required,
+		Approvers: dm.Approvers,
+		Pending:   dm.Pending(),
+		Status:    dm.Status,
+		Votes: lo.Map(dm.Votes, func(v domain.ApprovalVote, _ int) ApprovalVoteDTO {
+			return ApprovalVoteDTO{
+				Email:     v.Email,
+				Decision:  v.Decision,
+				Comment:   v.Comment,
+				CreatedAt: v.CreatedAt,
+				UpdatedAt: v.UpdatedAt,
+			}
+		}),
+		CreatedAt:  dm.CreatedAt,
+		ResolvedAt: dm.ResolvedAt,
+	}
+}
@@ -95,6 +95,9 @@ type CatalogSearchDTO struct {
 
 	Order *string `json:"order"`
 	By    *string `json:"by"`
+
+	Cursor    *string `json:"cursor"`
+	WithTotal bool    `json:"with_total"`
 }
 
 func (d *CatalogSearchDTO) Validate() error {
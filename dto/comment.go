@@ -18,6 +18,12 @@ type CommentDTO struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
+	Edited   bool       `json:"edited"`
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy *UserDTO   `json:"deleted_by,omitempty"`
+
 	CreatedBy *UserDTO      `json:"created_by"`
 	People    []UserLikeDTO `json:"people,omitempty"`
 	Likes     []UserLikeDTO `json:"likes,omitempty"`
@@ -25,13 +31,50 @@ type CommentDTO struct {
 	Uploads []UploadDTO `json:"files,omitempty"`
 
 	Pin bool `json:"pin"`
+
+	Type      string        `json:"type"`
+	Reactions []ReactionDTO `json:"reactions,omitempty"`
+	Poll      *PollDTO      `json:"poll,omitempty"`
+
+	ConvertedTaskUUID *uuid.UUID `json:"converted_task_uuid,omitempty"`
+}
+
+type ReactionDTO struct {
+	Emoji string        `json:"emoji"`
+	Count int           `json:"count"`
+	Users []UserLikeDTO `json:"users"`
 }
 
+type PollOptionDTO struct {
+	ID     int           `json:"id"`
+	Text   string        `json:"text"`
+	Votes  int           `json:"votes"`
+	Voters []UserLikeDTO `json:"voters"`
+}
+
+type PollDTO struct {
+	Options  []PollOptionDTO `json:"options"`
+	Multiple bool            `json:"multiple"`
+	ClosesAt *time.Time      `json:"closes_at,omitempty"`
+	Closed   bool            `json:"closed"`
+	Voters   int             `json:"voters"`
+}
+
+const (
+	CommentTypeText = "text"
+	CommentTypePoll = "poll"
+)
+
 func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 	createdBy, _ := dict.FindUser(dm.CreatedBy)
 
 	uploads := lo.Map(dm.Files, func(file domain.File, _ int) UploadDTO {
-		return NewUploadDTO(file.UUID, file.Name, file.Ext, file.Size, file.URL)
+		upload := NewUploadDTO(file.UUID, file.Name, file.Ext, file.Size, file.URL)
+		upload.PreviewURL = file.PreviewURL
+		upload.ScanStatus = file.ScanStatus
+		upload.SHA256 = file.SHA256
+
+		return upload
 	})
 
 	likes := lo.Map(dm.UserLikes, func(user domain.UserLike, _ int) UserLikeDTO {
@@ -48,6 +91,18 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		}
 	})
 
+	var deletedBy *UserDTO
+	if dm.DeletedBy != nil {
+		deletedBy, _ = dict.FindUser(*dm.DeletedBy)
+	}
+
+	commentType := CommentTypeText
+	var poll *PollDTO
+	if dm.Poll != nil {
+		commentType = CommentTypePoll
+		poll = NewPollDTO(*dm.Poll, dict, s3)
+	}
+
 	return CommentDTO{
 		UUID:         dm.UUID,
 		Comment:      dm.Comment,
@@ -58,6 +113,12 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		CreatedAt: dm.CreatedAt,
 		UpdatedAt: dm.UpdatedAt,
 
+		Edited:   dm.EditedAt != nil,
+		EditedAt: dm.EditedAt,
+
+		DeletedAt: dm.DeletedAt,
+		DeletedBy: deletedBy,
+
 		Uploads: uploads,
 
 		Likes: likes,
@@ -65,6 +126,70 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		People: people,
 
 		Pin: dm.Pin,
+
+		Type:      commentType,
+		Reactions: NewReactionDTOs(dm.UserReactions, s3),
+		Poll:      poll,
+
+		ConvertedTaskUUID: dm.ConvertedTaskUUID,
+	}
+}
+
+func NewReactionDTOs(dms []domain.Reaction, s3 IStorage) []ReactionDTO {
+	return lo.Map(dms, func(r domain.Reaction, _ int) ReactionDTO {
+		return ReactionDTO{
+			Emoji: r.Emoji,
+			Count: len(r.Users),
+			Users: lo.Map(r.Users, func(user domain.UserLike, _ int) UserLikeDTO {
+				return UserLikeDTO{
+					UnixAt: user.CreatedAt,
+					User:   NewUserShotDto(user.User, s3),
+				}
+			}),
+		}
+	})
+}
+
+func NewPollDTO(dm domain.Poll, dict IDict, s3 IStorage) *PollDTO {
+	voters := make(map[int][]UserLikeDTO)
+	for _, v := range dm.Votes {
+		u, found := dict.FindUser(v.Email)
+		if !found {
+			continue
+		}
+
+		like := UserLikeDTO{
+			UnixAt: v.CreatedAt.UnixMicro(),
+			User: NewUserShotDto(domain.User{
+				UUID:     u.UUID,
+				Email:    u.Email,
+				Name:     u.Name,
+				Lname:    u.Lname,
+				Pname:    u.Pname,
+				HasPhoto: u.HasPhoto,
+			}, s3),
+		}
+
+		for _, id := range v.Options {
+			voters[id] = append(voters[id], like)
+		}
+	}
+
+	results := dm.Results()
+
+	return &PollDTO{
+		Options: lo.Map(dm.Options, func(o domain.PollOption, _ int) PollOptionDTO {
+			return PollOptionDTO{
+				ID:     o.ID,
+				Text:   o.Text,
+				Votes:  results[o.ID],
+				Voters: lo.Ternary(voters[o.ID] == nil, []UserLikeDTO{}, voters[o.ID]),
+			}
+		}),
+		Multiple: dm.Multiple,
+		ClosesAt: dm.ClosesAt,
+		Closed:   dm.Closed(time.Now()),
+		Voters:   len(dm.Votes),
 	}
 }
 
@@ -75,3 +200,26 @@ func (d *CommentDTO) InPeople(userUUID uuid.UUID) (UserLikeDTO, bool) {
 
 	return f, ok
 }
+
+type CommentRevisionDTO struct {
+	UUID      uuid.UUID `json:"uuid"`
+	Version   int       `json:"version"`
+	Comment   string    `json:"comment"`
+	EditedBy  *UserDTO  `json:"edited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewCommentRevisionDTO(dm domain.CommentRevision, dict IDict) CommentRevisionDTO {
+	editedBy, found := dict.FindUser(dm.EditedBy)
+	if !found {
+		editedBy = &UserDTO{Email: dm.EditedBy}
+	}
+
+	return CommentRevisionDTO{
+		UUID:      dm.UUID,
+		Version:   dm.Version,
+		Comment:   dm.Comment,
+		EditedBy:  editedBy,
+		CreatedAt: dm.CreatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type InboundMappingDTO struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Tags        string            `json:"tags,omitempty"`
+	Priority    string            `json:"priority,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+	DedupeKey   string            `json:"dedupe_key,omitempty"`
+}
+
+func (d InboundMappingDTO) ToDomain() domain.InboundMapping {
+	return domain.InboundMapping(d)
+}
+
+type InboundWebhookDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+
+	Name     string            `json:"name"`
+	URL      string            `json:"url"`
+	Token    string            `json:"token"`
+	Mapping  InboundMappingDTO `json:"mapping"`
+	IsActive bool              `json:"is_active"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewInboundWebhookDTO makes the dto, the payload is sent to the url with the
+// token in the X-Webhook-Token header.
+func NewInboundWebhookDTO(dm domain.InboundWebhook, backendURL string) InboundWebhookDTO {
+	return InboundWebhookDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		Name:        dm.Name,
+		URL:         fmt.Sprintf("%s/inbound/%s", backendURL, dm.UUID),
+		Token:       dm.Token,
+		Mapping:     InboundMappingDTO(dm.Mapping),
+		IsActive:    dm.IsActive,
+		CreatedAt:   dm.CreatedAt,
+		UpdatedAt:   dm.UpdatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type MailboxDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+
+	Email    string `json:"email"`
+	Domain   string `json:"domain"`
+	IsActive bool   `json:"is_active"`
+
+	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewMailboxDTO(dm domain.Mailbox) MailboxDTO {
+	return MailboxDTO{
+		UUID:          dm.UUID,
+		ProjectUUID:   dm.ProjectUUID,
+		Email:         dm.Email,
+		Domain:        dm.Domain,
+		IsActive:      dm.IsActive,
+		LastFetchedAt: dm.LastFetchedAt,
+		LastError:     dm.LastError,
+		CreatedAt:     dm.CreatedAt,
+		UpdatedAt:     dm.UpdatedAt,
+	}
+}
@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type MyDayTaskDTO struct {
+	UUID           uuid.UUID  `json:"uuid"`
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	FederationUUID uuid.UUID  `json:"federation_uuid"`
+	ProjectUUID    uuid.UUID  `json:"project_uuid"`
+	ImplementBy    string     `json:"implement_by"`
+	ResponsibleBy  string     `json:"responsible_by"`
+	Status         int        `json:"status"`
+	Priority       int        `json:"priority"`
+	FinishTo       *time.Time `json:"finish_to"`
+}
+
+type MyDayReminderDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	TaskUUID    uuid.UUID  `json:"task_uuid"`
+	Description string     `json:"description"`
+	Comment     string     `json:"comment"`
+	Type        string     `json:"type"`
+	Status      int        `json:"status"`
+	DateFrom    *time.Time `json:"date_from"`
+	DateTo      *time.Time `json:"date_to"`
+}
+
+type MentionDTO struct {
+	CommentUUID uuid.UUID `json:"comment_uuid"`
+	TaskUUID    uuid.UUID `json:"task_uuid"`
+	TaskName    string    `json:"task_name"`
+	Comment     string    `json:"comment"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type OpenedTaskDTO struct {
+	UUID     uuid.UUID `json:"uuid"`
+	ID       int       `json:"id"`
+	Name     string    `json:"name"`
+	Status   int       `json:"status"`
+	OpenedAt time.Time `json:"opened_at"`
+}
+
+type MyDayDTO struct {
+	Date time.Time `json:"date"`
+
+	Overdue   []MyDayTaskDTO     `json:"overdue"`
+	DueToday  []MyDayTaskDTO     `json:"due_today"`
+	Reminders []MyDayReminderDTO `json:"reminders"`
+
+	Notifications      map[string]int `json:"notifications"`
+	NotificationsTotal int            `json:"notifications_total"`
+
+	Mentions       []MentionDTO    `json:"mentions"`
+	RecentlyOpened []OpenedTaskDTO `json:"recently_opened"`
+}
+
+func NewMyDayDTO(dm domain.MyDay) MyDayDTO {
+	toTask := func(item domain.Task, _ int) MyDayTaskDTO {
+		return MyDayTaskDTO{
+			UUID:           item.UUID,
+			ID:             item.ID,
+			Name:           item.Name,
+			FederationUUID: item.FederationUUID,
+			ProjectUUID:    item.ProjectUUID,
+			ImplementBy:    item.ImplementBy,
+			ResponsibleBy:  item.ResponsibleBy,
+			Status:         item.Status,
+			Priority:       item.Priority,
+			FinishTo:       item.FinishTo,
+		}
+	}
+
+	notifications := dm.Notifications
+	if notifications == nil {
+		notifications = map[string]int{}
+	}
+
+	return MyDayDTO{
+		Date:     dm.Date,
+		Overdue:  lo.Map(dm.Overdue, toTask),
+		DueToday: lo.Map(dm.DueToday, toTask),
+		Reminders: lo.Map(dm.Reminders, func(item domain.Reminder, _ int) MyDayReminderDTO {
+			return MyDayReminderDTO{
+				UUID:        item.UUID,
+				TaskUUID:    item.TaskUUID,
+				Description: item.Description,
+				Comment:     item.Comment,
+				Type:        item.Type,
+				Status:      item.Status,
+				DateFrom:    item.DateFrom,
+				DateTo:      item.DateTo,
+			}
+		}),
+		Notifications:      notifications,
+		NotificationsTotal: dm.NotificationsTotal,
+		Mentions: lo.Map(dm.Mentions, func(item domain.Mention, _ int) MentionDTO {
+			return MentionDTO{
+				CommentUUID: item.CommentUUID,
+				TaskUUID:    item.TaskUUID,
+				TaskName:    item.TaskName,
+				Comment:     item.Comment,
+				CreatedBy:   item.CreatedBy,
+				CreatedAt:   item.CreatedAt,
+			}
+		}),
+		RecentlyOpened: lo.Map(dm.RecentlyOpened, func(item domain.OpenedTask, _ int) OpenedTaskDTO {
+			return OpenedTaskDTO{
+				UUID:     item.UUID,
+				ID:       item.ID,
+				Name:     item.Name,
+				Status:   item.Status,
+				OpenedAt: item.OpenedAt,
+			}
+		}),
+	}
+}
@@ -39,3 +39,23 @@ type NotificationReminderDTO struct {
 	Opened bool    `json:"opened"`
 	Group  int     `json:"group"`
 }
+
+type NotificationApprovalDTO struct {
+	UUID string `json:"uuid"`
+	Name string `json:"type_name"`
+	Type string `json:"type"`
+
+	Score float64 `json:"score"`
+	Star  bool    `json:"star"`
+}
+
+// NotificationFileInfectedDTO tells the uploader that the file of the task is
+// blocked by the antivirus.
+type NotificationFileInfectedDTO struct {
+	UUID string `json:"uuid"`
+	Name string `json:"type_name"`
+	Type string `json:"type"`
+
+	Score float64 `json:"score"`
+	Star  bool    `json:"star"`
+}
@@ -40,4 +40,7 @@ type PermissionRulesDTO struct {
 	TaskCreate bool `json:"task_create"`
 	TaskDelete bool `json:"task_delete"`
 	TaskPatch  bool `json:"task_patch"`
+
+	CommentAudit  bool `json:"comment_audit"`
+	WebhookManage bool `json:"webhook_manage"`
 }
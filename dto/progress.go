@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type BurndownDayDTO struct {
+	Date      time.Time `json:"date"`
+	Remaining float64   `json:"remaining"`
+}
+
+type EpicProgressDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	WeightField string    `json:"weight_field,omitempty"`
+
+	Total       int `json:"total"`
+	Done        int `json:"done"`
+	Unestimated int `json:"unestimated"`
+
+	Weight     float64 `json:"weight"`
+	DoneWeight float64 `json:"done_weight"`
+	Percent    float64 `json:"percent"`
+
+	Burndown []BurndownDayDTO `json:"burndown"`
+	Velocity float64          `json:"velocity"`
+	Forecast *time.Time       `json:"forecast,omitempty"`
+}
+
+func NewEpicProgressDTO(dm domain.EpicProgress) EpicProgressDTO {
+	return EpicProgressDTO{
+		UUID:        dm.UUID,
+		WeightField: dm.WeightField,
+		Total:       dm.Total,
+		Done:        dm.Done,
+		Unestimated: dm.Unestimated,
+		Weight:      dm.Weight,
+		DoneWeight:  dm.DoneWeight,
+		Percent:     dm.Percent,
+		Burndown: lo.Map(dm.Burndown, func(item domain.BurndownDay, _ int) BurndownDayDTO {
+			return BurndownDayDTO(item)
+		}),
+		Velocity: dm.Velocity,
+		Forecast: dm.Forecast,
+	}
+}
@@ -52,6 +52,7 @@ type ProjectStatistics struct {
 }
 
 type FieldStatistics struct {
+	Hash   string  `json:"hash"`
 	Name   string  `json:"name"`
 	Filled float64 `json:"filled"`
 	Count  int     `json:"count"`
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type ProjectStatisticSnapshotDTO struct {
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Day         time.Time `json:"day"`
+
+	TasksTotal         int `json:"tasks_total"`
+	TasksFinishedTotal int `json:"tasks_finished_total"`
+	TasksActiveTotal   int `json:"tasks_active_total"`
+	TaskCanceledTotal  int `json:"task_canceled_total"`
+	TaskDeletedTotal   int `json:"task_deleted_total"`
+
+	FieldStatistics []FieldStatistics `json:"field_statistics,omitempty"`
+}
+
+func NewProjectStatisticSnapshotDTO(dm domain.ProjectStatisticSnapshot) ProjectStatisticSnapshotDTO {
+	return ProjectStatisticSnapshotDTO{
+		ProjectUUID:        dm.ProjectUUID,
+		Day:                dm.Day,
+		TasksTotal:         dm.TasksTotal,
+		TasksFinishedTotal: dm.TasksFinishedTotal,
+		TasksActiveTotal:   dm.TasksActiveTotal,
+		TaskCanceledTotal:  dm.TasksCanceledTotal,
+		TaskDeletedTotal:   dm.TasksDeletedTotal,
+		FieldStatistics: lo.Map(dm.FieldStatistics, func(item domain.FieldStatistic, _ int) FieldStatistics {
+			return FieldStatistics{
+				Hash:   item.Hash,
+				Name:   item.Name,
+				Filled: item.Filled,
+				Count:  item.Count,
+				Total:  item.Total,
+			}
+		}),
+	}
+}
@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// StorageUsageDTO is the storage of the federation, quota zero is unlimited.
+type StorageUsageDTO struct {
+	Quota int64 `json:"quota"`
+	Bytes int64 `json:"bytes"`
+	Files int   `json:"files"`
+
+	Companies []StorageCompanyUsageDTO `json:"companies"`
+	Projects  []StorageProjectUsageDTO `json:"projects"`
+	Types     []StorageTypeUsageDTO    `json:"types"`
+}
+
+type StorageCompanyUsageDTO struct {
+	UUID  uuid.UUID `json:"uuid"`
+	Name  string    `json:"name"`
+	Bytes int64     `json:"bytes"`
+	Files int       `json:"files"`
+}
+
+type StorageProjectUsageDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	CompanyUUID uuid.UUID `json:"company_uuid"`
+	Bytes       int64     `json:"bytes"`
+	Files       int       `json:"files"`
+}
+
+type StorageTypeUsageDTO struct {
+	MimeType string `json:"mime_type"`
+	Bytes    int64  `json:"bytes"`
+	Files    int    `json:"files"`
+}
+
+func NewStorageUsageDTO(dm domain.StorageUsage, dict IDict) StorageUsageDTO {
+	projects := lo.Map(dm.Projects, func(item domain.StorageUsageItem, _ int) StorageProjectUsageDTO {
+		name := ""
+		if project, ok := dict.FindProject(item.ProjectUUID); ok {
+			name = project.Name
+		}
+
+		return StorageProjectUsageDTO{
+			UUID:        item.ProjectUUID,
+			Name:        name,
+			CompanyUUID: item.CompanyUUID,
+			Bytes:       item.Bytes,
+			Files:       item.Files,
+		}
+	})
+
+	// companies are summed up from their projects
+	companies := []StorageCompanyUsageDTO{}
+	index := map[uuid.UUID]int{}
+
+	for _, item := range dm.Projects {
+		i, ok := index[item.CompanyUUID]
+		if !ok {
+			name := ""
+			if project, ok := dict.FindProject(item.ProjectUUID); ok {
+				name = project.Company.Name
+			}
+
+			i = len(companies)
+			index[item.CompanyUUID] = i
+			companies = append(companies, StorageCompanyUsageDTO{UUID: item.CompanyUUID, Name: name})
+		}
+
+		companies[i].Bytes += item.Bytes
+		companies[i].Files += item.Files
+	}
+
+	sort.SliceStable(companies, func(i, j int) bool {
+		return companies[i].Bytes > companies[j].Bytes
+	})
+
+	types := lo.Map(dm.Types, func(item domain.StorageUsageItem, _ int) StorageTypeUsageDTO {
+		return StorageTypeUsageDTO{
+			MimeType: item.MimeType,
+			Bytes:    item.Bytes,
+			Files:    item.Files,
+		}
+	})
+
+	return StorageUsageDTO{
+		Quota:     dm.Quota,
+		Bytes:     dm.Bytes,
+		Files:     dm.Files,
+		Companies: companies,
+		Projects:  projects,
+		Types:     types,
+	}
+}
@@ -48,6 +48,10 @@ type TaskDTO struct {
 	Tags        []string  `json:"tags"`
 	CompanyTags []TagDTOs `json:"company_tags"`
 
+	Visibility   string      `json:"visibility"`
+	AccessUsers  []UserDTO   `json:"access_users"`
+	AccessGroups []uuid.UUID `json:"access_groups"`
+
 	Federation FederationDTOs `json:"federation"`
 	Project    ProjectDTOs    `json:"project"`
 
@@ -61,6 +65,7 @@ type TaskDTO struct {
 	CreatedAt  time.Time  `json:"created_at"`
 	FinishedAt *time.Time `json:"finished_at"`
 	FinishedBy *UserDTO   `json:"finished_by,omitempty"`
+	StartAt    *time.Time `json:"start_at"`
 	FinishTo   *time.Time `json:"finish_to"`
 	Duration   int        `json:"duration"`
 
@@ -79,6 +84,8 @@ type TaskDTO struct {
 	ChildrensTotal int         `json:"childrens_total"`
 	ChildrensUUID  []uuid.UUID `json:"childrens_uuid"`
 
+	SourceCommentUUID *uuid.UUID `json:"source_comment_uuid,omitempty"`
+
 	// @todo: renaim
 	LinkedFieldsData map[uuid.UUID]interface{} `json:"linked_fields_data"`
 
@@ -125,6 +132,7 @@ type TaskDTOs struct {
 	FinishedAt *time.Time `json:"finished_at,omitempty" xlsx:"G" ru:"Завершено"`
 	FinishedBy *UserDTO   `json:"finished_by,omitempty"`
 
+	StartAt  *time.Time `json:"start_at,omitempty"`
 	FinishTo *time.Time `json:"finish_to,omitempty"`
 	Duration int        `json:"duration"`
 
@@ -152,6 +160,7 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 	federationDTO, _ := dict.FindFederation(dm.FederationUUID)
 	projectDTO, _ := dict.FindProject(dm.ProjectUUID)
 
+	accessUsers, _ := dict.FindUsers(dm.AccessUsers)
 	coWorkers, _ := dict.FindUsers(dm.CoWorkersBy)
 	watchBy, _ := dict.FindUsers(dm.WatchBy)
 
@@ -238,6 +247,13 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 			URL:       dm.URL,
 			CreatedAt: dm.CreatedAt,
 			CreatedBy: *createdBy,
+
+			PreviewURL: dm.PreviewURL,
+			Width:      dm.Width,
+			Height:     dm.Height,
+			DurationMs: dm.DurationMs,
+			ScanStatus: dm.ScanStatus,
+			SHA256:     dm.SHA256,
 		}
 	})
 
@@ -305,6 +321,11 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 		ImplementBy:   helpers.Empty(*implementBy, fi),
 		Tags:          tags,
 		CompanyTags:   companyTags,
+
+		Visibility:   dm.Visibility,
+		AccessUsers:  accessUsers,
+		AccessGroups: lo.Ternary(dm.AccessGroups == nil, []uuid.UUID{}, dm.AccessGroups),
+
 		Status: StatusDTO{
 			Code: dm.Status,
 			Name: "todo",
@@ -324,11 +345,15 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 		ChildrensTotal: dm.ChildrensTotal,
 		ChildrensUUID:  dm.ChildrensUUID,
 
+		SourceCommentUUID: dm.SourceCommentUUID,
+
 		LinkedFieldsData: linkedFieldsData,
 
 		Stops: dm.Stops,
 
+		StartAt:    dm.StartAt,
 		FinishTo:   dm.FinishTo,
+		Duration:   dm.Duration,
 		FinishedAt: dm.FinishedAt,
 		FinishedBy: helpers.Empty(*finishedBy, fb),
 
@@ -389,7 +414,9 @@ func NewTaskDTOs(dm domain.Task, dict IDict) TaskDTOs {
 
 		ChildrensTotal: dm.ChildrensTotal,
 		FinishedAt:     dm.FinishedAt,
+		StartAt:        dm.StartAt,
 		FinishTo:       dm.FinishTo,
+		Duration:       dm.Duration,
 
 		CreatedAt:  dm.CreatedAt,
 		ActivityAt: dm.ActivityAt,
@@ -445,6 +472,12 @@ type TaskSearchDTO struct {
 
 	Order *string `json:"order"`
 	By    *string `json:"by"`
+
+	Cursor    *string `json:"cursor"`
+	WithTotal bool    `json:"with_total"`
+
+	// ViewerEmail hides tasks the user can not see, nil shows all of them
+	ViewerEmail *string `json:"-"`
 }
 
 func (d *TaskSearchDTO) Validate() error {
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TimelineItemDTO struct {
+	UUID       uuid.UUID  `json:"uuid"`
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	ParentUUID *uuid.UUID `json:"parent_uuid"`
+	Path       []string   `json:"path"`
+	IsEpic     bool       `json:"is_epic"`
+	Status     int        `json:"status"`
+
+	StartAt  *time.Time `json:"start_at"`
+	FinishTo *time.Time `json:"finish_to"`
+	Duration int        `json:"duration"`
+
+	SpanStart  *time.Time `json:"span_start"`
+	SpanFinish *time.Time `json:"span_finish"`
+
+	DependsOn []uuid.UUID `json:"depends_on"`
+}
+
+func NewTimelineItemDTO(dm domain.TimelineItem) TimelineItemDTO {
+	return TimelineItemDTO{
+		UUID:       dm.UUID,
+		ID:         dm.ID,
+		Name:       dm.Name,
+		ParentUUID: dm.ParentUUID,
+		Path:       dm.Path,
+		IsEpic:     dm.IsEpic,
+		Status:     dm.Status,
+		StartAt:    dm.Start,
+		FinishTo:   dm.Finish,
+		Duration:   dm.Duration,
+		SpanStart:  dm.SpanStart,
+		SpanFinish: dm.SpanFinish,
+		DependsOn:  dm.DependsOn,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type UploadDTO struct {
@@ -12,6 +13,13 @@ type UploadDTO struct {
 	EXT  string    `json:"ext"`
 	Size int64     `json:"size"`
 	URL  string    `json:"url"`
+
+	PreviewURL string `json:"preview_url,omitempty"`
+	ScanStatus string `json:"scan_status,omitempty"`
+
+	// SHA256 of the content, the known content is attached by it without
+	// the upload
+	SHA256 string `json:"sha256,omitempty"`
 }
 
 func NewUploadDTO(uid uuid.UUID, name, ext string, size int64, url string) UploadDTO {
@@ -24,6 +32,34 @@ func NewUploadDTO(uid uuid.UUID, name, ext string, size int64, url string) Uploa
 	}
 }
 
+type FileVersionDTO struct {
+	UUID      uuid.UUID `json:"uuid"`
+	Version   int       `json:"version"`
+	Current   bool      `json:"current"`
+	Name      string    `json:"name"`
+	EXT       string    `json:"ext"`
+	Size      int64     `json:"size"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy *UserDTO  `json:"created_by"`
+}
+
+func NewFileVersionDTO(dm domain.File, dict IDict) FileVersionDTO {
+	createdBy, _ := dict.FindUserByUUID(dm.CreatedBy)
+
+	return FileVersionDTO{
+		UUID:      dm.UUID,
+		Version:   dm.Version,
+		Current:   dm.Current,
+		Name:      dm.Name,
+		EXT:       dm.Ext,
+		Size:      dm.Size,
+		URL:       dm.URL,
+		CreatedAt: dm.CreatedAt,
+		CreatedBy: createdBy,
+	}
+}
+
 type FileDTO struct {
 	UUID       uuid.UUID `json:"uuid"`
 	ObjectName string    `json:"object_name"`
@@ -43,6 +79,16 @@ type FileDTOs struct {
 	Size int64     `json:"size"`
 	URL  string    `json:"url"`
 
+	// PreviewURL is empty until the preview is made
+	PreviewURL string `json:"preview_url"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	DurationMs int64  `json:"duration_ms"`
+
+	// ScanStatus is pending, clean or infected, the url works only for clean
+	ScanStatus string `json:"scan_status"`
+	SHA256     string `json:"sha256"`
+
 	CreatedAt time.Time `json:"created_at"`
 	CreatedBy UserDTO   `json:"created_by"`
 }
@@ -64,3 +110,27 @@ type ProfilePhotoDTO struct {
 	Medium string `json:"medium"`
 	Large  string `json:"large"`
 }
+
+type FileSearchDTO struct {
+	File    UploadDTO         `json:"file"`
+	Task    FileSearchTaskDTO `json:"task"`
+	Snippet string            `json:"snippet"`
+}
+
+type FileSearchTaskDTO struct {
+	UUID uuid.UUID `json:"uuid"`
+	ID   int       `json:"id"`
+	Name string    `json:"name"`
+}
+
+func NewFileSearchDTO(dm domain.FileSearchHit) FileSearchDTO {
+	return FileSearchDTO{
+		File: NewUploadDTO(dm.File.UUID, dm.File.Name, dm.File.Ext, dm.File.Size, dm.File.URL),
+		Task: FileSearchTaskDTO{
+			UUID: dm.TaskUUID,
+			ID:   dm.TaskID,
+			Name: dm.TaskName,
+		},
+		Snippet: dm.Snippet,
+	}
+}
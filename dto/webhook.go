@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type WebhookDTO struct {
+	UUID           uuid.UUID `json:"uuid"`
+	FederationUUID uuid.UUID `json:"federation_uuid"`
+
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+
+	IsActive   bool       `json:"is_active"`
+	Failures   int        `json:"failures"`
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewWebhookDTO(dm domain.Webhook) WebhookDTO {
+	return WebhookDTO{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		URL:            dm.URL,
+		Events:         dm.Events,
+		IsActive:       dm.IsActive,
+		Failures:       dm.Failures,
+		DisabledAt:     dm.DisabledAt,
+		CreatedAt:      dm.CreatedAt,
+		UpdatedAt:      dm.UpdatedAt,
+	}
+}
+
+type WebhookDeliveryDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	WebhookUUID uuid.UUID `json:"webhook_uuid"`
+
+	Event   string          `json:"event"`
+	Payload json.RawMessage `json:"payload"`
+
+	Status       string `json:"status"`
+	Attempts     int    `json:"attempts"`
+	ResponseCode int    `json:"response_code"`
+	ResponseBody string `json:"response_body"`
+	Error        string `json:"error"`
+
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewWebhookDeliveryDTO(dm domain.WebhookDelivery) WebhookDeliveryDTO {
+	return WebhookDeliveryDTO{
+		UUID:          dm.UUID,
+		WebhookUUID:   dm.WebhookUUID,
+		Event:         dm.Event,
+		Payload:       dm.Payload,
+		Status:        dm.Status,
+		Attempts:      dm.Attempts,
+		ResponseCode:  dm.ResponseCode,
+		ResponseBody:  dm.ResponseBody,
+		Error:         dm.Error,
+		NextAttemptAt: dm.NextAttemptAt,
+		DeliveredAt:   dm.DeliveredAt,
+		CreatedAt:     dm.CreatedAt,
+		UpdatedAt:     dm.UpdatedAt,
+	}
+}
+
+// WebhookPayloadDTO is the body posted to a webhook subscriber.
+type WebhookPayloadDTO struct {
+	UUID           uuid.UUID   `json:"uuid"`
+	Event          string      `json:"event"`
+	FederationUUID uuid.UUID   `json:"federation_uuid"`
+	CreatedAt      time.Time   `json:"created_at"`
+	Data           interface{} `json:"data"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type WorkloadWeekDTO struct {
+	Start         time.Time `json:"start"`
+	Total         int       `json:"total"`
+	OverAllocated bool      `json:"over_allocated"`
+}
+
+type WorkloadDTO struct {
+	User     UserDTO `json:"user"`
+	Capacity int     `json:"capacity"`
+
+	Total      int            `json:"total"`
+	Overdue    int            `json:"overdue"`
+	NoFinishTo int            `json:"no_finish_to"`
+	ByRole     map[string]int `json:"by_role"`
+	ByStatus   map[int]int    `json:"by_status"`
+	ByPriority map[int]int    `json:"by_priority"`
+
+	Weeks []WorkloadWeekDTO `json:"weeks"`
+	Later int               `json:"later"`
+
+	OverAllocated bool `json:"over_allocated"`
+}
+
+func NewWorkloadDTO(dm domain.Workload, user UserDTO) WorkloadDTO {
+	return WorkloadDTO{
+		User:       user,
+		Capacity:   dm.Capacity,
+		Total:      dm.Total,
+		Overdue:    dm.Overdue,
+		NoFinishTo: dm.NoFinishTo,
+		ByRole:     dm.ByRole,
+		ByStatus:   dm.ByStatus,
+		ByPriority: dm.ByPriority,
+		Weeks: lo.Map(dm.Weeks, func(item domain.WorkloadWeek, _ int) WorkloadWeekDTO {
+			return WorkloadWeekDTO(item)
+		}),
+		Later:         dm.Later,
+		OverAllocated: dm.OverAllocated,
+	}
+}
@@ -35,10 +35,10 @@ func (s *Service) CreateActivity(activity *Activity) error {
 	return s.repo.CreateActivity(activity)
 }
 
-func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int) ([]domain.Activity, int64, error) {
-	orms, total, err := s.repo.GetTaskActivities(taskUID, limit, offset)
+func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int, cursor *string, withTotal bool) ([]domain.Activity, int64, string, error) {
+	orms, total, next, err := s.repo.GetTaskActivities(taskUID, limit, offset, cursor, withTotal)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	return lo.Map(orms, func(orm Activity, _ int) domain.Activity {
@@ -55,5 +55,5 @@ func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int) ([]dom
 			Meta:      orm.Meta,
 			Type:      int(orm.Type),
 		}
-	}), total, nil
+	}), total, next, nil
 }
@@ -27,7 +27,8 @@ type Activity struct {
 
 	Type domain.ActivityType `gorm:"type:integer;default:0;not null"`
 
-	Total int64 `gorm:"->"`
+	Total     int64  `gorm:"->"`
+	CursorKey string `gorm:"->"`
 }
 
 func (j *Meta) Scan(value interface{}) error {
@@ -2,7 +2,9 @@ package activities
 
 import (
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
 )
 
 type Repository struct {
@@ -19,20 +21,64 @@ func (r *Repository) CreateActivity(activity *Activity) error {
 	return r.gorm.DB.Create(activity).Error
 }
 
-func (r *Repository) GetTaskActivities(taskUID uuid.UUID, limit, offset int) (orms []Activity, total int64, err error) {
-	err = r.gorm.DB.
-		Select("*, count(*) OVER() AS total").
+var activityKeyset = helpers.Keyset{
+	Name:    "created_at:desc",
+	Columns: []helpers.KeysetColumn{{Expr: "created_at", Type: "timestamptz"}},
+	UUID:    "uuid",
+	Desc:    true,
+}
+
+// GetTaskActivities returns a page of task activities, by offset or after the
+// cursor. total is -1 when a cursor is given without withTotal.
+func (r *Repository) GetTaskActivities(taskUID uuid.UUID, limit, offset int, cursor *string, withTotal bool) (orms []Activity, total int64, next string, err error) {
+	query := r.gorm.DB.
+		Model(&Activity{}).
 		Where("entity_uuid = ?", taskUID).
-		Where("entity_type = ?", "task").
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
+		Where("entity_type = ?", "task")
+
+	if cursor != nil {
+		total = -1
+		if withTotal {
+			err = query.Session(&gorm.Session{}).Count(&total).Error
+			if err != nil {
+				return orms, total, next, err
+			}
+		}
+
+		c, err := helpers.DecodeCursor(*cursor)
+		if err != nil {
+			return orms, total, next, err
+		}
+
+		where, args, err := activityKeyset.Where(c)
+		if err != nil {
+			return orms, total, next, err
+		}
+
+		query = query.Where(where, args...).Select("*, " + activityKeyset.Select())
+	} else {
+		query = query.Offset(offset).Select("*, count(*) OVER() AS total, " + activityKeyset.Select())
+	}
+
+	err = query.
+		Order(activityKeyset.OrderBy()).
+		Limit(limit + 1).
 		Find(&orms).
 		Error
+	if err != nil {
+		return orms, total, next, err
+	}
 
-	if len(orms) > 0 {
+	if len(orms) > 0 && cursor == nil {
 		total = orms[0].Total
 	}
 
-	return orms, total, err
+	if len(orms) > limit {
+		orms = orms[:limit]
+
+		last := orms[len(orms)-1]
+		next, err = activityKeyset.Next(last.CursorKey, last.UUID)
+	}
+
+	return orms, total, next, err
 }
@@ -219,3 +219,79 @@ func (s *Service) TaskFileWasDeleted(creator domain.Creator, taskUUID uuid.UUID,
 
 	return act, nil
 }
+
+func (s *Service) TaskApprovalVoted(creator domain.Creator, taskUUID uuid.UUID, vote domain.ApprovalVote, status string) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskApprovalVoteDTO{
+		Decision: vote.Decision,
+		Comment:  vote.Comment,
+		Status:   status,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    taskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(domain.ActivityTaskApprovalVote),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityTaskApprovalVote,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+// TaskCommentWasConverted writes the activity on both tasks: the child task
+// created from the comment and the task the comment belongs to.
+func (s *Service) TaskCommentWasConverted(creator domain.Creator, parent, child domain.Task, commentUUID uuid.UUID) error {
+	acts := []*Activity{}
+
+	for _, item := range []struct {
+		typ    domain.ActivityType
+		entity uuid.UUID
+		linked domain.Task
+	}{
+		{domain.ActivityTaskCommentToTask, parent.UUID, child},
+		{domain.ActivityTaskFromComment, child.UUID, parent},
+	} {
+		mp, err := helpers.StructToMap(dto.ActivityTaskCommentLinkDTO{
+			CommentUUID: commentUUID,
+			TaskUUID:    item.linked.UUID,
+			TaskID:      item.linked.ID,
+			TaskName:    item.linked.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		acts = append(acts, &Activity{
+			UUID:          uuid.New(),
+			EntityUUID:    item.entity,
+			EntityType:    "task",
+			Description:   fmt.Sprint(item.typ),
+			CreatedByUUID: creator.UUID,
+			CreatedBy:     creator.Email,
+			Type:          item.typ,
+			Meta:          mp,
+		})
+	}
+
+	for _, act := range acts {
+		err := s.CreateActivity(act)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -76,6 +76,8 @@ type StateDiff struct {
 	NewComments  []dto.CommentDTO  `json:"new_comments"`
 	NewLikes     int               `json:"new_likes"`
 	NewMentions  int               `json:"new_mentions"`
+	NewReactions int               `json:"new_reactions"`
+	NewVotes     int               `json:"new_votes"`
 	NewUploads   []dto.FileDTOs    `json:"new_uploads"`
 	NewReminders []dto.ReminderDTO `json:"new_reminders"`
 
@@ -85,6 +87,8 @@ type StateDiff struct {
 func CompareState(taskDto dto.TaskDTO, userUUID uuid.UUID, fromTime time.Time) StateDiff {
 	newMensions := 0
 	newLikes := 0
+	newReactions := 0
+	newVotes := 0
 	newComments := []dto.CommentDTO{}
 	newUploads := []dto.FileDTOs{}
 	newReminders := []dto.ReminderDTO{}
@@ -116,6 +120,28 @@ func CompareState(taskDto dto.TaskDTO, userUUID uuid.UUID, fromTime time.Time) S
 
 				return false
 			})
+
+			for _, r := range c.Reactions {
+				for _, u := range r.Users {
+					if u.User.UUID != userUUID && time.UnixMicro(u.UnixAt).After(fromTime) {
+						newReactions++
+					}
+				}
+			}
+
+			// votes are news for the author of the poll only
+			if c.Poll != nil && c.CreatedBy != nil && c.CreatedBy.UUID == userUUID {
+				voters := make(map[uuid.UUID]bool)
+				for _, o := range c.Poll.Options {
+					for _, v := range o.Voters {
+						if v.User.UUID != userUUID && time.UnixMicro(v.UnixAt).After(fromTime) {
+							voters[v.User.UUID] = true
+						}
+					}
+				}
+
+				newVotes += len(voters)
+			}
 		}
 	}
 
@@ -146,6 +172,8 @@ func CompareState(taskDto dto.TaskDTO, userUUID uuid.UUID, fromTime time.Time) S
 		NewComments:  newComments,
 		NewMentions:  newMensions,
 		NewLikes:     newLikes,
+		NewReactions: newReactions,
+		NewVotes:     newVotes,
 		NewUploads:   newUploads,
 		NewReminders: newReminders,
 		UpdatedAt:    score,
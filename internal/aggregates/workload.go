@@ -0,0 +1,39 @@
+package aggregates
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// GetWorkload returns open tasks of the federation grouped by people, the
+// weekly capacity of every user is taken from the profile preferences.
+func (s *Service) GetWorkload(ctx context.Context, federationUUID uuid.UUID, projectUUID *uuid.UUID, roles []string, weeks int) ([]domain.Workload, error) {
+	tasks, err := s.ts.GetOpenTasks(ctx, federationUUID, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := []string{}
+	for _, t := range tasks {
+		emails = append(emails, t.ImplementBy, t.ResponsibleBy)
+		emails = append(emails, t.CoWorkersBy...)
+	}
+
+	prefs, err := s.ps.GetPreferencesByEmails(lo.WithoutEmpty(lo.Uniq(emails)))
+	if err != nil {
+		return nil, err
+	}
+
+	capacities := make(map[string]int, len(prefs))
+	for email, p := range prefs {
+		if p.WeeklyCapacity != nil {
+			capacities[email] = *p.WeeklyCapacity
+		}
+	}
+
+	return domain.BuildWorkload(tasks, roles, capacities, time.Now(), weeks), nil
+}
@@ -2,33 +2,43 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"runtime/debug"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/approvals"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/catalogs"
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/dashboard"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/inbound"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/legalentities"
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/mailbox"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/krisch/crm-backend/internal/statistics"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/uploads"
+	"github.com/krisch/crm-backend/internal/webhooks"
 	"github.com/krisch/crm-backend/pkg/redis"
 	"github.com/sirupsen/logrus"
 )
@@ -63,6 +73,13 @@ type App struct {
 	AgentsService        *agents.Service
 	PermissionsService   *permissions.Service
 	LegalEntities        legalentities.Service
+	WebhooksService      *webhooks.Service
+	InboundService       *inbound.Service
+	MailboxService       *mailbox.Service
+	ApprovalsService     *approvals.Service
+	StatisticsService    *statistics.Service
+	DashboardService     *dashboard.Service
+	UploadsService       *uploads.Service
 
 	MetricsCounters *helpers.MetricsCounters
 }
@@ -156,15 +173,240 @@ func (a *App) Work(ctx context.Context, rds *redis.RDS) {
 	a.RedisSubscribe(ctx, rds, "update")
 	a.SyncDictionariesByTimeout()
 	a.SyncDictionariesByHook()
+
+	if a.Options.WEBHOOKS_ENABLE {
+		a.DeliverWebhooks(ctx)
+	}
+
+	if a.Options.MAIL_INGEST_ENABLE {
+		a.IngestMail(ctx)
+	}
+
+	if a.Options.STATISTIC_SNAPSHOT_ENABLE {
+		a.SnapshotStatistics(ctx)
+	}
+
+	if a.Options.UPLOADS_TUS_CLEAN_ENABLE {
+		a.ExpireUploads(ctx)
+	}
+
+	if a.Options.SCAN_DRIVER != "" {
+		a.RescanFiles(ctx)
+	}
+}
+
+func (a *App) DeliverWebhooks(ctx context.Context) {
+	interval := time.Second * time.Duration(a.Options.WEBHOOKS_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.DeliverWebhooks(ctx)
+			}
+		}()
+
+		for ctx.Err() == nil {
+			n, err := a.WebhooksService.DeliverPending(ctx)
+			if err != nil && ctx.Err() == nil {
+				logrus.WithError(err).Error("webhooks delivery error")
+			}
+
+			// a full batch means there may be more due deliveries
+			if n < a.Options.WEBHOOKS_BATCH {
+				select {
+				case <-ctx.Done():
+				case <-time.After(interval):
+				}
+			}
+		}
+	}()
+}
+
+func (a *App) IngestMail(ctx context.Context) {
+	idle := time.Second * 5
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(idle)
+				a.IngestMail(ctx)
+			}
+		}()
+
+		n, err := a.MailboxService.SealPasswords(ctx)
+		if err != nil {
+			logrus.WithError(err).Error("mailbox passwords encryption error")
+		} else if n > 0 {
+			logrus.WithField("mailboxes", n).Info("mailbox passwords encrypted")
+		}
+
+		for ctx.Err() == nil {
+			n, err := a.MailboxService.IngestDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				logrus.WithError(err).Error("mail ingest error")
+			}
+
+			if n == 0 {
+				select {
+				case <-ctx.Done():
+				case <-time.After(idle):
+				}
+			}
+		}
+	}()
+}
+
+// SnapshotStatistics stores project statistics every night at
+// STATISTIC_SNAPSHOT_HOUR as the day that has just ended.
+func (a *App) SnapshotStatistics(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(time.Minute)
+				a.SnapshotStatistics(ctx)
+			}
+		}()
+
+		next := nextSnapshot(time.Now(), a.Options.STATISTIC_SNAPSHOT_HOUR)
+
+		// the clock is checked every minute, so the time spent asleep or a
+		// clock change does not move the snapshot
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if now.Before(next) {
+					continue
+				}
+
+				n, err := a.StatisticsService.Snapshot(ctx, next.AddDate(0, 0, -1))
+				if err != nil {
+					logrus.WithError(err).Error("statistic snapshot error")
+				}
+
+				logrus.Infof("statistic snapshot stored for %d projects", n)
+
+				next = nextSnapshot(now, a.Options.STATISTIC_SNAPSHOT_HOUR)
+			}
+		}
+	}()
+}
+
+// nextSnapshot is the next hour of the day after now.
+func nextSnapshot(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
+
+// ExpireUploads removes the staged chunks of the resumable uploads that were
+// not finished in UPLOADS_TUS_EXPIRE hours.
+func (a *App) ExpireUploads(ctx context.Context) {
+	interval := time.Minute * 10
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.ExpireUploads(ctx)
+			}
+		}()
+
+		for ctx.Err() == nil {
+			n, err := a.UploadsService.ExpireStale(ctx, time.Now())
+			if err != nil {
+				logrus.WithError(err).Error("uploads expire error")
+			}
+
+			if n > 0 {
+				logrus.Infof("%d expired uploads removed", n)
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(interval):
+			}
+		}
+	}()
+}
+
+// RescanFiles hands the files which still wait for the antivirus to the file
+// workers again.
+func (a *App) RescanFiles(ctx context.Context) {
+	interval := time.Minute * 10
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.RescanFiles(ctx)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			n, err := a.S3PrivateService.RescanPending(interval, 100)
+			if err != nil {
+				logrus.WithError(err).Error("files rescan error")
+			}
+
+			if n > 0 {
+				logrus.Infof("%d pending files handed to rescan", n)
+			}
+		}
+	}()
 }
 
 func (a *App) Subscribe(_ context.Context) {
 	a.TaskService.OnTaskUpdatedOrCreated(func(uid uuid.UUID, people []string) error {
 		logrus.Info("task updated or created")
-		err := a.NotificationsService.CreateTaskState(uid, people)
+		people, err := a.TaskService.FilterViewers(context.Background(), uid, people)
+		if err != nil {
+			return err
+		}
+
+		err = a.NotificationsService.CreateTaskState(uid, people)
 		return err
 	})
 
+	a.S3PrivateService.OnFileInfected(func(file s3.File) error {
+		taskUUID := file.TypeUUID
+		if file.Type == "comment" {
+			cm, err := a.CommentService.GetComment(context.Background(), file.TypeUUID)
+			if err != nil {
+				return err
+			}
+
+			taskUUID = cm.TaskUUID
+		}
+
+		user, ok := a.DictionaryService.FindUserByUUID(file.CreatedBy)
+		if !ok {
+			return fmt.Errorf("file uploader not found: %s", file.CreatedBy)
+		}
+
+		return a.NotificationsService.CreateFileInfected(user.Email, taskUUID)
+	})
+
 	a.TaskService.OnOpenTask(func(uid uuid.UUID, email string) error {
 		logrus.Info("task was open")
 		err := a.NotificationsService.RemoveNotification(email, "task", uid)
@@ -173,7 +415,65 @@ func (a *App) Subscribe(_ context.Context) {
 
 	a.RemindersService.OnReminderWasUpdatedOrCreated(func(uid, taskUUID uuid.UUID, people []string) error {
 		logrus.Info("reminder updated or created: ", uid)
-		err := a.NotificationsService.CreateTaskState(taskUUID, people)
+		people, err := a.TaskService.FilterViewers(context.Background(), taskUUID, people)
+		if err != nil {
+			return err
+		}
+
+		err = a.NotificationsService.CreateTaskState(taskUUID, people)
 		return err
 	})
+
+	a.TaskService.OnStatusChange(func(task domain.Task, status int) error {
+		return a.ApprovalsService.CheckStatus(context.Background(), task, status)
+	})
+
+	a.TaskService.OnStatusChanged(func(task domain.Task) error {
+		return a.ApprovalsService.StatusChanged(context.Background(), task)
+	})
+
+	a.TaskService.OnTaskEvent(func(event domain.WebhookEvent, task domain.Task) error {
+		data, err := a.TaskService.ConvertToDto(task)
+		if err != nil {
+			return err
+		}
+
+		return a.WebhooksService.Emit(context.Background(), task, event, data)
+	})
+
+	a.TaskService.OnCommentCreated(func(task domain.Task, cm domain.Comment) error {
+		return a.WebhooksService.Emit(context.Background(), task, domain.WebhookEventCommentCreated, map[string]interface{}{
+			"task_uuid": task.UUID,
+			"comment":   dto.NewCommentDTO(cm, a.DictionaryService, a.ProfileService),
+		})
+	})
+
+	a.RemindersService.OnReminderCreated(func(r domain.Reminder) error {
+		task, err := a.TaskService.GetTask(context.Background(), r.TaskUUID, []string{})
+		if err != nil {
+			return err
+		}
+
+		var user *dto.UserDTO
+		if r.UserUUID != nil {
+			user, _ = a.DictionaryService.FindUserByUUID(*r.UserUUID)
+		}
+
+		createdBy, _ := a.DictionaryService.FindUserByUUID(r.CreatedByUUID)
+
+		return a.WebhooksService.Emit(context.Background(), task, domain.WebhookEventReminderCreated, dto.ReminderDTO{
+			UUID:        r.UUID,
+			TaskUUID:    r.TaskUUID,
+			Description: r.Description,
+			Comment:     r.Comment,
+			DateTo:      r.DateTo,
+			DateFrom:    r.DateFrom,
+			Type:        r.Type,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+			User:        user,
+			CreatedBy:   createdBy,
+			Status:      r.Status,
+		})
+	})
 }
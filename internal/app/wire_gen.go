@@ -7,33 +7,42 @@
 package app
 
 import (
+	"time"
+
 	"github.com/Shopify/sarama"
 	"github.com/google/wire"
 	"github.com/krisch/crm-backend/internal/activities"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/approvals"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/catalogs"
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/company"
 	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/dashboard"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/inbound"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/kafka"
 	"github.com/krisch/crm-backend/internal/legalentities"
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/mailbox"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/krisch/crm-backend/internal/statistics"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/uploads"
+	"github.com/krisch/crm-backend/internal/webhooks"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 	"gorm.io/gorm"
@@ -68,7 +77,10 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	dictionaryRepository := dictionary.NewRepository(gdb, rds, metricsCounters)
 	conf := s3Conf(configsConfigs)
 	s3Repository := s3.NewRepository(gdb)
-	s3Service := s3.New(conf, s3Repository)
+	s3Service, err := s3.New(conf, s3Repository)
+	if err != nil {
+		return nil, err
+	}
 	dictionaryService := dictionary.New(dictionaryRepository, metricsCounters, s3Service)
 	profileRepository := profile.NewRepository(gdb, rds, metricsCounters)
 	profileService := profile.New(configsConfigs, profileRepository, s3Service, dictionaryService)
@@ -79,7 +91,10 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	activitiesService := activities.New(activitiesRepository, dictionaryService)
 	commentsRepository := comments.NewRepository(gdb, rds, metricsCounters, cacheService)
 	confPrivate := s3PrivateConf(configsConfigs)
-	servicePrivate := s3.NewPrivate(confPrivate, s3Repository, cacheService)
+	servicePrivate, err := s3.NewPrivate(confPrivate, s3Repository, cacheService)
+	if err != nil {
+		return nil, err
+	}
 	commentsService := comments.New(commentsRepository, dictionaryService, servicePrivate, activitiesService)
 	taskService := task.New(taskRepository, dictionaryService, activitiesService, profileService, commentsService, servicePrivate)
 	remindersRepository := reminders.NewRepository(gdb)
@@ -113,7 +128,20 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	bankAccountSender := kafka.NewBankAccountSender(syncProducer)
 	legalentitiesRepository := provideLegalEntityRepo(db, legalEntitySender, bankAccountSender)
 	legalentitiesService := legalentities.NewService(legalentitiesRepository)
-	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService, legalentitiesService)
+	webhooksRepository := webhooks.NewRepository(gdb)
+	webhooksService := webhooks.New(webhooksRepository, federationService, configsConfigs)
+	inboundRepository := inbound.NewRepository(gdb)
+	inboundService := inbound.New(inboundRepository, dictionaryService, taskService)
+	mailboxRepository := mailbox.NewRepository(gdb)
+	mailboxService := mailbox.New(mailboxRepository, dictionaryService, taskService, servicePrivate, configsConfigs)
+	approvalsRepository := approvals.NewRepository(gdb)
+	approvalsService := approvals.New(approvalsRepository, taskService, federationService, aggregatesService, activitiesService, notificationsService)
+	statisticsRepository := statistics.NewRepository(gdb)
+	statisticsService := statistics.New(statisticsRepository, federationService, taskService)
+	uploadsRepository := uploads.NewRepository(gdb)
+	uploadsService := uploads.New(uploadsRepository, servicePrivate, configsConfigs)
+	dashboardService := dashboard.New(taskService, commentsService, remindersService, notificationsService, profileService, dictionaryService, cacheService)
+	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService, legalentitiesService, webhooksService, inboundService, mailboxService, approvalsService, statisticsService, dashboardService, uploadsService)
 	return app, nil
 }
 
@@ -139,6 +167,7 @@ func provideLegalEntityRepo(db *gorm.DB, l *kafka.LegalEntitySender, b *kafka.Ba
 
 func s3Conf(conf *configs.Configs) s3.Conf {
 	return s3.Conf{
+		Driver:          conf.STORAGE_DRIVER,
 		Endpoint:        conf.CDN_PUBLIC_ENDPOINT,
 		AccessKeyID:     conf.CDN_PUBLIC_ACCESS_KEY_ID,
 		SecretAccessKey: conf.CDN_PUBLIC_SECRET_ACCESS_KEY,
@@ -146,11 +175,16 @@ func s3Conf(conf *configs.Configs) s3.Conf {
 		Location:        conf.CDN_PUBLIC_REGION,
 		UseSSL:          conf.CDN_PUBLIC_SSL,
 		PublicURL:       conf.CDN_PUBLIC_URL,
+
+		LocalPath:  conf.STORAGE_LOCAL_PATH,
+		BackendURL: conf.URL_BACKEND,
+		Secret:     conf.STORAGE_SECRET,
 	}
 }
 
 func s3PrivateConf(conf *configs.Configs) s3.ConfPrivate {
 	return s3.ConfPrivate{
+		Driver:          conf.STORAGE_DRIVER,
 		Endpoint:        conf.CDN_PRIVATE_ENDPOINT,
 		AccessKeyID:     conf.CDN_PRIVATE_ACCESS_KEY_ID,
 		SecretAccessKey: conf.CDN_PRIVATE_SECRET_ACCESS_KEY,
@@ -159,6 +193,23 @@ func s3PrivateConf(conf *configs.Configs) s3.ConfPrivate {
 		UseSSL:          conf.CDN_PRIVATE_SSL,
 		PublicURL:       conf.CDN_PRIVATE_URL,
 		BackendURL:      conf.URL_BACKEND,
+
+		LocalPath: conf.STORAGE_LOCAL_PATH,
+		Secret:    conf.STORAGE_SECRET,
+
+		Quota: int64(conf.STORAGE_QUOTA) << 20,
+
+		FileWorkers: conf.FILE_WORKERS,
+		FileQueue:   conf.FILE_QUEUE,
+
+		PreviewWidth:     conf.PREVIEW_WIDTH,
+		PreviewPdftoppm:  conf.PREVIEW_PDFTOPPM,
+		PreviewPdftotext: conf.PREVIEW_PDFTOTEXT,
+		PreviewFfprobe:   conf.PREVIEW_FFPROBE,
+
+		ScanDriver:    conf.SCAN_DRIVER,
+		ScanClamdAddr: conf.SCAN_CLAMD_ADDR,
+		ScanTimeout:   time.Second * time.Duration(conf.SCAN_TIMEOUT),
 	}
 }
 
@@ -189,6 +240,13 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
 	legalentitiesService legalentities.Service,
+	webhooksService *webhooks.Service,
+	inboundService *inbound.Service,
+	mailboxService *mailbox.Service,
+	approvalsService *approvals.Service,
+	statisticsService *statistics.Service,
+	dashboardService *dashboard.Service,
+	uploadsService *uploads.Service,
 
 ) *App {
 	w := &App{
@@ -233,6 +291,13 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
 	w.LegalEntities = legalentitiesService
+	w.WebhooksService = webhooksService
+	w.InboundService = inboundService
+	w.MailboxService = mailboxService
+	w.ApprovalsService = approvalsService
+	w.StatisticsService = statisticsService
+	w.DashboardService = dashboardService
+	w.UploadsService = uploadsService
 
 	return w
 }
@@ -0,0 +1,227 @@
+package approvals
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/activities"
+	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/federation"
+	"github.com/krisch/crm-backend/internal/notifications"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const notificationKind = "approval"
+
+type Service struct {
+	repo *Repository
+	ts   *task.Service
+	fs   *federation.Service
+	aggs *aggregates.Service
+	as   *activities.Service
+	ns   *notifications.Service
+}
+
+func New(repo *Repository, ts *task.Service, fs *federation.Service, aggs *aggregates.Service, as *activities.Service, ns *notifications.Service) *Service {
+	return &Service{
+		repo: repo,
+		ts:   ts,
+		fs:   fs,
+		aggs: aggs,
+		as:   as,
+		ns:   ns,
+	}
+}
+
+func (s *Service) GetPolicy(_ context.Context, projectUUID uuid.UUID) (domain.ApprovalPolicy, error) {
+	return s.repo.GetPolicyByProject(projectUUID)
+}
+
+func (s *Service) SavePolicy(_ context.Context, dm *domain.ApprovalPolicy) error {
+	err := dm.Validate()
+	if err != nil {
+		return err
+	}
+
+	return s.repo.SavePolicy(dm)
+}
+
+func (s *Service) DeletePolicy(_ context.Context, projectUUID uuid.UUID) error {
+	return s.repo.DeletePolicy(projectUUID)
+}
+
+func (s *Service) GetApprovals(_ context.Context, taskUUID uuid.UUID) ([]domain.TaskApproval, error) {
+	return s.repo.GetApprovals(taskUUID)
+}
+
+// CheckStatus rejects the manual move to StatusDone while the round is
+// pending, only the votes resolve it. The other moves cancel the round.
+func (s *Service) CheckStatus(_ context.Context, t domain.Task, status int) error {
+	if status != domain.StatusDone {
+		return nil
+	}
+
+	_, err := s.repo.GetPolicyByProject(t.ProjectUUID)
+
+	var notFoundErr dto.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	pending, err := s.repo.HasPending(t.UUID)
+	if err != nil {
+		return err
+	}
+
+	if pending {
+		return domain.ErrApprovalActive
+	}
+
+	return nil
+}
+
+// StatusChanged starts a review round when the task moves to
+// StatusNeedReview and cancels the pending one on any other move.
+func (s *Service) StatusChanged(ctx context.Context, t domain.Task) error {
+	if t.Status != domain.StatusNeedReview {
+		return s.cancel(t.UUID)
+	}
+
+	policy, err := s.repo.GetPolicyByProject(t.ProjectUUID)
+
+	var notFoundErr dto.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = s.cancel(t.UUID)
+	if err != nil {
+		return err
+	}
+
+	approvers, err := s.approvers(ctx, t.UUID, policy)
+	if err != nil {
+		return err
+	}
+
+	dm, err := domain.NewTaskApproval(t.UUID, policy, approvers)
+	if err != nil {
+		logrus.WithField("task_uuid", t.UUID).Warn("approval is not started: ", err)
+		return nil
+	}
+
+	err = s.repo.CreateApproval(dm)
+	if err != nil {
+		return err
+	}
+
+	for _, email := range dm.Approvers {
+		err = s.ns.CreateApproval(email, t.UUID)
+		if err != nil {
+			logrus.Error("CreateApproval notification error: ", err)
+		}
+	}
+
+	return nil
+}
+
+// Vote records the decision of the approver. When the round is resolved the
+// task is moved to StatusDone or back to StatusInWork.
+func (s *Service) Vote(ctx context.Context, crtr domain.Creator, t domain.Task, decision, comment string) (dm domain.TaskApproval, err error) {
+	dm, vote, err := s.repo.Vote(t.UUID, func(dm *domain.TaskApproval) (domain.ApprovalVote, error) {
+		return dm.Vote(domain.Me{UUID: crtr.UUID, Email: crtr.Email}, decision, comment)
+	})
+	if err != nil {
+		return dm, err
+	}
+
+	_, err = s.as.TaskApprovalVoted(crtr, t.UUID, vote, dm.Status)
+	if err != nil {
+		logrus.Error("TaskApprovalVoted activity error: ", err)
+	}
+
+	err = s.ns.RemoveNotification(crtr.Email, notificationKind, t.UUID)
+	if err != nil {
+		logrus.Error("RemoveNotification error: ", err)
+	}
+
+	if dm.Status == domain.ApprovalPending {
+		return dm, nil
+	}
+
+	s.removeNotifications(dm)
+
+	if t.Status != domain.StatusNeedReview {
+		return dm, nil
+	}
+
+	status := domain.StatusDone
+	text := "Согласовано"
+
+	if dm.Status == domain.ApprovalRejected {
+		status = domain.StatusInWork
+		text = "Отклонено: " + vote.Comment
+	}
+
+	project, err := s.aggs.GetProject(ctx, t.ProjectUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	_, _, err = s.ts.PatchStatus(crtr, project, t, status, text)
+	if err != nil {
+		logrus.WithField("task_uuid", t.UUID).Error("approval status change error: ", err)
+	}
+
+	return dm, nil
+}
+
+func (s *Service) approvers(ctx context.Context, taskUUID uuid.UUID, policy domain.ApprovalPolicy) ([]string, error) {
+	emails := append([]string{}, policy.Approvers...)
+
+	for _, groupUUID := range policy.Groups {
+		users, err := s.fs.GetGroupUsers(ctx, groupUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, lo.Map(users, func(u domain.User, _ int) string {
+			return u.Email
+		})...)
+	}
+
+	return s.ts.FilterViewers(ctx, taskUUID, lo.Uniq(emails))
+}
+
+func (s *Service) cancel(taskUUID uuid.UUID) error {
+	dm, found, err := s.repo.CancelPending(taskUUID)
+	if err != nil || !found {
+		return err
+	}
+
+	s.removeNotifications(dm)
+
+	return nil
+}
+
+func (s *Service) removeNotifications(dm domain.TaskApproval) {
+	for _, email := range dm.Approvers {
+		err := s.ns.RemoveNotification(email, notificationKind, dm.TaskUUID)
+		if err != nil {
+			logrus.Error("RemoveNotification error: ", err)
+		}
+	}
+}
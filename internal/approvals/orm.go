@@ -0,0 +1,107 @@
+package approvals
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+)
+
+type ApprovalPolicy struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+	ProjectUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Approvers pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+	Groups    pq.StringArray `gorm:"type:uuid[];default:'{}';not null;"`
+	Rule      string         `gorm:"type:varchar(20);default:'any';not null;"`
+	Quorum    int            `gorm:"type:integer;default:0;not null;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+func (o ApprovalPolicy) toDomain() domain.ApprovalPolicy {
+	return domain.ApprovalPolicy{
+		UUID:           o.UUID,
+		FederationUUID: o.FederationUUID,
+		CompanyUUID:    o.CompanyUUID,
+		ProjectUUID:    o.ProjectUUID,
+		CreatedBy:      o.CreatedBy,
+		CreatedByUUID:  o.CreatedByUUID,
+		Approvers:      o.Approvers,
+		Groups: lo.FilterMap(o.Groups, func(item string, _ int) (uuid.UUID, bool) {
+			uid, err := uuid.Parse(item)
+			return uid, err == nil
+		}),
+		Rule:      o.Rule,
+		Quorum:    o.Quorum,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+type TaskApproval struct {
+	UUID       uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	TaskUUID   uuid.UUID `gorm:"type:uuid;not null;"`
+	PolicyUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Rule      string         `gorm:"type:varchar(20);not null;"`
+	Required  int            `gorm:"type:integer;not null;"`
+	Approvers pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+	Status    string         `gorm:"type:varchar(20);default:'pending';not null;"`
+
+	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	ResolvedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+func (o TaskApproval) toDomain(votes []TaskApprovalVote) domain.TaskApproval {
+	return domain.TaskApproval{
+		UUID:       o.UUID,
+		TaskUUID:   o.TaskUUID,
+		PolicyUUID: o.PolicyUUID,
+		Rule:       o.Rule,
+		Required:   o.Required,
+		Approvers:  o.Approvers,
+		Status:     o.Status,
+		Votes: lo.Map(votes, func(item TaskApprovalVote, _ int) domain.ApprovalVote {
+			return item.toDomain()
+		}),
+		CreatedAt:  o.CreatedAt,
+		ResolvedAt: o.ResolvedAt,
+	}
+}
+
+type TaskApprovalVote struct {
+	UUID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	ApprovalUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	TaskUUID     uuid.UUID `gorm:"type:uuid;not null;"`
+	Email        string    `gorm:"type:varchar(100);not null;"`
+	UserUUID     uuid.UUID `gorm:"type:uuid;not null;"`
+	Decision     string    `gorm:"type:varchar(20);not null;"`
+	Comment      string    `gorm:"type:text;default:'';not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (o TaskApprovalVote) toDomain() domain.ApprovalVote {
+	return domain.ApprovalVote{
+		UUID:         o.UUID,
+		ApprovalUUID: o.ApprovalUUID,
+		TaskUUID:     o.TaskUUID,
+		Email:        o.Email,
+		UserUUID:     o.UserUUID,
+		Decision:     o.Decision,
+		Comment:      o.Comment,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
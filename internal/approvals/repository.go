@@ -0,0 +1,244 @@
+package approvals
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) GetPolicyByProject(projectUUID uuid.UUID) (dm domain.ApprovalPolicy, err error) {
+	orm := ApprovalPolicy{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Take(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("политика согласования не найдена")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return orm.toDomain(), nil
+}
+
+// SavePolicy replaces the policy of the project. Rounds already started keep
+// the approvers they were created with.
+func (r *Repository) SavePolicy(dm *domain.ApprovalPolicy) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&ApprovalPolicy{}).
+			Where("project_uuid = ?", dm.ProjectUUID).
+			Where("deleted_at is null").
+			Update("deleted_at", "now()").Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&ApprovalPolicy{
+			UUID:           dm.UUID,
+			FederationUUID: dm.FederationUUID,
+			CompanyUUID:    dm.CompanyUUID,
+			ProjectUUID:    dm.ProjectUUID,
+			CreatedBy:      dm.CreatedBy,
+			CreatedByUUID:  dm.CreatedByUUID,
+			Approvers:      dm.Approvers,
+			Groups: helpers.Map(dm.Groups, func(item uuid.UUID, _ int) string {
+				return item.String()
+			}),
+			Rule:   dm.Rule,
+			Quorum: dm.Quorum,
+		}).Error
+	})
+}
+
+func (r *Repository) DeletePolicy(projectUUID uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&ApprovalPolicy{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("политика согласования не найдена")
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateApproval(dm *domain.TaskApproval) error {
+	return r.gorm.DB.Create(&TaskApproval{
+		UUID:       dm.UUID,
+		TaskUUID:   dm.TaskUUID,
+		PolicyUUID: dm.PolicyUUID,
+		Rule:       dm.Rule,
+		Required:   dm.Required,
+		Approvers:  dm.Approvers,
+		Status:     dm.Status,
+		CreatedAt:  dm.CreatedAt,
+	}).Error
+}
+
+// GetApprovals returns all rounds of the task with their votes, newest first.
+func (r *Repository) GetApprovals(taskUUID uuid.UUID) (dms []domain.TaskApproval, err error) {
+	orms := []TaskApproval{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Order("created_at desc").
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	votes := []TaskApprovalVote{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Order("created_at").
+		Find(&votes).Error
+	if err != nil {
+		return dms, err
+	}
+
+	byApproval := make(map[uuid.UUID][]TaskApprovalVote)
+	for _, v := range votes {
+		byApproval[v.ApprovalUUID] = append(byApproval[v.ApprovalUUID], v)
+	}
+
+	dms = helpers.Map(orms, func(item TaskApproval, _ int) domain.TaskApproval {
+		return item.toDomain(byApproval[item.UUID])
+	})
+
+	return dms, nil
+}
+
+// Vote applies the vote to the pending round of the task and saves it in one
+// transaction. The round row is locked first, so the concurrent votes are
+// counted one after another and the round is resolved once.
+func (r *Repository) Vote(taskUUID uuid.UUID, apply func(dm *domain.TaskApproval) (domain.ApprovalVote, error)) (dm domain.TaskApproval, vote domain.ApprovalVote, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		orm := TaskApproval{}
+
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("task_uuid = ?", taskUUID).
+			Where("status = ?", domain.ApprovalPending).
+			Take(&orm).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.NotFoundErr("согласование не найдено")
+		}
+
+		if err != nil {
+			return err
+		}
+
+		votes := []TaskApprovalVote{}
+
+		err = tx.
+			Where("approval_uuid = ?", orm.UUID).
+			Order("created_at").
+			Find(&votes).Error
+		if err != nil {
+			return err
+		}
+
+		dm = orm.toDomain(votes)
+
+		vote, err = apply(&dm)
+		if err != nil {
+			return err
+		}
+
+		err = saveVote(tx, vote)
+		if err != nil {
+			return err
+		}
+
+		if dm.Status == domain.ApprovalPending {
+			return nil
+		}
+
+		return tx.
+			Model(&TaskApproval{}).
+			Where("uuid = ?", dm.UUID).
+			Updates(map[string]interface{}{
+				"status":      dm.Status,
+				"resolved_at": dm.ResolvedAt,
+			}).Error
+	})
+
+	return dm, vote, err
+}
+
+func saveVote(tx *gorm.DB, vote domain.ApprovalVote) error {
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "approval_uuid"}, {Name: "email"}},
+			DoUpdates: clause.AssignmentColumns([]string{"decision", "comment", "updated_at"}),
+		}).
+		Create(&TaskApprovalVote{
+			UUID:         vote.UUID,
+			ApprovalUUID: vote.ApprovalUUID,
+			TaskUUID:     vote.TaskUUID,
+			Email:        vote.Email,
+			UserUUID:     vote.UserUUID,
+			Decision:     vote.Decision,
+			Comment:      vote.Comment,
+			CreatedAt:    vote.CreatedAt,
+			UpdatedAt:    vote.UpdatedAt,
+		}).Error
+}
+
+// HasPending is true when the task has the round waiting for the votes.
+func (r *Repository) HasPending(taskUUID uuid.UUID) (bool, error) {
+	var n int64
+
+	err := r.gorm.DB.
+		Model(&TaskApproval{}).
+		Where("task_uuid = ?", taskUUID).
+		Where("status = ?", domain.ApprovalPending).
+		Count(&n).Error
+
+	return n > 0, err
+}
+
+func (r *Repository) CancelPending(taskUUID uuid.UUID) (dm domain.TaskApproval, found bool, err error) {
+	orms := []TaskApproval{}
+
+	err = r.gorm.DB.Raw(`
+		UPDATE task_approvals SET status = ?, resolved_at = ?
+		WHERE task_uuid = ? AND status = ?
+		RETURNING *`, domain.ApprovalCanceled, time.Now(), taskUUID, domain.ApprovalPending).Scan(&orms).Error
+
+	if err != nil || len(orms) == 0 {
+		return dm, false, err
+	}
+
+	return orms[0].toDomain(nil), true, nil
+}
@@ -11,6 +11,8 @@ type Service struct {
 	cacheTaskTTL          int
 	cacheTasksTTL         int
 	cachePresignedURLsTTL int
+	cacheEpicProgressTTL  int
+	cacheMyDayTTL         int
 }
 
 func New(repo *Repository, opt *configs.Configs) *Service {
@@ -24,5 +26,7 @@ func New(repo *Repository, opt *configs.Configs) *Service {
 		cacheTaskTTL:          opt.CACHE_TASK,
 		cacheTasksTTL:         opt.CACHE_TASKS,
 		cachePresignedURLsTTL: opt.CACHE_PRE_SIGNED_URLS,
+		cacheEpicProgressTTL:  opt.CACHE_EPIC_PROGRESS,
+		cacheMyDayTTL:         opt.CACHE_MY_DAY,
 	}
 }
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/sirupsen/logrus"
+)
+
+func myDayKey(userUUID uuid.UUID) string {
+	return "my-day:" + userUUID.String()
+}
+
+func (s *Service) CacheMyDay(ctx context.Context, userUUID uuid.UUID, dm domain.MyDay) {
+	if s.cacheMyDayTTL == 0 {
+		logrus.WithField("cache", "my_day").Debug("cache is disabled")
+		return
+	}
+
+	js, err := json.Marshal(dm)
+	if err != nil {
+		logrus.Error("[module:cache] CacheMyDay: ", err)
+		return
+	}
+
+	err = s.repo.rds.SetStr(ctx, myDayKey(userUUID), string(js), s.cacheMyDayTTL)
+	if err != nil {
+		logrus.Error("[module:cache] CacheMyDay: ", err)
+	}
+}
+
+func (s *Service) GetMyDay(ctx context.Context, userUUID uuid.UUID) (dm domain.MyDay, found bool) {
+	if s.cacheMyDayTTL == 0 {
+		return dm, false
+	}
+
+	js, err := s.repo.rds.GetStr(ctx, myDayKey(userUUID))
+	if err != nil {
+		logrus.Error("[module:cache] GetMyDay: ", err)
+		return dm, false
+	}
+
+	if js == "" {
+		return dm, false
+	}
+
+	err = json.Unmarshal([]byte(js), &dm)
+	if err != nil {
+		logrus.Error("[module:cache] GetMyDay: unmarshal err: ", err)
+		return dm, false
+	}
+
+	return dm, true
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// Progress of an epic is kept in one hash per epic with a field per viewer
+// and weight field, so a change in the subtree drops all variants at once.
+// The viewers see different subtrees as the hidden tasks are not counted.
+func progressKey(uid uuid.UUID) string {
+	return "progress:" + uid.String()
+}
+
+func progressField(viewer, weightField string) string {
+	return "viewer:" + viewer + "|weight:" + weightField
+}
+
+func (s *Service) CacheEpicProgress(ctx context.Context, viewer string, dm domain.EpicProgress) {
+	if s.cacheEpicProgressTTL == 0 {
+		logrus.WithField("cache", "epic_progress").Debug("cache is disabled")
+		return
+	}
+
+	js, err := json.Marshal(dm)
+	if err != nil {
+		logrus.Error("[module:cache] CacheEpicProgress: ", err)
+		return
+	}
+
+	err = s.repo.rds.HSET(ctx, progressKey(dm.UUID), progressField(viewer, dm.WeightField), string(js))
+	if err != nil {
+		logrus.Error("[module:cache] CacheEpicProgress: ", err)
+		return
+	}
+
+	err = s.repo.rds.Expire(ctx, progressKey(dm.UUID), s.cacheEpicProgressTTL)
+	if err != nil {
+		logrus.Error("[module:cache] CacheEpicProgress: ", err)
+	}
+}
+
+func (s *Service) GetEpicProgress(ctx context.Context, uid uuid.UUID, viewer, weightField string) (dm domain.EpicProgress, found bool) {
+	if s.cacheEpicProgressTTL == 0 {
+		return dm, false
+	}
+
+	js, err := s.repo.rds.HGet(ctx, progressKey(uid), progressField(viewer, weightField))
+	if err != nil {
+		logrus.Error("[module:cache] GetEpicProgress: ", err)
+		return dm, false
+	}
+
+	if js == "" {
+		return dm, false
+	}
+
+	err = json.Unmarshal([]byte(js), &dm)
+	if err != nil {
+		logrus.Error("[module:cache] GetEpicProgress: unmarshal err: ", err)
+		return dm, false
+	}
+
+	return dm, true
+}
+
+func (s *Service) ClearEpicProgress(ctx context.Context, uids []uuid.UUID) {
+	for _, uid := range uids {
+		err := s.repo.rds.Del(ctx, progressKey(uid))
+		if err != nil {
+			logrus.Error("[module:cache] ClearEpicProgress: ", err)
+		}
+	}
+}
@@ -161,7 +161,7 @@ func (s *Service) GetSortFields(catalogUUID uuid.UUID) []string {
 	return allowOrder
 }
 
-func (s *Service) GetData(search dto.CatalogSearchDTO) (dmns []domain.CatalogData, total int64, err error) {
+func (s *Service) GetData(search dto.CatalogSearchDTO) (dmns []domain.CatalogData, total int64, next string, err error) {
 	allowOrder := s.GetSortFields(search.CatalogUUID)
 
 	return s.repo.GetData(search, allowOrder)
@@ -98,5 +98,6 @@ type CatalogData struct {
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
 
-	Total int64 `gorm:"->"`
+	Total     int64  `gorm:"->"`
+	CursorKey string `gorm:"->"`
 }
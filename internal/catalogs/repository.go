@@ -358,20 +358,13 @@ func (r *Repository) AddData(dm domain.CatalogData) (orm CatalogData, err error)
 	return orm, err
 }
 
-func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (dms []domain.CatalogData, total int64, err error) {
+func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (dms []domain.CatalogData, total int64, next string, err error) {
 	defer r.storeTime("GetData", tm())
 
 	orms := []CatalogData{}
 
-	offset := 0
-	limit := 25
-	if filter.Limit != nil {
-		limit = *filter.Limit
-	}
-
-	if filter.Offset != nil {
-		offset = *filter.Offset
-	}
+	offset := helpers.Deref(filter.Offset, 0)
+	limit := helpers.Deref(filter.Limit, 25)
 
 	sqlWhere := ""
 	if len(filter.Fields) > 0 {
@@ -397,22 +390,42 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 
 	query := r.gorm.DB
 
-	orderStr := "order by created_at desc"
-	if len(allowSort) > 0 && filter.Order != nil {
-		by := "desc"
-		if filter.By != nil && *filter.By == "asc" {
-			by = "asc"
-		}
+	ks := catalogDataKeyset(filter, allowSort)
+
+	args := []interface{}{filter.CatalogUUID}
+	totalStr := ", count(*) OVER() AS total"
 
-		if helpers.InArray(*filter.Order, allowSort) {
-			if strings.HasPrefix(*filter.Order, "fields.") {
-				*filter.Order = "fields->>'" + strings.Replace(*filter.Order, "fields.", "", 1) + "'"
+	if filter.Cursor != nil {
+		total = -1
+		if filter.WithTotal {
+			err = r.gorm.DB.
+				Raw("select count(*) from catalog_data o where o.catalog_uuid = ? "+sqlWhere, filter.CatalogUUID).
+				Scan(&total).Error
+			if err != nil {
+				return dms, -1, next, err
 			}
+		}
 
-			orderStr = "order by " + *filter.Order + " " + by
+		c, err := helpers.DecodeCursor(*filter.Cursor)
+		if err != nil {
+			return dms, -1, next, err
+		}
+
+		where, whereArgs, err := ks.Where(c)
+		if err != nil {
+			return dms, -1, next, err
 		}
+
+		sqlWhere += " and " + where
+		args = append(args, whereArgs...)
+		totalStr = ""
+		offset = 0
 	}
 
+	args = append(args, limit+1, offset)
+
+	orderStr := "order by " + ks.OrderBy()
+
 	// @todo: add federation limit
 	query = query.Raw(` 
 			with rich as (
@@ -429,7 +442,7 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 				
 			GROUP BY o.uuid 
 			) 
-			select  o.*, rich.entities_rich, count(*) OVER() AS total
+			select  o.*, rich.entities_rich`+totalStr+", "+ks.Select()+`
 				from catalog_data o
 				left join rich on o.uuid = rich.uuid  
 				where  
@@ -439,7 +452,7 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 					limit ? offset ?
 
 					 
-			`, filter.CatalogUUID, limit, offset)
+			`, args...)
 
 	sql := query.ToSQL(func(tx *gorm.DB) *gorm.DB {
 		return tx.Find(&orms)
@@ -450,13 +463,23 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 	result := query.Scan(&orms)
 
 	if result.Error != nil {
-		return dms, -1, result.Error
+		return dms, -1, next, result.Error
 	}
 
-	if len(orms) > 0 {
+	if len(orms) > 0 && filter.Cursor == nil {
 		total = orms[0].Total
 	}
 
+	if len(orms) > limit {
+		orms = orms[:limit]
+
+		last := orms[len(orms)-1]
+		next, err = ks.Next(last.CursorKey, last.UUID)
+		if err != nil {
+			return dms, total, next, err
+		}
+	}
+
 	dms = helpers.Map(orms, func(item CatalogData, _ int) domain.CatalogData {
 		return domain.CatalogData{
 			UUID: item.UUID,
@@ -478,7 +501,33 @@ func (r *Repository) GetData(filter dto.CatalogSearchDTO, allowSort []string) (d
 		}
 	})
 
-	return dms, total, nil
+	return dms, total, next, nil
+}
+
+// catalogDataKeyset returns the requested data ordering, created_at desc by
+// default. Columns are prefixed with the alias used in GetData.
+func catalogDataKeyset(filter dto.CatalogSearchDTO, allowSort []string) helpers.Keyset {
+	order, by := "created_at", "desc"
+
+	if len(allowSort) > 0 && filter.Order != nil && helpers.InArray(*filter.Order, allowSort) {
+		order = *filter.Order
+
+		if filter.By != nil && *filter.By == "asc" {
+			by = "asc"
+		}
+	}
+
+	column := helpers.KeysetColumn{Expr: "o." + order, Type: helpers.ColumnType(CatalogData{}, order)}
+	if strings.HasPrefix(order, "fields.") {
+		column = helpers.KeysetColumn{Expr: "o.fields->>'" + strings.TrimPrefix(order, "fields.") + "'", Type: "text"}
+	}
+
+	return helpers.Keyset{
+		Name:    order + ":" + by,
+		Columns: []helpers.KeysetColumn{column},
+		UUID:    "o.uuid",
+		Desc:    by == "desc",
+	}
 }
 
 func (r *Repository) GetSortFields() []string {
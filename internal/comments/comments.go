@@ -35,6 +35,26 @@ func (s *Service) GetTaskComments(uid uuid.UUID, withFiles, withLikes bool) (dms
 		return dms, err
 	}
 
+	return s.enrich(dms, withFiles, withLikes)
+}
+
+func (s *Service) GetMentions(email string, limit int) ([]domain.Comment, error) {
+	return s.repo.GetMentions(email, limit)
+}
+
+// GetTaskCommentsPage is GetTaskComments with keyset pagination.
+func (s *Service) GetTaskCommentsPage(uid uuid.UUID, limit int, cursor *string, withTotal, withFiles, withLikes bool) (dms []domain.Comment, total int64, next string, err error) {
+	dms, total, next, err = s.repo.GetTaskCommentsPage(uid, limit, cursor, withTotal)
+	if err != nil {
+		return dms, total, next, err
+	}
+
+	dms, err = s.enrich(dms, withFiles, withLikes)
+
+	return dms, total, next, err
+}
+
+func (s *Service) enrich(dms []domain.Comment, withFiles, withLikes bool) (_ []domain.Comment, err error) {
 	// People
 	for i, dm := range dms {
 		emails := lo.Keys(dm.People)
@@ -67,6 +87,25 @@ func (s *Service) GetTaskComments(uid uuid.UUID, withFiles, withLikes bool) (dms
 		}
 	}
 
+	// Polls
+	pollUUIDs := []uuid.UUID{}
+	for _, dm := range dms {
+		if dm.Poll != nil {
+			pollUUIDs = append(pollUUIDs, dm.UUID)
+		}
+	}
+
+	votes, err := s.repo.GetPollVotes(pollUUIDs)
+	if err != nil {
+		return dms, err
+	}
+
+	for i, dm := range dms {
+		if dm.Poll != nil {
+			dms[i].Poll.Votes = votes[dm.UUID]
+		}
+	}
+
 	// Likes
 	if withLikes {
 		for i, dm := range dms {
@@ -87,6 +126,8 @@ func (s *Service) GetTaskComments(uid uuid.UUID, withFiles, withLikes bool) (dms
 					CreatedAt: dm.Likes[u.Email],
 				}
 			})
+
+			dms[i].UserReactions = s.userReactions(dm.Reactions)
 		}
 	}
 
@@ -192,14 +233,115 @@ func (s *Service) LikeComment(_ context.Context, commentUUID uuid.UUID, userEmai
 	return dtos, liked, err
 }
 
+// ReactComment toggles the emoji reaction of the user, true if added.
+func (s *Service) ReactComment(_ context.Context, commentUUID uuid.UUID, userEmail, emoji string) (reactions []domain.Reaction, added bool, err error) {
+	dm, err := s.repo.ReactComment(commentUUID, func(current domain.Reactions) (err error) {
+		added, err = current.Toggle(emoji, userEmail, time.Now().UnixMicro())
+		return err
+	})
+	if err != nil {
+		return reactions, added, err
+	}
+
+	return s.userReactions(dm), added, nil
+}
+
+// VotePoll replaces the vote of the user in the poll of the comment.
+func (s *Service) VotePoll(ctx context.Context, commentUUID uuid.UUID, userEmail string, options []int) (dm domain.Comment, err error) {
+	dm, err = s.GetComment(ctx, commentUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.Poll == nil {
+		return dm, dto.NotFoundErr("голосование не найдено")
+	}
+
+	options, err = dm.Poll.CheckVote(options, time.Now())
+	if err != nil {
+		return dm, err
+	}
+
+	err = s.repo.SavePollVote(commentUUID, userEmail, options)
+	if err != nil {
+		return dm, err
+	}
+
+	votes, err := s.repo.GetPollVotes([]uuid.UUID{commentUUID})
+	if err != nil {
+		return dm, err
+	}
+
+	dm.Poll.Votes = votes[commentUUID]
+
+	return dm, nil
+}
+
+// userReactions resolves the users of all the emojis at once.
+func (s *Service) userReactions(reactions domain.Reactions) []domain.Reaction {
+	emails := []string{}
+	for _, users := range reactions {
+		emails = append(emails, lo.Keys(users)...)
+	}
+
+	usersDTO, _ := s.dict.FindUsers(emails)
+
+	return lo.Map(reactions.Emojis(), func(emoji string, _ int) domain.Reaction {
+		reacted := lo.Filter(usersDTO, func(u dto.UserDTO, _ int) bool {
+			_, ok := reactions[emoji][u.Email]
+			return ok
+		})
+
+		return domain.Reaction{
+			Emoji: emoji,
+			Users: lo.Map(reacted, func(u dto.UserDTO, _ int) domain.UserLike {
+				return domain.UserLike{
+					User: domain.User{
+						UUID:     u.UUID,
+						Email:    u.Email,
+						Name:     u.Name,
+						Lname:    u.Lname,
+						Pname:    u.Pname,
+						HasPhoto: u.HasPhoto,
+					},
+					CreatedAt: reactions[emoji][u.Email],
+				}
+			}),
+		}
+	})
+}
+
+func (s *Service) LinkTask(uid, taskUUID uuid.UUID) error {
+	return s.repo.LinkTask(uid, taskUUID)
+}
+
+func (s *Service) GetRevisions(commentUUID uuid.UUID) ([]domain.CommentRevision, error) {
+	return s.repo.GetRevisions(commentUUID)
+}
+
+func (s *Service) GetCommentWithDeleted(commentUUID uuid.UUID) (domain.Comment, error) {
+	return s.repo.GetCommentWithDeleted(commentUUID)
+}
+
+// GetDeletedComments returns deleted comments of the task for the audit,
+// files of deleted comments are removed from the storage.
+func (s *Service) GetDeletedComments(taskUUID uuid.UUID) ([]domain.Comment, error) {
+	dms, err := s.repo.GetDeletedComments(taskUUID)
+	if err != nil {
+		return dms, err
+	}
+
+	return s.enrich(dms, false, false)
+}
+
 func (s *Service) PinComment(_ context.Context, commentUUID uuid.UUID) (err error) {
 	err = s.repo.PatchCommentPin(commentUUID)
 
 	return err
 }
 
-func (s *Service) DeleteComment(ctx context.Context, taskUUID, commentUID uuid.UUID, _ *uuid.UUID) (err error) {
-	err = s.repo.DeleteComment(ctx, taskUUID, commentUID)
+func (s *Service) DeleteComment(ctx context.Context, taskUUID, commentUID uuid.UUID, deletedBy string) (err error) {
+	err = s.repo.DeleteComment(ctx, taskUUID, commentUID, deletedBy)
 	if err != nil {
 		return err
 	}
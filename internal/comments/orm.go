@@ -7,6 +7,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
 )
 
 type Comment struct {
@@ -16,8 +21,11 @@ type Comment struct {
 
 	TaskUUID uuid.UUID `gorm:"type:uuid;not null"`
 
-	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null;"`
-	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null;"`
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null;"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null;"`
+	EditedAt  *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	DeletedAt *time.Time `gorm:"->;type:timestamptz;default:NULL;"`
+	DeletedBy *string    `gorm:"->;type:varchar(100);default:NULL;"`
 
 	ReplyUUID    *uuid.UUID `gorm:"type:uuid;"`
 	ReplyComment *string    `gorm:"->;type:varchar(500);omitempty"`
@@ -26,6 +34,46 @@ type Comment struct {
 	People Persons `gorm:"type:text[];default:'{}';not null;"`
 
 	Pin bool `gorm:"type:boolean;default:false;not null;"`
+
+	Reactions Reactions      `gorm:"type:jsonb;default:'{}';not null;"`
+	Poll      datatypes.JSON `gorm:"type:jsonb;default:NULL;"`
+
+	ConvertedTaskUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	Total     int64  `gorm:"->"`
+	CursorKey string `gorm:"->"`
+}
+
+func (o Comment) toDomain() domain.Comment {
+	var poll *domain.Poll
+	if len(o.Poll) > 0 {
+		poll = &domain.Poll{}
+		if err := json.Unmarshal(o.Poll, poll); err != nil {
+			logrus.WithField("comment_uuid", o.UUID).Error("poll unmarshal: ", err)
+			poll = nil
+		}
+	}
+
+	return domain.Comment{
+		UUID:         o.UUID,
+		Comment:      o.Comment,
+		CreatedBy:    o.CreatedBy,
+		ReplyUUID:    o.ReplyUUID,
+		ReplyComment: o.ReplyComment,
+		TaskUUID:     o.TaskUUID,
+		People:       o.People,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+		EditedAt:     o.EditedAt,
+		DeletedAt:    o.DeletedAt,
+		DeletedBy:    o.DeletedBy,
+		Likes:        o.Likes,
+		Pin:          o.Pin,
+		Reactions:    domain.Reactions(o.Reactions),
+		Poll:         poll,
+
+		ConvertedTaskUUID: o.ConvertedTaskUUID,
+	}
 }
 
 // JSONB Interface for JSONB Field of yourTableName Table.
@@ -44,3 +92,56 @@ func (a *Persons) Scan(value interface{}) error {
 	}
 	return json.Unmarshal(b, &a)
 }
+
+type Reactions map[string]map[string]int64
+
+func (a Reactions) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+func (a *Reactions) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, &a)
+}
+
+type CommentPollVote struct {
+	CommentUUID uuid.UUID     `gorm:"type:uuid;primaryKey"`
+	Email       string        `gorm:"type:varchar(100);primaryKey"`
+	Options     pq.Int64Array `gorm:"type:integer[];default:'{}';not null;"`
+	CreatedAt   time.Time     `gorm:"type:timestamptz;default:now();not null;"`
+}
+
+func (o CommentPollVote) toDomain() domain.PollVote {
+	return domain.PollVote{
+		Email: o.Email,
+		Options: lo.Map(o.Options, func(item int64, _ int) int {
+			return int(item)
+		}),
+		CreatedAt: o.CreatedAt,
+	}
+}
+
+type CommentRevision struct {
+	UUID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CommentUUID uuid.UUID `gorm:"type:uuid;not null"`
+	TaskUUID    uuid.UUID `gorm:"type:uuid;not null"`
+	Version     int       `gorm:"type:integer;not null"`
+	Comment     string    `gorm:"type:text;not null"`
+	EditedBy    string    `gorm:"type:varchar(100);not null"`
+	CreatedAt   time.Time `gorm:"type:timestamptz;default:now();not null;"`
+}
+
+func (o CommentRevision) toDomain() domain.CommentRevision {
+	return domain.CommentRevision{
+		UUID:        o.UUID,
+		CommentUUID: o.CommentUUID,
+		TaskUUID:    o.TaskUUID,
+		Version:     o.Version,
+		Comment:     o.Comment,
+		EditedBy:    o.EditedBy,
+		CreatedAt:   o.CreatedAt,
+	}
+}
@@ -2,6 +2,9 @@ package comments
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -11,7 +14,9 @@ import (
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -55,7 +60,17 @@ func (r *Repository) CreateComment(ctx context.Context, cmnt domain.Comment) (er
 
 			People: cmnt.People,
 
-			Likes: Persons{},
+			Likes:     Persons{},
+			Reactions: Reactions{},
+		}
+
+		if cmnt.Poll != nil {
+			js, err := json.Marshal(cmnt.Poll)
+			if err != nil {
+				return err
+			}
+
+			orm.Poll = js
 		}
 
 		err := tx.Create(&orm).Error
@@ -79,6 +94,25 @@ func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (er
 	defer r.storeTime("UpdateComment", tm())
 
 	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		current := Comment{}
+
+		err := tx.
+			Select("uuid, task_uuid, comment, created_by, created_at").
+			Where("uuid = ?", cmnt.UUID).
+			Where("deleted_at IS NULL").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&current).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.NotFoundErr("комментарий не найден")
+		} else if err != nil {
+			return err
+		}
+
+		// the comment of the other task
+		if current.TaskUUID != cmnt.TaskUUID {
+			return dto.NotFoundErr("комментарий не найден")
+		}
+
 		orm := Comment{
 			UUID:      cmnt.UUID,
 			ReplyUUID: cmnt.ReplyUUID,
@@ -87,7 +121,17 @@ func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (er
 			Likes:     cmnt.Likes,
 		}
 
-		err := tx.
+		if current.Comment != cmnt.Comment {
+			err = r.storeRevisions(tx, current, cmnt)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			orm.EditedAt = &now
+		}
+
+		err = tx.
 			Updates(orm).
 			Where("uuid = ?", cmnt.UUID).
 			Error
@@ -107,13 +151,115 @@ func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (er
 	return err
 }
 
-func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID) (err error) {
+// storeRevisions adds the new text of the comment as the next revision,
+// cmnt.CreatedBy is the editor. The original text becomes the first revision
+// on the first edit.
+func (r *Repository) storeRevisions(tx *gorm.DB, current Comment, cmnt domain.Comment) error {
+	var last int
+
+	err := tx.
+		Model(&CommentRevision{}).
+		Select("coalesce(max(version), 0)").
+		Where("comment_uuid = ?", cmnt.UUID).
+		Scan(&last).Error
+	if err != nil {
+		return err
+	}
+
+	revisions := []CommentRevision{}
+
+	if last == 0 {
+		last++
+		revisions = append(revisions, CommentRevision{
+			UUID:        uuid.New(),
+			CommentUUID: cmnt.UUID,
+			TaskUUID:    current.TaskUUID,
+			Version:     last,
+			Comment:     current.Comment,
+			EditedBy:    current.CreatedBy,
+			CreatedAt:   current.CreatedAt,
+		})
+	}
+
+	revisions = append(revisions, CommentRevision{
+		UUID:        uuid.New(),
+		CommentUUID: cmnt.UUID,
+		TaskUUID:    current.TaskUUID,
+		Version:     last + 1,
+		Comment:     cmnt.Comment,
+		EditedBy:    cmnt.CreatedBy,
+		CreatedAt:   time.Now(),
+	})
+
+	return tx.Create(&revisions).Error
+}
+
+func (r *Repository) GetRevisions(commentUUID uuid.UUID) (dms []domain.CommentRevision, err error) {
+	defer r.storeTime("GetRevisions", tm())
+
+	orms := []CommentRevision{}
+
+	err = r.gorm.DB.
+		Where("comment_uuid = ?", commentUUID).
+		Order("version").
+		Find(&orms).Error
+
+	dms = lo.Map(orms, func(item CommentRevision, _ int) domain.CommentRevision {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+// GetCommentWithDeleted returns the comment even if it was deleted.
+func (r *Repository) GetCommentWithDeleted(uid uuid.UUID) (dm domain.Comment, err error) {
+	defer r.storeTime("GetCommentWithDeleted", tm())
+
+	orm := Comment{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Limit(1).
+		Find(&orm)
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("комментарий не найден")
+	}
+
+	return orm.toDomain(), nil
+}
+
+// GetDeletedComments returns deleted comments of the task, the last deleted
+// first.
+func (r *Repository) GetDeletedComments(taskUUID uuid.UUID) (dms []domain.Comment, err error) {
+	defer r.storeTime("GetDeletedComments", tm())
+
+	orms := []Comment{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Limit(300).
+		Find(&orms).Error
+
+	dms = lo.Map(orms, func(item Comment, _ int) domain.Comment {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID, deletedBy string) (err error) {
 	defer r.storeTime("DeleteComment", tm())
 
 	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
 		orm := Comment{}
 
-		r.gorm.DB.
+		tx.
 			Where("uuid = ?", uid.String()).
 			Where("deleted_at IS NULL").
 			First(&orm)
@@ -122,10 +268,13 @@ func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID)
 			return dto.NotFoundErr("комментарий не найден")
 		}
 
-		res := r.gorm.DB.
+		res := tx.
 			Model(orm).
 			Where("uuid = ?", uid.String()).
-			Update("deleted_at", "now()")
+			Updates(map[string]interface{}{
+				"deleted_at": gorm.Expr("now()"),
+				"deleted_by": deletedBy,
+			})
 
 		if res.Error != nil {
 			return res.Error
@@ -135,9 +284,15 @@ func (r *Repository) DeleteComment(ctx context.Context, taskUUID, uid uuid.UUID)
 			return dto.NotFoundErr("комментарий не найден")
 		}
 
-		err = r.gorm.DB.Exec("update tasks set comments_total = comments_total - 1 where uuid = ?", orm.TaskUUID).Error
+		// the votes have no foreign key to the partitioned comments
+		err = tx.
+			Where("comment_uuid = ?", uid).
+			Delete(&CommentPollVote{}).Error
+		if err != nil {
+			return err
+		}
 
-		return err
+		return tx.Exec("update tasks set comments_total = comments_total - 1 where uuid = ?", orm.TaskUUID).Error
 	})
 
 	if err == nil {
@@ -153,7 +308,7 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 	orm := []Comment{}
 	q := r.gorm.DB.
 		Model(orm).
-		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, c.comment as reply_comment").
+		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.edited_at, comments.likes, comments.pin, comments.reactions, comments.poll, comments.converted_task_uuid, c.comment as reply_comment").
 		Where("comments.task_uuid = ?", uid).
 		Where("comments.deleted_at IS NULL").
 		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
@@ -167,31 +322,110 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 	err = q.Find(&orm).Error
 
 	for _, o := range orm {
-		dms = append(dms, domain.Comment{
-			UUID:         o.UUID,
-			Comment:      o.Comment,
-			CreatedBy:    o.CreatedBy,
-			ReplyUUID:    o.ReplyUUID,
-			ReplyComment: o.ReplyComment,
-			TaskUUID:     o.TaskUUID,
-			People:       o.People,
-			CreatedAt:    o.CreatedAt,
-			UpdatedAt:    o.UpdatedAt,
-			Likes:        o.Likes,
-			Pin:          o.Pin,
-		})
+		dms = append(dms, o.toDomain())
 	}
 
 	return dms, err
 }
 
+// GetMentions returns the newest comments of other people the user is
+// mentioned in.
+func (r *Repository) GetMentions(email string, limit int) (dms []domain.Comment, err error) {
+	defer r.storeTime("GetMentions", tm())
+
+	orm := []Comment{}
+
+	err = r.gorm.DB.
+		Select("uuid, comment, created_by, task_uuid, people, created_at, updated_at").
+		Where("people -> ? IS NOT NULL", email).
+		Where("created_by <> ?", email).
+		Where("deleted_at IS NULL").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&orm).Error
+
+	for _, o := range orm {
+		dms = append(dms, o.toDomain())
+	}
+
+	return dms, err
+}
+
+var commentKeyset = helpers.Keyset{
+	Name: "pin:desc",
+	Columns: []helpers.KeysetColumn{
+		{Expr: "comments.pin", Type: "boolean"},
+		{Expr: "comments.created_at", Type: "timestamptz"},
+	},
+	UUID: "comments.uuid",
+	Desc: true,
+}
+
+// GetTaskCommentsPage returns pinned comments first, then the newest ones,
+// after the cursor if given. total is -1 when not requested.
+func (r *Repository) GetTaskCommentsPage(uid uuid.UUID, limit int, cursor *string, withTotal bool) (dms []domain.Comment, total int64, next string, err error) {
+	defer r.storeTime("GetTaskCommentsPage", tm())
+
+	orm := []Comment{}
+	total = -1
+
+	q := r.gorm.DB.
+		Model(&Comment{}).
+		Where("comments.task_uuid = ?", uid).
+		Where("comments.deleted_at IS NULL")
+
+	if withTotal {
+		err = q.Session(&gorm.Session{}).Count(&total).Error
+		if err != nil {
+			return dms, total, next, err
+		}
+	}
+
+	if cursor != nil {
+		c, err := helpers.DecodeCursor(*cursor)
+		if err != nil {
+			return dms, total, next, err
+		}
+
+		where, args, err := commentKeyset.Where(c)
+		if err != nil {
+			return dms, total, next, err
+		}
+
+		q = q.Where(where, args...)
+	}
+
+	err = q.
+		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.edited_at, comments.likes, comments.pin, comments.reactions, comments.poll, comments.converted_task_uuid, c.comment as reply_comment, " + commentKeyset.Select()).
+		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
+		Order(commentKeyset.OrderBy()).
+		Limit(limit + 1).
+		Find(&orm).Error
+	if err != nil {
+		return dms, total, next, err
+	}
+
+	if len(orm) > limit {
+		orm = orm[:limit]
+
+		last := orm[len(orm)-1]
+		next, err = commentKeyset.Next(last.CursorKey, last.UUID)
+	}
+
+	for _, o := range orm {
+		dms = append(dms, o.toDomain())
+	}
+
+	return dms, total, next, err
+}
+
 func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, err error) {
 	defer r.storeTime("GetTaskComments", tm())
 
 	orm := Comment{}
 	err = r.gorm.DB.
 		Model(orm).
-		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, c.comment as reply_comment").
+		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.edited_at, comments.likes, comments.pin, comments.reactions, comments.poll, comments.converted_task_uuid, c.comment as reply_comment").
 		Where("comments.deleted_at IS NULL").
 		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
 		Order("comments.pin DESC, comments.created_at DESC").
@@ -200,19 +434,7 @@ func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, e
 		First(&orm).
 		Error
 
-	return domain.Comment{
-		UUID:         orm.UUID,
-		Comment:      orm.Comment,
-		CreatedBy:    orm.CreatedBy,
-		ReplyUUID:    orm.ReplyUUID,
-		ReplyComment: orm.ReplyComment,
-		TaskUUID:     orm.TaskUUID,
-		People:       orm.People,
-		CreatedAt:    orm.CreatedAt,
-		UpdatedAt:    orm.UpdatedAt,
-		Likes:        orm.Likes,
-		Pin:          orm.Pin,
-	}, err
+	return orm.toDomain(), err
 }
 
 func (r *Repository) GetCommentText(uid uuid.UUID) (msg string, err error) {
@@ -271,6 +493,130 @@ func (r *Repository) PatchCommentLikes(uid uuid.UUID, likes map[string]int64) (e
 	return res.Error
 }
 
+// ReactComment applies the toggle to the reactions of the comment locked for
+// update, so the concurrent reactions are not lost.
+func (r *Repository) ReactComment(uid uuid.UUID, apply func(reactions domain.Reactions) error) (reactions domain.Reactions, err error) {
+	defer r.storeTime("ReactComment", tm())
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		orm := Comment{}
+
+		res := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("comments.uuid = ?", uid).
+			Where("comments.deleted_at IS NULL").
+			Limit(1).
+			Find(&orm)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("комментарий не найден")
+		}
+
+		reactions = domain.Reactions(orm.Reactions)
+		if reactions == nil {
+			reactions = make(domain.Reactions)
+		}
+
+		err := apply(reactions)
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Model(&Comment{}).
+			Where("comments.uuid = ?", uid).
+			Updates(map[string]interface{}{
+				"reactions":  Reactions(reactions),
+				"updated_at": gorm.Expr("now()"),
+			}).Error
+	})
+
+	return reactions, err
+}
+
+// GetPollVotes returns votes of the poll comments by comment uuid.
+func (r *Repository) GetPollVotes(uids []uuid.UUID) (map[uuid.UUID][]domain.PollVote, error) {
+	defer r.storeTime("GetPollVotes", tm())
+
+	res := make(map[uuid.UUID][]domain.PollVote)
+	if len(uids) == 0 {
+		return res, nil
+	}
+
+	orms := []CommentPollVote{}
+
+	err := r.gorm.DB.
+		Where("comment_uuid in ?", uids).
+		Order("created_at").
+		Find(&orms).Error
+	if err != nil {
+		return res, err
+	}
+
+	for _, o := range orms {
+		res[o.CommentUUID] = append(res[o.CommentUUID], o.toDomain())
+	}
+
+	return res, nil
+}
+
+// SavePollVote replaces the vote of the user, no options removes it. The
+// comment is touched for the notifications state.
+func (r *Repository) SavePollVote(commentUUID uuid.UUID, email string, options []int) error {
+	defer r.storeTime("SavePollVote", tm())
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		if len(options) == 0 {
+			err := tx.
+				Where("comment_uuid = ?", commentUUID).
+				Where("email = ?", email).
+				Delete(&CommentPollVote{}).Error
+			if err != nil {
+				return err
+			}
+		} else {
+			err := tx.
+				Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "comment_uuid"}, {Name: "email"}},
+					DoUpdates: clause.Assignments(map[string]interface{}{"options": gorm.Expr("excluded.options"), "created_at": gorm.Expr("now()")}),
+				}).
+				Create(&CommentPollVote{
+					CommentUUID: commentUUID,
+					Email:       email,
+					Options: lo.Map(options, func(item int, _ int) int64 {
+						return int64(item)
+					}),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec("UPDATE comments SET updated_at = now() WHERE uuid = ?", commentUUID).Error
+	})
+}
+
+// LinkTask marks the comment as converted into the task. It fails if the
+// comment was already converted, so concurrent conversions create one task.
+func (r *Repository) LinkTask(uid, taskUUID uuid.UUID) (err error) {
+	defer r.storeTime("LinkTask", tm())
+
+	res := r.gorm.DB.Exec("UPDATE comments SET converted_task_uuid = ?, updated_at = now() WHERE comments.uuid = ? AND comments.deleted_at IS NULL AND comments.converted_task_uuid IS NULL", taskUUID, uid)
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return errors.New("комментарий уже преобразован в задачу")
+	}
+
+	return res.Error
+}
+
 func (r *Repository) PatchCommentPin(uid uuid.UUID) (err error) {
 	defer r.storeTime("PatchCommentPin", tm())
 
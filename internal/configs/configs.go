@@ -62,6 +62,51 @@ type Configs struct {
 	CDN_PRIVATE_SSL               bool   `env:"CDN_PRIVATE_SSL" envDefault:"true"`
 	CDN_PRIVATE_URL               string `env:"CDN_PRIVATE_URL" envDefault:"https://storage.yandexcloud.net"`
 
+	// Storage: s3, local or memory. Local and memory links are served by the app
+	// by the bucket name, they are signed by the secret which is required then
+	STORAGE_DRIVER     string `env:"STORAGE_DRIVER" envDefault:"s3"`
+	STORAGE_LOCAL_PATH string `env:"STORAGE_LOCAL_PATH" envDefault:"./storage"`
+	STORAGE_SECRET     string `env:"STORAGE_SECRET" secured:"true"`
+
+	// Storage quota of the federation in MB, zero is unlimited. Usage is
+	// recomputed from the bucket by the cli
+	STORAGE_QUOTA           int  `env:"STORAGE_QUOTA" envDefault:"0"`
+	STORAGE_USAGE_RECOMPUTE bool `env:"STORAGE_USAGE_RECOMPUTE" envDefault:"false"`
+
+	// Storage garbage collection by the cli. Dry run only logs what is found,
+	// retention of the files of deleted tasks in days, grace of the new
+	// objects in hours, rate in deletes per second
+	STORAGE_GC           bool `env:"STORAGE_GC" envDefault:"false"`
+	STORAGE_GC_DRY_RUN   bool `env:"STORAGE_GC_DRY_RUN" envDefault:"true"`
+	STORAGE_GC_RETENTION int  `env:"STORAGE_GC_RETENTION" envDefault:"30"`
+	STORAGE_GC_GRACE     int  `env:"STORAGE_GC_GRACE" envDefault:"24"`
+	STORAGE_GC_RATE      int  `env:"STORAGE_GC_RATE" envDefault:"10"`
+
+	// Resumable (tus) uploads: size in MB, expire in hours. The chunks are
+	// staged in the path, it must be a volume shared by all the replicas
+	UPLOADS_TUS_PATH         string `env:"UPLOADS_TUS_PATH" envDefault:"/tmp/tus"`
+	UPLOADS_TUS_MAX_SIZE     int    `env:"UPLOADS_TUS_MAX_SIZE" envDefault:"2048"`
+	UPLOADS_TUS_EXPIRE       int    `env:"UPLOADS_TUS_EXPIRE" envDefault:"24"`
+	UPLOADS_TUS_CLEAN_ENABLE bool   `env:"UPLOADS_TUS_CLEAN_ENABLE" envDefault:"true"`
+
+	// Task and comment files are scanned, previewed and indexed by the file
+	// workers
+	FILE_WORKERS int `env:"FILE_WORKERS" envDefault:"2"`
+	FILE_QUEUE   int `env:"FILE_QUEUE" envDefault:"1000"`
+
+	// Previews and texts, pdf and media need poppler and ffmpeg installed,
+	// the files are skipped without them
+	PREVIEW_WIDTH     int    `env:"PREVIEW_WIDTH" envDefault:"400"`
+	PREVIEW_PDFTOPPM  string `env:"PREVIEW_PDFTOPPM" envDefault:"pdftoppm"`
+	PREVIEW_PDFTOTEXT string `env:"PREVIEW_PDFTOTEXT" envDefault:"pdftotext"`
+	PREVIEW_FFPROBE   string `env:"PREVIEW_FFPROBE" envDefault:"ffprobe"`
+
+	// Antivirus: clamd or fake, empty disables scanning. Clamd address is
+	// host:port or the socket path, timeout in seconds
+	SCAN_DRIVER     string `env:"SCAN_DRIVER" envDefault:""`
+	SCAN_CLAMD_ADDR string `env:"SCAN_CLAMD_ADDR" envDefault:"localhost:3310"`
+	SCAN_TIMEOUT    int    `env:"SCAN_TIMEOUT" envDefault:"60"`
+
 	// Features
 	SEED           bool   `env:"SEED" envDefault:"false"`
 	METRICS        bool   `env:"METRICS" envDefault:"true"`
@@ -81,6 +126,8 @@ type Configs struct {
 	CACHE_TASK            int `env:"CACHE_TASKS" envDefault:"15"`
 	CACHE_TASKS           int `env:"CACHE_TASKS" envDefault:"15"`
 	CACHE_PRE_SIGNED_URLS int `env:"CACHE_PRE_SIGNED_URLS" envDefault:"15"`
+	CACHE_EPIC_PROGRESS   int `env:"CACHE_EPIC_PROGRESS" envDefault:"600"`
+	CACHE_MY_DAY          int `env:"CACHE_MY_DAY" envDefault:"30"`
 
 	// HTTP
 	PORT int `env:"PORT" envDefault:"8080"`
@@ -98,6 +145,52 @@ type Configs struct {
 	EMAILS_INTEGRATION_ENABLED bool     `env:"EMAILS_INTEGRATION_ENABLED" envDefault:"false"`
 	KAFKA_BROKERS              []string `env:"KAFKA_BROKERS" envDefault:"kafka:9092"`
 	KAFKA_TOPIC                string   `env:"KAFKA_TOPIC" envDefault:"emails"`
+
+	// Webhooks
+	WEBHOOKS_ENABLE        bool `env:"WEBHOOKS_ENABLE" envDefault:"true"`
+	WEBHOOKS_INTERVAL      int  `env:"WEBHOOKS_INTERVAL" envDefault:"5"`
+	WEBHOOKS_TIMEOUT       int  `env:"WEBHOOKS_TIMEOUT" envDefault:"10"`
+	WEBHOOKS_BATCH         int  `env:"WEBHOOKS_BATCH" envDefault:"50"`
+	WEBHOOKS_MAX_ATTEMPTS  int  `env:"WEBHOOKS_MAX_ATTEMPTS" envDefault:"8"`
+	WEBHOOKS_RETRY_DELAY   int  `env:"WEBHOOKS_RETRY_DELAY" envDefault:"30"`
+	WEBHOOKS_DISABLE_AFTER int  `env:"WEBHOOKS_DISABLE_AFTER" envDefault:"50"`
+
+	// Mail ingestion, the mailbox passwords are encrypted with the secret
+	MAIL_INGEST_ENABLE   bool   `env:"MAIL_INGEST_ENABLE" envDefault:"false"`
+	MAIL_INGEST_INTERVAL int    `env:"MAIL_INGEST_INTERVAL" envDefault:"60"`
+	MAIL_INGEST_TIMEOUT  int    `env:"MAIL_INGEST_TIMEOUT" envDefault:"30"`
+	MAIL_INGEST_BATCH    int    `env:"MAIL_INGEST_BATCH" envDefault:"50"`
+	MAIL_INGEST_SECRET   string `env:"MAIL_INGEST_SECRET" envDefault:"" secured:"true"`
+
+	// Statistic
+	STATISTIC_SNAPSHOT_ENABLE bool `env:"STATISTIC_SNAPSHOT_ENABLE" envDefault:"true"`
+	STATISTIC_SNAPSHOT_HOUR   int  `env:"STATISTIC_SNAPSHOT_HOUR" envDefault:"1"`
+
+	// Backfill of statistic snapshots by cmd/cli, dates are YYYY-MM-DD,
+	// empty FROM is the project creation, empty TO is yesterday
+	STATISTIC_BACKFILL         bool   `env:"STATISTIC_BACKFILL" envDefault:"false"`
+	STATISTIC_BACKFILL_PROJECT string `env:"STATISTIC_BACKFILL_PROJECT" envDefault:""`
+	STATISTIC_BACKFILL_FROM    string `env:"STATISTIC_BACKFILL_FROM" envDefault:""`
+	STATISTIC_BACKFILL_TO      string `env:"STATISTIC_BACKFILL_TO" envDefault:""`
+}
+
+// Validate reports the settings the app must not start with.
+func (o *Configs) Validate() error {
+	if o.MAIL_INGEST_ENABLE && o.MAIL_INGEST_SECRET == "" {
+		return fmt.Errorf("MAIL_INGEST_SECRET is required with MAIL_INGEST_ENABLE")
+	}
+
+	if o.STORAGE_DRIVER == "local" || o.STORAGE_DRIVER == "memory" {
+		if o.STORAGE_SECRET == "" || o.STORAGE_SECRET == "storage" {
+			return fmt.Errorf("STORAGE_SECRET is required with the %s STORAGE_DRIVER", o.STORAGE_DRIVER)
+		}
+
+		if o.CDN_PUBLIC_BUCKET_NAME == o.CDN_PRIVATE_BUCKET_NAME {
+			return fmt.Errorf("CDN_PUBLIC_BUCKET_NAME and CDN_PRIVATE_BUCKET_NAME must differ with the %s STORAGE_DRIVER", o.STORAGE_DRIVER)
+		}
+	}
+
+	return nil
 }
 
 func (o *Configs) Debug() {
@@ -0,0 +1,182 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/cache"
+	"github.com/krisch/crm-backend/internal/comments"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/notifications"
+	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/reminders"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+type Service struct {
+	ts    *task.Service
+	cs    *comments.Service
+	rm    *reminders.Service
+	ns    *notifications.Service
+	ps    *profile.Service
+	dict  *dictionary.Service
+	cache *cache.Service
+}
+
+func New(
+	ts *task.Service,
+	cs *comments.Service,
+	rm *reminders.Service,
+	ns *notifications.Service,
+	ps *profile.Service,
+	dict *dictionary.Service,
+	cacheService *cache.Service,
+) *Service {
+	return &Service{
+		ts:    ts,
+		cs:    cs,
+		rm:    rm,
+		ns:    ns,
+		ps:    ps,
+		dict:  dict,
+		cache: cacheService,
+	}
+}
+
+// GetMyDay collects the home screen of the user across all the federations
+// the user is a member of. The day is taken in the timezone of the user.
+func (s *Service) GetMyDay(ctx context.Context, userUUID uuid.UUID, email string) (dm domain.MyDay, err error) {
+	if dm, found := s.cache.GetMyDay(ctx, userUUID); found {
+		return dm, nil
+	}
+
+	now := time.Now().In(s.location(email))
+	y, m, d := now.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	end := start.AddDate(0, 0, 1)
+
+	federationUUIDs := s.dict.GetUserFederatons(userUUID)
+
+	dm = domain.MyDay{Date: start}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		tasks, err := s.ts.GetDueTasks(egCtx, federationUUIDs, email, end)
+		if err != nil {
+			return err
+		}
+
+		dm.Overdue, dm.DueToday = domain.SplitDueTasks(tasks, now)
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		items, err := s.rm.GetByUserForPeriod(userUUID, start, end)
+		dm.Reminders = items
+
+		return err
+	})
+
+	eg.Go(func() error {
+		items, err := s.ns.GetNotification(email)
+		if err != nil {
+			return err
+		}
+
+		dm.Notifications = lo.CountValues(lo.Map(items, func(item dto.NotificationDTO, _ int) string {
+			return item.Type
+		}))
+		dm.NotificationsTotal = len(items)
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		items, err := s.getMentions(egCtx, email)
+		dm.Mentions = items
+
+		return err
+	})
+
+	eg.Go(func() error {
+		items, err := s.ts.GetOpenedTasks(egCtx, federationUUIDs, userUUID, email, domain.MyDayLimit)
+		dm.RecentlyOpened = items
+
+		return err
+	})
+
+	err = eg.Wait()
+	if err != nil {
+		return dm, err
+	}
+
+	s.cache.CacheMyDay(ctx, userUUID, dm)
+
+	return dm, nil
+}
+
+// getMentions skips comments of tasks deleted or hidden from the user.
+func (s *Service) getMentions(ctx context.Context, email string) ([]domain.Mention, error) {
+	cms, err := s.cs.GetMentions(email, domain.MyDayLimit*2)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.ts.GetTasksNames(ctx, lo.Uniq(lo.Map(cms, func(item domain.Comment, _ int) uuid.UUID {
+		return item.TaskUUID
+	})), email)
+	if err != nil {
+		return nil, err
+	}
+
+	names := lo.SliceToMap(tasks, func(item domain.Task) (uuid.UUID, string) {
+		return item.UUID, item.Name
+	})
+
+	items := []domain.Mention{}
+	for _, cm := range cms {
+		name, ok := names[cm.TaskUUID]
+		if !ok {
+			continue
+		}
+
+		items = append(items, domain.Mention{
+			CommentUUID: cm.UUID,
+			TaskUUID:    cm.TaskUUID,
+			TaskName:    name,
+			Comment:     cm.Comment,
+			CreatedBy:   cm.CreatedBy,
+			CreatedAt:   cm.CreatedAt,
+		})
+	}
+
+	return items[:min(len(items), domain.MyDayLimit)], nil
+}
+
+func (s *Service) location(email string) *time.Location {
+	prefs, err := s.ps.GetPreferencesByEmails([]string{email})
+	if err != nil {
+		logrus.WithError(err).Warn("dashboard: preferences")
+		return time.Local
+	}
+
+	p, ok := prefs[email]
+	if !ok || p.Timezone == nil {
+		return time.Local
+	}
+
+	loc, err := time.LoadLocation(*p.Timezone)
+	if err != nil {
+		return time.Local
+	}
+
+	return loc
+}
@@ -130,6 +130,7 @@ func (s *Service) GetProjectStatistic(companyUID, uid uuid.UUID) (item ProjectSt
 
 	return orm, lo.Map(fs, func(item FieldStatistics, _ int) dto.FieldStatistics {
 		return dto.FieldStatistics{
+			Hash:   item.Hash,
 			Name:   item.Name,
 			Filled: item.Filled,
 			Count:  item.Count,
@@ -0,0 +1,36 @@
+package gates
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// CommentAudit allows the creator of the federation and users with the
+// comment_audit rule in it to read deleted comments and their revisions.
+func (a *Service) CommentAudit(federationUUID, userUUID uuid.UUID) error {
+	fUUIDs := a.dict.GetUserFederatons(userUUID)
+
+	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
+
+	if hasFederation == -1 {
+		return fmt.Errorf("федерация не найдена")
+	}
+
+	federation, found := a.dict.FindFederation(federationUUID)
+	if !found {
+		return fmt.Errorf("федерация не найдена")
+	}
+
+	if federation.CreatedByUUID != nil && *federation.CreatedByUUID == userUUID {
+		return nil
+	}
+
+	perm, err := a.repo.GetPermisson(userUUID)
+	if err == nil && perm.FederationUUID == federationUUID && perm.Rules.CommentAudit {
+		return nil
+	}
+
+	return fmt.Errorf("нет прав на просмотр удаленных комментариев")
+}
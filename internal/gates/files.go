@@ -0,0 +1,32 @@
+package gates
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (a *Service) FilesSearch(federationUUID, userUUID uuid.UUID) error {
+	fUUIDs := a.dict.GetUserFederatons(userUUID)
+
+	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
+
+	if hasFederation == -1 {
+		return fmt.Errorf("федерация не найдена или у вас нет доступа к ней")
+	}
+
+	return nil
+}
+
+func (a *Service) StorageUsage(federationUUID, userUUID uuid.UUID) error {
+	fUUIDs := a.dict.GetUserFederatons(userUUID)
+
+	hasFederation := lo.IndexOf(fUUIDs, federationUUID)
+
+	if hasFederation == -1 {
+		return fmt.Errorf("федерация не найдена или у вас нет доступа к ней")
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package gates
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (a *Service) InboundManage(projectUUID, userUUID uuid.UUID) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return fmt.Errorf("проект не найден")
+	}
+
+	cUUIDs := a.dict.GetUserCompanies(userUUID)
+
+	hasCompany := lo.IndexOf(cUUIDs, project.CompanyUUID)
+
+	if hasCompany == -1 {
+		return fmt.Errorf("компания не найдена")
+	}
+
+	return nil
+}
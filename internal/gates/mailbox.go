@@ -0,0 +1,9 @@
+package gates
+
+import (
+	"github.com/google/uuid"
+)
+
+func (a *Service) MailboxManage(projectUUID, userUUID uuid.UUID) error {
+	return a.InboundManage(projectUUID, userUUID)
+}
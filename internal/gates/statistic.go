@@ -0,0 +1,27 @@
+package gates
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ProjectStatistic allows the users of the project company to read the
+// project statistic and analytics.
+func (a *Service) ProjectStatistic(projectUUID, userUUID uuid.UUID) error {
+	project, found := a.dict.FindProject(projectUUID)
+	if !found {
+		return fmt.Errorf("проект не найден")
+	}
+
+	cUUIDs := a.dict.GetUserCompanies(userUUID)
+
+	hasCompany := lo.IndexOf(cUUIDs, project.CompanyUUID)
+
+	if hasCompany == -1 {
+		return fmt.Errorf("проект не найден")
+	}
+
+	return nil
+}
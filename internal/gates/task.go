@@ -43,3 +43,11 @@ func (a *Service) TaskPatch(task domain.Task, userUUID uuid.UUID) error {
 
 	return nil
 }
+
+func (a *Service) TaskVisibilityPatch(task domain.Task, email string) error {
+	if !lo.Contains([]string{task.CreatedBy, task.ManagedBy, task.ResponsibleBy}, email) {
+		return fmt.Errorf("видимость задачи может менять только автор, постановщик или ответственный")
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("некорректный курсор")
+
+// Cursor is a position in a keyset paginated list: values of the sort columns
+// and the uuid of the last returned row. It is passed to clients as an opaque
+// string.
+type Cursor struct {
+	Order string    `json:"o"`
+	Keys  []*string `json:"k"`
+	UUID  uuid.UUID `json:"u"`
+}
+
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c) //nolint
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (c Cursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	err = json.Unmarshal(b, &c)
+	if err != nil || c.UUID == uuid.Nil {
+		return c, ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
+type KeysetColumn struct {
+	Expr string
+	Type string
+}
+
+// Keyset describes a stable ordering: sort columns in one direction and the
+// uuid as a tie breaker. Only the first column may be NULL, nulls go last.
+type Keyset struct {
+	Name    string
+	Columns []KeysetColumn
+	UUID    string
+	Desc    bool
+}
+
+func (k Keyset) dir() string {
+	if k.Desc {
+		return "desc"
+	}
+
+	return "asc"
+}
+
+func (k Keyset) OrderBy() string {
+	parts := []string{}
+	for i, c := range k.Columns {
+		if i == 0 {
+			parts = append(parts, c.Expr+" "+k.dir()+" nulls last")
+			continue
+		}
+
+		parts = append(parts, c.Expr+" "+k.dir())
+	}
+
+	parts = append(parts, k.UUID+" "+k.dir())
+
+	return strings.Join(parts, ", ")
+}
+
+// Select returns the select expression with sort values of a row, scan it into
+// a string and pass to Next.
+func (k Keyset) Select() string {
+	parts := []string{}
+	for _, c := range k.Columns {
+		parts = append(parts, "("+c.Expr+")::text")
+	}
+
+	return "json_build_array(" + strings.Join(parts, ", ") + ")::text AS cursor_key"
+}
+
+// Where returns the condition selecting rows after the cursor.
+func (k Keyset) Where(c Cursor) (string, []interface{}, error) {
+	if c.Order != k.Name || len(c.Keys) != len(k.Columns) {
+		return "", nil, ErrInvalidCursor
+	}
+
+	op := ">"
+	if k.Desc {
+		op = "<"
+	}
+
+	cols := []string{}
+	vals := []string{}
+	args := []interface{}{}
+
+	for i, col := range k.Columns {
+		if i == 0 && c.Keys[0] == nil {
+			continue
+		}
+
+		if c.Keys[i] == nil {
+			return "", nil, ErrInvalidCursor
+		}
+
+		cols = append(cols, col.Expr)
+		vals = append(vals, "?::"+col.Type)
+		args = append(args, *c.Keys[i])
+	}
+
+	cols = append(cols, k.UUID)
+	vals = append(vals, "?::uuid")
+	args = append(args, c.UUID)
+
+	cmp := fmt.Sprintf("(%s) %s (%s)", strings.Join(cols, ", "), op, strings.Join(vals, ", "))
+
+	first := k.Columns[0].Expr
+	if c.Keys[0] == nil {
+		return fmt.Sprintf("(%s IS NULL AND %s)", first, cmp), args, nil
+	}
+
+	return fmt.Sprintf("(%s IS NULL OR %s)", first, cmp), args, nil
+}
+
+// Next builds the cursor pointing after the row with the given sort values.
+func (k Keyset) Next(cursorKey string, uid uuid.UUID) (string, error) {
+	keys := []*string{}
+
+	err := json.Unmarshal([]byte(cursorKey), &keys)
+	if err != nil {
+		return "", err
+	}
+
+	return Cursor{Order: k.Name, Keys: keys, UUID: uid}.Encode(), nil
+}
+
+// ColumnType returns the sql type of the model field stored in the column,
+// taken from the gorm tag or guessed by the go type.
+func ColumnType(model interface{}, column string) string {
+	st := reflect.TypeOf(model)
+
+	for i := 0; i < st.NumField(); i++ {
+		field := st.Field(i)
+		if ToLowerSnake(field.Name) != column {
+			continue
+		}
+
+		for _, part := range strings.Split(field.Tag.Get("gorm"), ";") {
+			if strings.HasPrefix(part, "type:") {
+				return strings.TrimPrefix(part, "type:")
+			}
+		}
+
+		tp := field.Type
+		if tp.Kind() == reflect.Ptr {
+			tp = tp.Elem()
+		}
+
+		switch {
+		case tp == reflect.TypeOf(time.Time{}):
+			return "timestamptz"
+		case tp == reflect.TypeOf(uuid.UUID{}):
+			return "uuid"
+		}
+
+		switch tp.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return "bigint"
+		case reflect.Float32, reflect.Float64:
+			return "double precision"
+		case reflect.Bool:
+			return "boolean"
+		}
+
+		return "text"
+	}
+
+	return "text"
+}
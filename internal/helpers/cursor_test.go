@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursor(t *testing.T) {
+	ks := Keyset{
+		Name:    "finish_to:desc",
+		Columns: []KeysetColumn{{Expr: "finish_to", Type: "timestamptz"}},
+		UUID:    "uuid",
+		Desc:    true,
+	}
+
+	uid := uuid.New()
+
+	next, err := ks.Next(`["2024-06-03 10:00:00+00"]`, uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := DecodeCursor(next)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	where, args, err := ks.Where(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if where != "(finish_to IS NULL OR (finish_to, uuid) < (?::timestamptz, ?::uuid))" || len(args) != 2 || args[1] != uid {
+		t.Errorf("where = %s %v", where, args)
+	}
+
+	next, _ = ks.Next(`[null]`, uid)
+	c, _ = DecodeCursor(next)
+
+	where, _, _ = ks.Where(c)
+	if where != "(finish_to IS NULL AND (uuid) < (?::uuid))" {
+		t.Errorf("where = %s", where)
+	}
+
+	if ks.OrderBy() != "finish_to desc nulls last, uuid desc" {
+		t.Errorf("order = %s", ks.OrderBy())
+	}
+
+	ks.Name = "finish_to:asc"
+	if _, _, err = ks.Where(c); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("cursor of other order accepted: %v", err)
+	}
+
+	if _, err = DecodeCursor("not a cursor"); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("DecodeCursor() = %v", err)
+	}
+}
+
+func TestColumnType(t *testing.T) {
+	type model struct {
+		ID        int
+		Name      string `gorm:"type:varchar(50);default:''"`
+		FinishTo  *time.Time
+		IsEpic    bool
+		ProjectID uuid.UUID
+	}
+
+	tests := map[string]string{
+		"id":         "bigint",
+		"name":       "varchar(50)",
+		"finish_to":  "timestamptz",
+		"is_epic":    "boolean",
+		"project_id": "uuid",
+		"missing":    "text",
+	}
+
+	for column, want := range tests {
+		if got := ColumnType(model{}, column); got != want {
+			t.Errorf("ColumnType(%s) = %s, want %s", column, got, want)
+		}
+	}
+}
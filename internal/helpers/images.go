@@ -3,6 +3,10 @@ package helpers
 import (
 	"fmt"
 	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
 	"image/png"
 	"os"
 
@@ -31,6 +35,42 @@ func ResizeImage(path string, maxWidth int) (string, error) {
 	return to, saveImage(PathInsertSize(path, maxWidth), dst)
 }
 
+// ThumbnailImage saves the jpeg thumbnail of the image not wider than
+// maxWidth, transparent parts become white. It returns the size of the
+// original image.
+func ThumbnailImage(path, to string, maxWidth int) (int, int, error) {
+	img, err := loadImage(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("loadImage failed: %w", err)
+	}
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+
+	filters := []gift.Filter{}
+	if width > maxWidth {
+		filters = append(filters, gift.Resize(maxWidth, 0, gift.LanczosResampling))
+	}
+
+	g := gift.New(filters...)
+
+	dst := image.NewRGBA(g.Bounds(img.Bounds()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	g.DrawAt(dst, img, dst.Bounds().Min, gift.OverOperator)
+
+	f, err := os.Create(to)
+	if err != nil {
+		return 0, 0, fmt.Errorf("os.Create failed: %w", err)
+	}
+	defer f.Close()
+
+	err = jpeg.Encode(f, dst, &jpeg.Options{Quality: 80})
+	if err != nil {
+		return 0, 0, fmt.Errorf("jpeg.Encode failed: %w", err)
+	}
+
+	return width, height, nil
+}
+
 func ImageSize(path string) (int, int, error) {
 	img, err := loadImage(path)
 	if err != nil {
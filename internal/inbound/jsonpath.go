@@ -0,0 +1,205 @@
+package inbound
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+var placeholderRe = regexp.MustCompile(`{{\s*(\$[^}]*?)\s*}}`)
+
+// Lookup resolves a JSONPath-like expression against a decoded JSON document.
+// Supported syntax: $, .key, ['key'], ["key"] and [index].
+func Lookup(doc interface{}, path string) (interface{}, bool) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, false
+	}
+
+	cur := doc
+	rest := path[1:]
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+
+			key := rest[:end]
+			rest = rest[end:]
+
+			obj, ok := cur.(map[string]interface{})
+			if !ok || key == "" {
+				return nil, false
+			}
+
+			cur, ok = obj[key]
+			if !ok {
+				return nil, false
+			}
+		case '[':
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, false
+			}
+
+			token := rest[1:end]
+			rest = rest[end+1:]
+
+			if len(token) >= 2 && (token[0] == '\'' || token[0] == '"') && token[len(token)-1] == token[0] {
+				obj, ok := cur.(map[string]interface{})
+				if !ok {
+					return nil, false
+				}
+
+				cur, ok = obj[token[1:len(token)-1]]
+				if !ok {
+					return nil, false
+				}
+
+				continue
+			}
+
+			idx, err := strconv.Atoi(token)
+			if err != nil {
+				return nil, false
+			}
+
+			arr, ok := cur.([]interface{})
+			if !ok {
+				return nil, false
+			}
+
+			if idx < 0 {
+				idx += len(arr)
+			}
+
+			if idx < 0 || idx >= len(arr) {
+				return nil, false
+			}
+
+			cur = arr[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+// Resolve evaluates a mapping expression: a bare path returns the raw value,
+// a template substitutes every {{ $.path }} placeholder, anything else is a
+// constant.
+func Resolve(doc interface{}, expr string) (interface{}, bool) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, false
+	}
+
+	if strings.HasPrefix(expr, "$") {
+		return Lookup(doc, expr)
+	}
+
+	if !placeholderRe.MatchString(expr) {
+		return expr, true
+	}
+
+	res := placeholderRe.ReplaceAllStringFunc(expr, func(m string) string {
+		v, ok := Lookup(doc, placeholderRe.FindStringSubmatch(m)[1])
+		if !ok {
+			return ""
+		}
+
+		return toString(v)
+	})
+
+	return res, true
+}
+
+// Apply maps a decoded payload to task attributes.
+func Apply(mapping domain.InboundMapping, doc interface{}) (res domain.InboundTask, err error) {
+	if v, ok := Resolve(doc, mapping.Name); ok {
+		res.Name = strings.TrimSpace(toString(v))
+	}
+
+	if v, ok := Resolve(doc, mapping.Description); ok {
+		res.Description = toString(v)
+	}
+
+	if v, ok := Resolve(doc, mapping.DedupeKey); ok {
+		res.DedupeKey = strings.TrimSpace(toString(v))
+	}
+
+	if v, ok := Resolve(doc, mapping.Tags); ok {
+		switch t := v.(type) {
+		case []interface{}:
+			res.Tags = lo.Map(t, func(item interface{}, _ int) string {
+				return strings.TrimSpace(toString(item))
+			})
+		default:
+			res.Tags = lo.Map(strings.Split(toString(t), ","), func(item string, _ int) string {
+				return strings.TrimSpace(item)
+			})
+		}
+
+		res.Tags = lo.WithoutEmpty(lo.Uniq(res.Tags))
+	}
+
+	if v, ok := Resolve(doc, mapping.Priority); ok {
+		p, err := toInt(v)
+		if err != nil {
+			return res, fmt.Errorf("приоритет: %w", err)
+		}
+
+		res.Priority = &p
+	}
+
+	res.Fields = make(map[string]interface{})
+	for hash, expr := range mapping.Fields {
+		if v, ok := Resolve(doc, expr); ok && v != nil {
+			res.Fields[hash] = v
+		}
+	}
+
+	return res, nil
+}
+
+func toString(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		if t == math.Trunc(t) {
+			return strconv.FormatInt(int64(t), 10)
+		}
+
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		b, _ := json.Marshal(t)
+		return string(b)
+	}
+}
+
+func toInt(v interface{}) (int, error) {
+	switch t := v.(type) {
+	case float64:
+		return int(t), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(t))
+	default:
+		return 0, fmt.Errorf("ожидалось число, получено %v", v)
+	}
+}
@@ -0,0 +1,96 @@
+package inbound
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+const payload = `{
+	"order": {"id": 42, "title": "Broken printer", "tags": ["site", "urgent"]},
+	"items": [{"name": "first"}, {"name": "last"}],
+	"contact person": "Ivan",
+	"level": "3"
+}`
+
+func decode(t *testing.T) interface{} {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(payload), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	return doc
+}
+
+func TestLookup(t *testing.T) {
+	doc := decode(t)
+
+	cases := []struct {
+		path  string
+		want  string
+		found bool
+	}{
+		{"$.order.title", "Broken printer", true},
+		{"$.order.id", "42", true},
+		{"$.items[0].name", "first", true},
+		{"$.items[-1].name", "last", true},
+		{"$['contact person']", "Ivan", true},
+		{`$["order"]["title"]`, "Broken printer", true},
+		{"$.items[5].name", "", false},
+		{"$.missing", "", false},
+		{"order.id", "", false},
+	}
+
+	for _, c := range cases {
+		v, ok := Lookup(doc, c.path)
+		if ok != c.found {
+			t.Errorf("Lookup(%s) found = %v, want %v", c.path, ok, c.found)
+			continue
+		}
+
+		if ok && toString(v) != c.want {
+			t.Errorf("Lookup(%s) = %v, want %s", c.path, v, c.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	doc := decode(t)
+
+	res, err := Apply(domain.InboundMapping{
+		Name:        "Заказ #{{ $.order.id }}: {{$.order.title}}",
+		Description: "$.items[1].name",
+		Tags:        "$.order.tags",
+		Priority:    "$.level",
+		Fields:      map[string]string{"abc": "$['contact person']", "const": "web"},
+		DedupeKey:   "order-{{$.order.id}}",
+	}, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Name != "Заказ #42: Broken printer" {
+		t.Errorf("name = %q", res.Name)
+	}
+
+	if res.Description != "last" {
+		t.Errorf("description = %q", res.Description)
+	}
+
+	if len(res.Tags) != 2 || res.Tags[0] != "site" || res.Tags[1] != "urgent" {
+		t.Errorf("tags = %v", res.Tags)
+	}
+
+	if res.Priority == nil || *res.Priority != 3 {
+		t.Errorf("priority = %v", res.Priority)
+	}
+
+	if res.Fields["abc"] != "Ivan" || res.Fields["const"] != "web" {
+		t.Errorf("fields = %v", res.Fields)
+	}
+
+	if res.DedupeKey != "order-42" {
+		t.Errorf("dedupe key = %q", res.DedupeKey)
+	}
+}
@@ -0,0 +1,279 @@
+package inbound
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultPriority = 10
+
+	// maxDedupeKey is the length of inbound_webhook_tasks.dedupe_key
+	maxDedupeKey = 250
+
+	maxClaimAttempts = 3
+)
+
+var ErrInvalidToken = errors.New("неверный токен")
+
+type Service struct {
+	repo *Repository
+	dict *dictionary.Service
+	ts   *task.Service
+}
+
+func New(repo *Repository, dict *dictionary.Service, ts *task.Service) *Service {
+	return &Service{
+		repo: repo,
+		dict: dict,
+		ts:   ts,
+	}
+}
+
+func (s *Service) Create(_ context.Context, dm *domain.InboundWebhook) error {
+	err := validate(dm)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Create(dm)
+}
+
+func (s *Service) GetByProject(_ context.Context, projectUUID uuid.UUID) ([]domain.InboundWebhook, error) {
+	return s.repo.GetByProject(projectUUID)
+}
+
+func (s *Service) Get(_ context.Context, projectUUID, uid uuid.UUID) (dm domain.InboundWebhook, err error) {
+	dm, err = s.repo.GetByUUID(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.ProjectUUID != projectUUID {
+		return dm, dto.NotFoundErr("вебхук не найден")
+	}
+
+	return dm, nil
+}
+
+func (s *Service) Update(ctx context.Context, projectUUID, uid uuid.UUID, name *string, mapping *domain.InboundMapping, isActive *bool, rotateToken bool) (dm domain.InboundWebhook, err error) {
+	dm, err = s.Get(ctx, projectUUID, uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if name != nil {
+		dm.Name = *name
+	}
+
+	if mapping != nil {
+		dm.Mapping = *mapping
+	}
+
+	if isActive != nil {
+		dm.IsActive = *isActive
+	}
+
+	if rotateToken {
+		dm.Token = domain.NewInboundToken()
+	}
+
+	err = validate(&dm)
+	if err != nil {
+		return dm, err
+	}
+
+	return dm, s.repo.Update(&dm)
+}
+
+func (s *Service) Delete(ctx context.Context, projectUUID, uid uuid.UUID) error {
+	_, err := s.Get(ctx, projectUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(uid)
+}
+
+// Receive maps the payload to a task. With a dedupe key the task created by
+// the same key is updated while it is still open, otherwise a new task is
+// created. The key is bound before the task is created, so the concurrent
+// deliveries of the same key make one task.
+func (s *Service) Receive(ctx context.Context, uid uuid.UUID, token string, body []byte) (taskUUID uuid.UUID, created bool, err error) {
+	hook, err := s.repo.GetByUUID(uid)
+	if err != nil {
+		return taskUUID, false, err
+	}
+
+	if !hook.IsActive || subtle.ConstantTimeCompare([]byte(hook.Token), []byte(token)) != 1 {
+		return taskUUID, false, ErrInvalidToken
+	}
+
+	var doc interface{}
+	err = json.Unmarshal(body, &doc)
+	if err != nil {
+		return taskUUID, false, fmt.Errorf("некорректный json: %w", err)
+	}
+
+	it, err := Apply(hook.Mapping, doc)
+	if err != nil {
+		return taskUUID, false, err
+	}
+
+	if it.Priority != nil && (*it.Priority < 0 || *it.Priority > 30) {
+		return taskUUID, false, fmt.Errorf("приоритет должен быть от 0 до 30")
+	}
+
+	if utf8.RuneCountInString(it.DedupeKey) > maxDedupeKey {
+		return taskUUID, false, fmt.Errorf("ключ дедупликации длиннее %d символов", maxDedupeKey)
+	}
+
+	t, err := domain.NewTask(
+		it.Name,
+		hook.FederationUUID,
+		hook.CompanyUUID,
+		hook.ProjectUUID,
+		hook.CreatedBy,
+		it.Fields,
+		it.Tags,
+
+		it.Description,
+		[]string{},
+		[]string{},
+		"",
+		"",
+
+		helpers.Deref(it.Priority, defaultPriority),
+
+		nil,
+		"",
+		"",
+
+		map[uuid.UUID][]string{},
+	)
+	if err != nil {
+		return taskUUID, false, err
+	}
+
+	if it.DedupeKey != "" {
+		existUUID, err := s.claim(ctx, hook, it, t.UUID)
+		if err != nil || existUUID != uuid.Nil {
+			return existUUID, false, err
+		}
+	}
+
+	_, err = s.ts.CreateTask(t)
+	if err != nil {
+		if it.DedupeKey != "" {
+			if errRelease := s.repo.ReleaseTask(hook.UUID, it.DedupeKey, t.UUID); errRelease != nil {
+				logrus.WithError(errRelease).WithField("webhook_uuid", hook.UUID).Error("release dedupe key")
+			}
+		}
+
+		return taskUUID, false, err
+	}
+
+	return t.UUID, true, nil
+}
+
+// claim binds the dedupe key to the new task before it is created. When the
+// key is bound to the open task of the project, that task is updated and
+// returned instead, the closed task gives the key to the new one.
+func (s *Service) claim(ctx context.Context, hook domain.InboundWebhook, it domain.InboundTask, taskUUID uuid.UUID) (uuid.UUID, error) {
+	for attempt := 0; attempt < maxClaimAttempts; attempt++ {
+		boundUUID, err := s.repo.ClaimTask(hook.UUID, it.DedupeKey, taskUUID)
+		if err != nil || boundUUID == taskUUID {
+			return uuid.Nil, err
+		}
+
+		t, err := s.ts.GetTask(ctx, boundUUID, []string{})
+
+		var notFoundErr dto.NotFoundError
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return uuid.Nil, err
+		}
+
+		if err == nil && t.ProjectUUID == hook.ProjectUUID && isOpen(t.Status) {
+			return t.UUID, s.update(hook, t, it)
+		}
+
+		moved, err := s.repo.MoveTask(hook.UUID, it.DedupeKey, boundUUID, taskUUID)
+		if err != nil || moved {
+			return uuid.Nil, err
+		}
+
+		// the concurrent request moved the key first, its task is the one
+	}
+
+	return uuid.Nil, fmt.Errorf("не удалось применить ключ дедупликации")
+}
+
+func (s *Service) update(hook domain.InboundWebhook, t domain.Task, it domain.InboundTask) (err error) {
+	crtr := domain.Creator{UUID: hook.CreatedByUUID, Email: hook.CreatedBy}
+
+	if it.Name != "" && it.Name != t.Name {
+		err = s.ts.PatchName(crtr, t.UUID, it.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	shouldUpdate := []string{}
+
+	if it.Description != "" && it.Description != t.Description {
+		t.Description = it.Description
+		shouldUpdate = append(shouldUpdate, "description")
+	}
+
+	if len(lo.Without(it.Tags, t.Tags...)) > 0 {
+		t.Tags = lo.Uniq(append(t.Tags, it.Tags...))
+		shouldUpdate = append(shouldUpdate, "tags")
+	}
+
+	if it.Priority != nil && *it.Priority != t.Priority {
+		t.Priority = *it.Priority
+		shouldUpdate = append(shouldUpdate, "priority")
+	}
+
+	t.RawFields = map[string]interface{}{}
+	if len(it.Fields) > 0 {
+		t.RawFields = it.Fields
+		shouldUpdate = append(shouldUpdate, "fields")
+	}
+
+	if len(shouldUpdate) == 0 {
+		return nil
+	}
+
+	return s.ts.UpdateTask(crtr, t, shouldUpdate)
+}
+
+func isOpen(status int) bool {
+	return status != domain.StatusDone && status != domain.StatusCancel
+}
+
+func validate(dm *domain.InboundWebhook) error {
+	errs, ok := helpers.ValidationStruct(*dm)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	if dm.Mapping.Name == "" {
+		return fmt.Errorf("не указано поле для названия задачи")
+	}
+
+	return nil
+}
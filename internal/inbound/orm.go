@@ -0,0 +1,80 @@
+package inbound
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type InboundWebhook struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+	ProjectUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Name     string  `gorm:"type:varchar(100);default:'';not null;"`
+	Token    string  `gorm:"type:varchar(100);not null;"`
+	Mapping  Mapping `gorm:"type:jsonb;default:'{}';not null;"`
+	IsActive bool    `gorm:"type:bool;default:true;not null;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	Total int64 `gorm:"->"`
+}
+
+func (o InboundWebhook) toDomain() domain.InboundWebhook {
+	return domain.InboundWebhook{
+		UUID:           o.UUID,
+		FederationUUID: o.FederationUUID,
+		CompanyUUID:    o.CompanyUUID,
+		ProjectUUID:    o.ProjectUUID,
+		CreatedBy:      o.CreatedBy,
+		CreatedByUUID:  o.CreatedByUUID,
+		Name:           o.Name,
+		Token:          o.Token,
+		Mapping:        domain.InboundMapping(o.Mapping),
+		IsActive:       o.IsActive,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+		DeletedAt:      o.DeletedAt,
+	}
+}
+
+type InboundWebhookTask struct {
+	WebhookUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	DedupeKey   string    `gorm:"type:varchar(250);not null;primary_key:true"`
+	TaskUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type Mapping domain.InboundMapping
+
+// Scan scan value into Jsonb, implements sql.Scanner interface.
+func (j *Mapping) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := Mapping{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+// Value return json value, implement driver.Valuer interface.
+func (j Mapping) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
@@ -0,0 +1,152 @@
+package inbound
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm *domain.InboundWebhook) error {
+	return r.gorm.DB.Create(&InboundWebhook{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		CreatedBy:      dm.CreatedBy,
+		CreatedByUUID:  dm.CreatedByUUID,
+		Name:           dm.Name,
+		Token:          dm.Token,
+		Mapping:        Mapping(dm.Mapping),
+		IsActive:       dm.IsActive,
+	}).Error
+}
+
+func (r *Repository) GetByProject(projectUUID uuid.UUID) (dms []domain.InboundWebhook, err error) {
+	orms := []InboundWebhook{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Order("created_at desc").
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item InboundWebhook, _ int) domain.InboundWebhook {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) GetByUUID(uid uuid.UUID) (dm domain.InboundWebhook, err error) {
+	orm := InboundWebhook{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("вебхук не найден")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return orm.toDomain(), nil
+}
+
+func (r *Repository) Update(dm *domain.InboundWebhook) error {
+	res := r.gorm.DB.
+		Model(&InboundWebhook{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"name":       dm.Name,
+			"token":      dm.Token,
+			"mapping":    Mapping(dm.Mapping),
+			"is_active":  dm.IsActive,
+			"updated_at": time.Now(),
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("вебхук не найден")
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&InboundWebhook{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("вебхук не найден")
+	}
+
+	return nil
+}
+
+// ClaimTask binds the dedupe key to the task unless the key is bound
+// already, the task bound to the key is returned. The key is unique within the
+// webhook, so of the concurrent requests only one binds it.
+func (r *Repository) ClaimTask(webhookUUID uuid.UUID, key string, taskUUID uuid.UUID) (boundUUID uuid.UUID, err error) {
+	err = r.gorm.DB.Raw(`
+		INSERT INTO inbound_webhook_tasks (webhook_uuid, dedupe_key, task_uuid, created_at, updated_at)
+		VALUES (?, ?, ?, now(), now())
+		ON CONFLICT (webhook_uuid, dedupe_key) DO UPDATE
+		SET updated_at = now()
+		RETURNING task_uuid`, webhookUUID, key, taskUUID).
+		Scan(&boundUUID).Error
+
+	return boundUUID, err
+}
+
+// MoveTask binds the dedupe key to the new task while it is still bound to the
+// old one, false is returned when it was moved meanwhile.
+func (r *Repository) MoveTask(webhookUUID uuid.UUID, key string, oldUUID, newUUID uuid.UUID) (bool, error) {
+	res := r.gorm.DB.
+		Model(&InboundWebhookTask{}).
+		Where("webhook_uuid = ?", webhookUUID).
+		Where("dedupe_key = ?", key).
+		Where("task_uuid = ?", oldUUID).
+		Updates(map[string]interface{}{"task_uuid": newUUID, "updated_at": time.Now()})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// ReleaseTask unbinds the dedupe key from the task which was not created.
+func (r *Repository) ReleaseTask(webhookUUID uuid.UUID, key string, taskUUID uuid.UUID) error {
+	return r.gorm.DB.
+		Where("webhook_uuid = ?", webhookUUID).
+		Where("dedupe_key = ?", key).
+		Where("task_uuid = ?", taskUUID).
+		Delete(&InboundWebhookTask{}).Error
+}
@@ -0,0 +1,47 @@
+package mailbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/s3"
+)
+
+// The dependencies of the service, the ingest is tested without a database.
+
+type repository interface {
+	Create(dm *domain.Mailbox) error
+	GetByProject(projectUUID uuid.UUID) ([]domain.Mailbox, error)
+	GetByUUID(uid uuid.UUID) (domain.Mailbox, error)
+	Update(dm *domain.Mailbox, reset bool) error
+	Delete(uid uuid.UUID) error
+	GetUnsealed() ([]domain.Mailbox, error)
+	SavePassword(uid uuid.UUID, password string) error
+
+	ClaimMailboxes(limit int, lease time.Duration) ([]domain.Mailbox, error)
+	SaveState(dm domain.Mailbox, next time.Duration) error
+	IsKnown(mailboxUUID uuid.UUID, messageID, bodyHash string) (bool, error)
+	IsSender(mailboxUUID, taskUUID uuid.UUID, sender string) (bool, error)
+	FindThread(mailboxUUID uuid.UUID, messageIDs []string) (uuid.UUID, bool, error)
+	SaveEmail(mailboxUUID uuid.UUID, msg domain.MailMessage, taskUUID uuid.UUID, commentUUID *uuid.UUID) error
+}
+
+type users interface {
+	FindUser(email string) (*dto.UserDTO, bool)
+	GetUserCompanies(userUUID uuid.UUID) []uuid.UUID
+}
+
+type tasks interface {
+	GetTaskUUIDByID(projectUUID uuid.UUID, id int) (uuid.UUID, bool, error)
+	GetTask(ctx context.Context, uid uuid.UUID, fields []string) (domain.Task, error)
+	CheckVisible(ctx context.Context, uid uuid.UUID, email string) error
+	CreateTask(task domain.Task) (int, error)
+	CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Comment) error
+}
+
+type uploader interface {
+	UploadTaskCommentFile(federatonUUID, taskUUID, commentUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (s3.File, error)
+}
@@ -0,0 +1,182 @@
+package mailbox
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+	"github.com/krisch/crm-backend/domain"
+)
+
+var ErrNoTLS = errors.New("сервер не поддерживает TLS")
+
+// Fetcher reads new messages from an IMAP inbox. The inbox is opened
+// read-only and bodies are fetched with BODY.PEEK, so the messages stay
+// unread for people working with the same mailbox.
+type Fetcher struct {
+	Timeout time.Duration
+	Batch   int
+
+	// AllowInsecure permits plain text login when the server does not
+	// support STARTTLS, it is meant for local servers.
+	AllowInsecure bool
+}
+
+// RawMessage is a message as it was read from the server.
+type RawMessage struct {
+	UID  uint32
+	Body []byte
+}
+
+// Fetch returns up to Batch messages with uid greater than LastUID in
+// ascending order and the current UIDVALIDITY of the inbox. When the
+// UIDVALIDITY differs from the stored one the uids were reassigned and the
+// inbox is read from the beginning.
+func (f *Fetcher) Fetch(dm domain.Mailbox) (msgs []RawMessage, uidValidity uint32, err error) {
+	c, err := f.dial(dm.Domain)
+	if err != nil {
+		return msgs, 0, err
+	}
+	defer c.Logout() //nolint
+
+	err = c.Login(dm.Email, dm.Password)
+	if err != nil {
+		return msgs, 0, fmt.Errorf("ошибка авторизации: %w", err)
+	}
+
+	status, err := c.Select("INBOX", true)
+	if err != nil {
+		return msgs, 0, err
+	}
+
+	lastUID := dm.LastUID
+	if status.UidValidity != dm.UIDValidity {
+		lastUID = 0
+	}
+
+	if status.Messages == 0 {
+		return msgs, status.UidValidity, nil
+	}
+
+	criteria := imap.NewSearchCriteria()
+	criteria.Uid = new(imap.SeqSet)
+	criteria.Uid.AddRange(lastUID+1, 0)
+
+	uids, err := c.UidSearch(criteria)
+	if err != nil {
+		return msgs, 0, err
+	}
+
+	// "n:*" always matches the last message, even when its uid is below n
+	uids = filterUIDs(uids, lastUID)
+	if len(uids) == 0 {
+		return msgs, status.UidValidity, nil
+	}
+
+	if f.Batch > 0 && len(uids) > f.Batch {
+		uids = uids[:f.Batch]
+	}
+
+	seqset := new(imap.SeqSet)
+	seqset.AddNum(uids...)
+
+	section := &imap.BodySectionName{Peek: true}
+	ch := make(chan *imap.Message, 10)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- c.UidFetch(seqset, []imap.FetchItem{imap.FetchUid, section.FetchItem()}, ch)
+	}()
+
+	for m := range ch {
+		r := m.GetBody(section)
+		if r == nil {
+			continue
+		}
+
+		b, err := io.ReadAll(r)
+		if err != nil {
+			continue
+		}
+
+		msgs = append(msgs, RawMessage{UID: m.Uid, Body: b})
+	}
+
+	err = <-done
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].UID < msgs[j].UID
+	})
+
+	return msgs, status.UidValidity, nil
+}
+
+func (f *Fetcher) dial(addr string) (c *client.Client, err error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: f.Timeout}
+	tlsConfig := &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+
+	if port == "993" {
+		c, err = client.DialWithDialerTLS(dialer, addr, tlsConfig)
+	} else {
+		c, err = client.DialWithDialer(dialer, addr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.Timeout = f.Timeout
+
+	if c.IsTLS() {
+		return c, nil
+	}
+
+	ok, err := c.SupportStartTLS()
+	if err != nil {
+		return nil, errors.Join(err, c.Terminate())
+	}
+
+	if ok {
+		err = c.StartTLS(tlsConfig)
+		if err != nil {
+			return nil, errors.Join(err, c.Terminate())
+		}
+
+		return c, nil
+	}
+
+	if !f.AllowInsecure {
+		return nil, errors.Join(ErrNoTLS, c.Terminate())
+	}
+
+	return c, nil
+}
+
+func filterUIDs(uids []uint32, after uint32) []uint32 {
+	res := make([]uint32, 0, len(uids))
+	for _, uid := range uids {
+		if uid > after {
+			res = append(res, uid)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+
+	return res
+}
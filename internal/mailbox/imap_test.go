@@ -0,0 +1,162 @@
+package mailbox
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-imap/backend/memory"
+	"github.com/emersion/go-imap/server"
+	"github.com/krisch/crm-backend/domain"
+)
+
+const reply = "From: Ivan Petrov <Ivan@Example.org>\r\n" +
+	"To: support@example.org\r\n" +
+	"Subject: =?utf-8?B?UmU6IFsjNDJdINCX0LDQutCw0Lc=?=\r\n" +
+	"Date: Mon, 03 Jun 2024 10:00:00 +0000\r\n" +
+	"Message-ID: <reply-1@example.org>\r\n" +
+	"In-Reply-To: <first-1@example.org>\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=b1\r\n" +
+	"\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/plain; charset=windows-1251\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"=C3=EE=F2=EE=E2=EE\r\n" +
+	"\r\n" +
+	"> old text\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"Content-Disposition: attachment; filename=\"report.txt\"\r\n" +
+	"\r\n" +
+	"report\r\n" +
+	"--b1--\r\n"
+
+// serve starts a local IMAP server with the memory backend, the inbox
+// already has one message with uid 6.
+func serve(t *testing.T) (string, *memory.Mailbox) {
+	t.Helper()
+
+	be := memory.New()
+
+	user, err := be.Login(nil, "username", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mbox, err := user.GetMailbox("INBOX")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := server.New(be)
+	s.AllowInsecureAuth = true
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go s.Serve(ln) //nolint
+
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	return ln.Addr().String(), mbox.(*memory.Mailbox)
+}
+
+func TestFetch(t *testing.T) {
+	addr, mbox := serve(t)
+
+	f := &Fetcher{Timeout: 5 * time.Second, Batch: 10, AllowInsecure: true}
+	dm := domain.Mailbox{Email: "username", Password: "password", Domain: addr}
+
+	msgs, validity, err := f.Fetch(dm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(msgs) != 1 || msgs[0].UID != 6 || validity != 1 {
+		t.Fatalf("first fetch = %v messages, validity %v", len(msgs), validity)
+	}
+
+	dm.UIDValidity = validity
+	dm.LastUID = msgs[0].UID
+
+	msgs, _, err = f.Fetch(dm)
+	if err != nil || len(msgs) != 0 {
+		t.Fatalf("nothing new expected, got %v messages: %v", len(msgs), err)
+	}
+
+	err = mbox.CreateMessage(nil, time.Now(), bytes.NewBufferString(reply))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgs, _, err = f.Fetch(dm)
+	if err != nil || len(msgs) != 1 || msgs[0].UID != 7 {
+		t.Fatalf("new message expected, got %v messages: %v", len(msgs), err)
+	}
+
+	msg, err := ParseMessage(msgs[0].UID, bytes.NewReader(msgs[0].Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.Sender != "ivan@example.org" || msg.SenderName != "Ivan Petrov" || msg.MessageID != "reply-1@example.org" {
+		t.Errorf("headers = %+v", msg)
+	}
+
+	if len(msg.InReplyTo) != 1 || msg.InReplyTo[0] != "first-1@example.org" {
+		t.Errorf("in reply to = %v", msg.InReplyTo)
+	}
+
+	if id, ok := domain.MailTaskID(msg.Subject); !ok || id != 42 || domain.MailSubject(msg.Subject) != "Заказ" {
+		t.Errorf("subject = %q", msg.Subject)
+	}
+
+	if domain.MailReplyText(msg.BodyText) != "Готово" {
+		t.Errorf("body = %q", msg.BodyText)
+	}
+
+	if len(msg.Attachments) != 1 || msg.Attachments[0].Name != "report.txt" || strings.TrimSpace(string(msg.Attachments[0].Content)) != "report" {
+		t.Errorf("attachments = %+v", msg.Attachments)
+	}
+
+	dm.UIDValidity = 2
+
+	msgs, _, err = f.Fetch(dm)
+	if err != nil || len(msgs) != 2 {
+		t.Fatalf("changed uidvalidity should read inbox again, got %v messages: %v", len(msgs), err)
+	}
+
+	f.AllowInsecure = false
+	if _, _, err = f.Fetch(dm); err == nil {
+		t.Error("plain text login without STARTTLS accepted")
+	}
+}
+
+func TestParseMessageHTML(t *testing.T) {
+	body := "From: a@a.ru\r\n" +
+		"Subject: test\r\n" +
+		"Content-Type: text/html; charset=utf-8\r\n" +
+		"\r\n" +
+		"<html><style>p {}</style><p>Tom &amp; Jerry</p><br>bye</html>"
+
+	msg, err := ParseMessage(1, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.BodyText != "Tom & Jerry\n\nbye" {
+		t.Errorf("body = %q", msg.BodyText)
+	}
+
+	if msg.MessageID != "" || msg.BodyHash() == "" {
+		t.Errorf("message id = %q, hash = %q", msg.MessageID, msg.BodyHash())
+	}
+}
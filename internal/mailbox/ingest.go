@@ -0,0 +1,319 @@
+package mailbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	claimLimit      = 10
+	defaultPriority = 10
+	maxNameLen      = 100
+	maxTextLen      = 5000
+)
+
+// IngestDue polls mailboxes due for fetching, returns the number of polled
+// mailboxes.
+func (s *Service) IngestDue(ctx context.Context) (int, error) {
+	dms, err := s.repo.ClaimMailboxes(claimLimit, s.lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dm := range dms {
+		dm.Password, err = s.secret.open(dm.Password)
+		if err != nil {
+			logrus.WithError(err).WithField("mailbox_uuid", dm.UUID).Error("mail ingest error")
+			continue
+		}
+
+		err = s.Ingest(ctx, dm)
+		if err != nil {
+			logrus.WithError(err).WithField("mailbox_uuid", dm.UUID).Error("mail ingest error")
+		}
+	}
+
+	return len(dms), nil
+}
+
+// Ingest reads new messages of the mailbox and turns them into tasks and
+// comments. Processing stops at the first failed message, it is fetched
+// again on the next poll.
+func (s *Service) Ingest(ctx context.Context, dm domain.Mailbox) (err error) {
+	next := s.interval
+
+	defer func() {
+		now := time.Now()
+		dm.LastFetchedAt = &now
+		dm.LastError = ""
+		if err != nil {
+			dm.LastError = err.Error()
+		}
+
+		saveErr := s.repo.SaveState(dm, next)
+		if saveErr != nil {
+			err = errors.Join(err, saveErr)
+		}
+	}()
+
+	msgs, uidValidity, err := s.fetcher.Fetch(dm)
+	if err != nil {
+		return err
+	}
+
+	if uidValidity != dm.UIDValidity {
+		dm.UIDValidity = uidValidity
+		dm.LastUID = 0
+	}
+
+	for _, raw := range msgs {
+		msg, err := ParseMessage(raw.UID, bytes.NewReader(raw.Body))
+		if err != nil {
+			logrus.WithError(err).WithField("mailbox_uuid", dm.UUID).WithField("uid", raw.UID).Warn("unreadable email skipped")
+			dm.LastUID = raw.UID
+			continue
+		}
+
+		err = s.ingestMessage(ctx, dm, msg)
+		if err != nil {
+			return fmt.Errorf("письмо %d: %w", raw.UID, err)
+		}
+
+		dm.LastUID = raw.UID
+	}
+
+	// a full batch means there may be more new messages
+	if s.fetcher.Batch > 0 && len(msgs) >= s.fetcher.Batch {
+		next = 0
+	}
+
+	return nil
+}
+
+func (s *Service) ingestMessage(ctx context.Context, dm domain.Mailbox, msg domain.MailMessage) error {
+	known, err := s.repo.IsKnown(dm.UUID, msg.MessageID, msg.BodyHash())
+	if err != nil || known {
+		return err
+	}
+
+	author, isUser := s.author(dm, msg)
+
+	taskUUID, found, err := s.findTask(ctx, dm, msg, isUser)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		taskUUID, err = s.createTask(dm, author, isUser, msg)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.SaveEmail(dm.UUID, msg, taskUUID, nil)
+		if err != nil || len(msg.Attachments) == 0 {
+			return err
+		}
+
+		commentUUID, err := s.comment(ctx, taskUUID, author, true, msg, "Вложения из письма")
+		if err != nil {
+			return err
+		}
+
+		return s.attach(ctx, taskUUID, commentUUID, author, msg.Attachments)
+	}
+
+	commentUUID, err := s.comment(ctx, taskUUID, author, isUser, msg, domain.MailReplyText(msg.BodyText))
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.SaveEmail(dm.UUID, msg, taskUUID, &commentUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.attach(ctx, taskUUID, commentUUID, author, msg.Attachments)
+}
+
+// findTask matches the message to a task by the thread headers first and
+// then by the "[#id]" subject tag. Anyone can put the tag in the subject, so
+// it is trusted from the participants of the task only, the message of an
+// outside sender becomes a new task.
+func (s *Service) findTask(ctx context.Context, dm domain.Mailbox, msg domain.MailMessage, isUser bool) (taskUUID uuid.UUID, found bool, err error) {
+	taskUUID, found, err = s.repo.FindThread(dm.UUID, lo.WithoutEmpty(msg.InReplyTo))
+	if err != nil {
+		return taskUUID, false, err
+	}
+
+	if !found {
+		id, ok := domain.MailTaskID(msg.Subject)
+		if !ok {
+			return taskUUID, false, nil
+		}
+
+		taskUUID, found, err = s.ts.GetTaskUUIDByID(dm.ProjectUUID, id)
+		if err != nil || !found {
+			return taskUUID, false, err
+		}
+
+		found, err = s.isParticipant(ctx, dm, taskUUID, msg.Sender, isUser)
+		if err != nil || !found {
+			return taskUUID, false, err
+		}
+	}
+
+	_, err = s.ts.GetTask(ctx, taskUUID, []string{})
+
+	var notFoundErr dto.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return taskUUID, false, nil
+	}
+
+	return taskUUID, err == nil, err
+}
+
+// isParticipant reports whether the sender is the user of the company who
+// can see the task or wrote to the task through the mailbox before.
+func (s *Service) isParticipant(ctx context.Context, dm domain.Mailbox, taskUUID uuid.UUID, sender string, isUser bool) (bool, error) {
+	if isUser {
+		err := s.ts.CheckVisible(ctx, taskUUID, sender)
+		if err == nil {
+			return true, nil
+		}
+
+		var notFoundErr dto.NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return false, err
+		}
+	}
+
+	return s.repo.IsSender(dm.UUID, taskUUID, sender)
+}
+
+// author is the sender if they are a user of the project company, otherwise
+// the mailbox owner writes on behalf of the sender.
+func (s *Service) author(dm domain.Mailbox, msg domain.MailMessage) (domain.Creator, bool) {
+	user, found := s.dict.FindUser(msg.Sender)
+	if found && lo.Contains(s.dict.GetUserCompanies(user.UUID), dm.CompanyUUID) {
+		return domain.Creator{UUID: user.UUID, Email: user.Email}, true
+	}
+
+	return domain.Creator{UUID: dm.CreatedByUUID, Email: dm.CreatedBy}, false
+}
+
+func (s *Service) createTask(dm domain.Mailbox, author domain.Creator, isUser bool, msg domain.MailMessage) (uuid.UUID, error) {
+	name := truncate(domain.MailSubject(msg.Subject), maxNameLen)
+	if len([]rune(name)) < 3 {
+		name = truncate("Письмо от "+msg.Sender, maxNameLen)
+	}
+
+	description := msg.BodyText
+	if !isUser {
+		description = signed(msg, description)
+	}
+
+	t, err := domain.NewTask(
+		name,
+		dm.FederationUUID,
+		dm.CompanyUUID,
+		dm.ProjectUUID,
+		author.Email,
+		map[string]interface{}{},
+		[]string{},
+
+		truncate(description, maxTextLen),
+		[]string{},
+		[]string{},
+		"",
+		"",
+
+		defaultPriority,
+
+		nil,
+		"",
+		"",
+
+		map[uuid.UUID][]string{},
+	)
+	if err != nil {
+		return t.UUID, err
+	}
+
+	_, err = s.ts.CreateTask(t)
+
+	return t.UUID, err
+}
+
+func (s *Service) comment(ctx context.Context, taskUUID uuid.UUID, author domain.Creator, isUser bool, msg domain.MailMessage, text string) (commentUUID uuid.UUID, err error) {
+	if !isUser {
+		text = signed(msg, text)
+	}
+
+	if len([]rune(text)) < 2 {
+		text = "Письмо: " + msg.Subject
+	}
+
+	cm := domain.NewComment(author.Email, taskUUID, uuid.Nil, []string{}, truncate(text, maxTextLen))
+
+	err = s.ts.CreateComment(ctx, taskUUID, *cm)
+
+	return cm.UUID, err
+}
+
+// attach uploads attachments to the comment. It runs after the email is
+// saved, so a failed upload is reported but does not repeat the comment.
+func (s *Service) attach(ctx context.Context, taskUUID, commentUUID uuid.UUID, author domain.Creator, atts []domain.MailAttachment) error {
+	if len(atts) == 0 {
+		return nil
+	}
+
+	t, err := s.ts.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	for _, att := range atts {
+		err = s.upload(t, commentUUID, author, att)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) upload(t domain.Task, commentUUID uuid.UUID, author domain.Creator, att domain.MailAttachment) error {
+	name := filepath.Base(att.Name)
+	storeFilePath := "/tmp/" + helpers.FakeString(10) + "-" + name
+
+	err := os.WriteFile(storeFilePath, att.Content, 0o600)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(storeFilePath)
+
+	_, err = s.storage.UploadTaskCommentFile(t.FederationUUID, t.UUID, commentUUID, name, storeFilePath, author.UUID)
+
+	return err
+}
+
+func signed(msg domain.MailMessage, text string) string {
+	from := msg.Sender
+	if msg.SenderName != "" {
+		from = fmt.Sprintf("%s <%s>", msg.SenderName, msg.Sender)
+	}
+
+	return fmt.Sprintf("От: %s\n\n%s", from, text)
+}
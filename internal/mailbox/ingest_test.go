@@ -0,0 +1,363 @@
+package mailbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/s3"
+)
+
+// fakeRepo keeps the emails in memory the way the mailbox_emails table does,
+// the rest of the repository is not used by the ingest.
+type fakeRepo struct {
+	repository
+	emails []MailboxEmail
+}
+
+func (r *fakeRepo) IsKnown(mailboxUUID uuid.UUID, messageID, bodyHash string) (bool, error) {
+	for _, e := range r.emails {
+		if e.MailboxUUID != mailboxUUID {
+			continue
+		}
+
+		if (messageID != "" && e.MessageID == messageID) || (messageID == "" && e.BodyHash == bodyHash) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *fakeRepo) IsSender(mailboxUUID, taskUUID uuid.UUID, sender string) (bool, error) {
+	for _, e := range r.emails {
+		if e.MailboxUUID == mailboxUUID && e.TaskUUID == taskUUID && e.Sender == sender {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *fakeRepo) FindThread(mailboxUUID uuid.UUID, messageIDs []string) (uuid.UUID, bool, error) {
+	for _, e := range r.emails {
+		for _, id := range messageIDs {
+			if e.MailboxUUID == mailboxUUID && e.MessageID == id {
+				return e.TaskUUID, true, nil
+			}
+		}
+	}
+
+	return uuid.Nil, false, nil
+}
+
+func (r *fakeRepo) SaveEmail(mailboxUUID uuid.UUID, msg domain.MailMessage, taskUUID uuid.UUID, commentUUID *uuid.UUID) error {
+	r.emails = append(r.emails, MailboxEmail{
+		MailboxUUID: mailboxUUID,
+		MessageID:   msg.MessageID,
+		BodyHash:    msg.BodyHash(),
+		Sender:      msg.Sender,
+		TaskUUID:    taskUUID,
+		CommentUUID: commentUUID,
+	})
+
+	return nil
+}
+
+type fakeUsers struct {
+	users     map[string]uuid.UUID
+	companyOf map[uuid.UUID]uuid.UUID
+}
+
+func (u fakeUsers) FindUser(email string) (*dto.UserDTO, bool) {
+	uid, ok := u.users[email]
+	if !ok {
+		return nil, false
+	}
+
+	return &dto.UserDTO{UUID: uid, Email: email}, true
+}
+
+func (u fakeUsers) GetUserCompanies(userUUID uuid.UUID) []uuid.UUID {
+	return []uuid.UUID{u.companyOf[userUUID]}
+}
+
+type fakeTasks struct {
+	tasks    map[uuid.UUID]domain.Task
+	hidden   map[string]bool
+	created  []domain.Task
+	comments map[uuid.UUID][]domain.Comment
+}
+
+func (f *fakeTasks) GetTaskUUIDByID(projectUUID uuid.UUID, id int) (uuid.UUID, bool, error) {
+	for _, t := range f.tasks {
+		if t.ProjectUUID == projectUUID && t.ID == id {
+			return t.UUID, true, nil
+		}
+	}
+
+	return uuid.Nil, false, nil
+}
+
+func (f *fakeTasks) GetTask(_ context.Context, uid uuid.UUID, _ []string) (domain.Task, error) {
+	t, ok := f.tasks[uid]
+	if !ok {
+		return t, dto.NotFoundErr("задача не найдена")
+	}
+
+	return t, nil
+}
+
+func (f *fakeTasks) CheckVisible(_ context.Context, uid uuid.UUID, email string) error {
+	if f.hidden[email] {
+		return dto.NotFoundErr("задача не найдена")
+	}
+
+	return nil
+}
+
+func (f *fakeTasks) CreateTask(t domain.Task) (int, error) {
+	f.created = append(f.created, t)
+	f.tasks[t.UUID] = t
+
+	return len(f.tasks), nil
+}
+
+func (f *fakeTasks) CreateComment(_ context.Context, uid uuid.UUID, cm domain.Comment) error {
+	f.comments[uid] = append(f.comments[uid], cm)
+
+	return nil
+}
+
+type fakeUploader struct {
+	names []string
+}
+
+func (u *fakeUploader) UploadTaskCommentFile(_, _, _ uuid.UUID, fileName, _ string, _ uuid.UUID) (s3.File, error) {
+	u.names = append(u.names, fileName)
+
+	return s3.File{Name: fileName}, nil
+}
+
+type ingestFixture struct {
+	s        *Service
+	repo     *fakeRepo
+	ts       *fakeTasks
+	uploads  *fakeUploader
+	dm       domain.Mailbox
+	existing domain.Task
+}
+
+// newIngestFixture is the mailbox of the project with the task #42, its
+// thread started with <first-1@example.org> from client@example.org.
+func newIngestFixture() *ingestFixture {
+	companyUUID, projectUUID := uuid.New(), uuid.New()
+	userUUID := uuid.New()
+
+	dm := domain.Mailbox{
+		UUID:          uuid.New(),
+		CompanyUUID:   companyUUID,
+		ProjectUUID:   projectUUID,
+		CreatedBy:     "owner@example.org",
+		CreatedByUUID: uuid.New(),
+	}
+
+	existing := domain.Task{UUID: uuid.New(), ID: 42, ProjectUUID: projectUUID, Name: "Заказ"}
+
+	f := &ingestFixture{
+		repo: &fakeRepo{emails: []MailboxEmail{
+			{MailboxUUID: dm.UUID, MessageID: "first-1@example.org", Sender: "client@example.org", TaskUUID: existing.UUID},
+		}},
+		ts: &fakeTasks{
+			tasks:    map[uuid.UUID]domain.Task{existing.UUID: existing},
+			hidden:   map[string]bool{},
+			comments: map[uuid.UUID][]domain.Comment{},
+		},
+		uploads:  &fakeUploader{},
+		dm:       dm,
+		existing: existing,
+	}
+
+	f.s = &Service{
+		repo: f.repo,
+		dict: fakeUsers{
+			users:     map[string]uuid.UUID{"manager@example.org": userUUID},
+			companyOf: map[uuid.UUID]uuid.UUID{userUUID: companyUUID},
+		},
+		ts:      f.ts,
+		storage: f.uploads,
+	}
+
+	return f
+}
+
+func message(sender, id, subject string, inReplyTo ...string) domain.MailMessage {
+	return domain.MailMessage{
+		MessageID:  id,
+		InReplyTo:  inReplyTo,
+		Sender:     sender,
+		Subject:    subject,
+		BodyText:   "Готово\n\n> old text",
+		ReceivedAt: time.Now(),
+	}
+}
+
+func TestIngestReplyByThread(t *testing.T) {
+	f := newIngestFixture()
+
+	msg := message("stranger@example.org", "reply-1@example.org", "Re: Заказ", "first-1@example.org")
+
+	if err := f.s.ingestMessage(context.Background(), f.dm, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	comments := f.ts.comments[f.existing.UUID]
+	if len(comments) != 1 || len(f.ts.created) != 0 {
+		t.Fatalf("comments = %d, created = %d", len(comments), len(f.ts.created))
+	}
+
+	if !strings.Contains(comments[0].Comment, "От: stranger@example.org") || !strings.HasSuffix(comments[0].Comment, "Готово") {
+		t.Errorf("comment = %q", comments[0].Comment)
+	}
+}
+
+func TestIngestReplyBySubjectTag(t *testing.T) {
+	f := newIngestFixture()
+
+	// the user of the company who can see the task and the earlier sender
+	for _, sender := range []string{"manager@example.org", "client@example.org"} {
+		msg := message(sender, "tag-"+sender, "Re: [#42] Заказ")
+
+		if err := f.s.ingestMessage(context.Background(), f.dm, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := len(f.ts.comments[f.existing.UUID]); n != 2 || len(f.ts.created) != 0 {
+		t.Fatalf("comments = %d, created = %d", n, len(f.ts.created))
+	}
+
+	if author := f.ts.comments[f.existing.UUID][0].CreatedBy; author != "manager@example.org" {
+		t.Errorf("user comment author = %s", author)
+	}
+}
+
+// The subject tag of an outside sender and of the user who can not see the
+// task does not reach the task, the message is a new task.
+func TestIngestRejectsSubjectTagOfStranger(t *testing.T) {
+	f := newIngestFixture()
+	f.ts.hidden["manager@example.org"] = true
+
+	for _, sender := range []string{"stranger@example.org", "manager@example.org"} {
+		msg := message(sender, "spoof-"+sender, "Re: [#42] Заказ")
+
+		if err := f.s.ingestMessage(context.Background(), f.dm, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := len(f.ts.comments[f.existing.UUID]); n != 0 {
+		t.Fatalf("the stranger commented the task %d times", n)
+	}
+
+	if len(f.ts.created) != 2 {
+		t.Fatalf("created = %d", len(f.ts.created))
+	}
+}
+
+func TestIngestDedupe(t *testing.T) {
+	f := newIngestFixture()
+
+	withID := message("client@example.org", "new-1@example.org", "Новый заказ")
+	withoutID := message("client@example.org", "", "Еще заказ")
+
+	for i := 0; i < 2; i++ {
+		for _, msg := range []domain.MailMessage{withID, withoutID} {
+			if err := f.s.ingestMessage(context.Background(), f.dm, msg); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if len(f.ts.created) != 2 {
+		t.Fatalf("created = %d, the repeated messages are not skipped", len(f.ts.created))
+	}
+
+	changed := withoutID
+	changed.BodyText = "Другой текст"
+
+	if err := f.s.ingestMessage(context.Background(), f.dm, changed); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.ts.created) != 3 {
+		t.Errorf("created = %d, the other body is not a new message", len(f.ts.created))
+	}
+}
+
+func TestIngestAttachments(t *testing.T) {
+	f := newIngestFixture()
+
+	msg := message("client@example.org", "files-1@example.org", "Документы")
+	msg.Attachments = []domain.MailAttachment{
+		{Name: "report.txt", Content: []byte("report")},
+		{Name: "../../etc/passwd", Content: []byte("x")},
+	}
+
+	if err := f.s.ingestMessage(context.Background(), f.dm, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.ts.created) != 1 {
+		t.Fatalf("created = %d", len(f.ts.created))
+	}
+
+	taskUUID := f.ts.created[0].UUID
+
+	comments := f.ts.comments[taskUUID]
+	if len(comments) != 1 || !strings.Contains(comments[0].Comment, "Вложения из письма") {
+		t.Fatalf("comments = %+v", comments)
+	}
+
+	if strings.Join(f.uploads.names, ",") != "report.txt,passwd" {
+		t.Errorf("uploads = %v", f.uploads.names)
+	}
+}
+
+func TestSealer(t *testing.T) {
+	s := newSealer("secret")
+
+	sealed, err := s.seal("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !isSealed(sealed) || strings.Contains(sealed, "password") {
+		t.Fatalf("seal() = %q", sealed)
+	}
+
+	if again, _ := s.seal("password"); again == sealed {
+		t.Error("seal() is not randomized")
+	}
+
+	if password, err := s.open(sealed); err != nil || password != "password" {
+		t.Errorf("open() = %q %v", password, err)
+	}
+
+	if password, err := s.open("legacy"); err != nil || password != "legacy" {
+		t.Errorf("open() of the plain password = %q %v", password, err)
+	}
+
+	if _, err := newSealer("other").open(sealed); err == nil {
+		t.Error("open() with the other secret returned no error")
+	}
+
+	var none *sealer
+	if _, err := none.seal("password"); err == nil {
+		t.Error("seal() without the secret returned no error")
+	}
+}
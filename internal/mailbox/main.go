@@ -0,0 +1,168 @@
+package mailbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/task"
+)
+
+type Service struct {
+	repo    repository
+	dict    users
+	ts      tasks
+	storage uploader
+	secret  *sealer
+
+	fetcher  *Fetcher
+	interval time.Duration
+	lease    time.Duration
+}
+
+func New(repo *Repository, dict *dictionary.Service, ts *task.Service, storage *s3.ServicePrivate, conf *configs.Configs) *Service {
+	timeout := time.Second * time.Duration(conf.MAIL_INGEST_TIMEOUT)
+
+	return &Service{
+		repo:    repo,
+		dict:    dict,
+		ts:      ts,
+		storage: storage,
+		secret:  newSealer(conf.MAIL_INGEST_SECRET),
+
+		fetcher: &Fetcher{
+			Timeout: timeout,
+			Batch:   conf.MAIL_INGEST_BATCH,
+		},
+		interval: time.Second * time.Duration(conf.MAIL_INGEST_INTERVAL),
+		lease:    timeout * 4,
+	}
+}
+
+// Create stores the mailbox with the password encrypted, dm keeps the
+// encrypted one.
+func (s *Service) Create(_ context.Context, dm *domain.Mailbox) error {
+	err := validate(dm)
+	if err != nil {
+		return err
+	}
+
+	dm.Password, err = s.secret.seal(dm.Password)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Create(dm)
+}
+
+func (s *Service) GetByProject(_ context.Context, projectUUID uuid.UUID) ([]domain.Mailbox, error) {
+	return s.repo.GetByProject(projectUUID)
+}
+
+func (s *Service) Get(_ context.Context, projectUUID, uid uuid.UUID) (dm domain.Mailbox, err error) {
+	dm, err = s.repo.GetByUUID(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.ProjectUUID != projectUUID {
+		return dm, dto.NotFoundErr("почтовый ящик не найден")
+	}
+
+	return dm, nil
+}
+
+func (s *Service) Update(ctx context.Context, projectUUID, uid uuid.UUID, email, password, server *string, isActive *bool) (dm domain.Mailbox, err error) {
+	dm, err = s.Get(ctx, projectUUID, uid)
+	if err != nil {
+		return dm, err
+	}
+
+	reset := false
+
+	if email != nil && strings.ToLower(*email) != dm.Email {
+		dm.Email = strings.ToLower(*email)
+		reset = true
+	}
+
+	if server != nil && *server != dm.Domain {
+		dm.Domain = *server
+		reset = true
+	}
+
+	if password != nil {
+		dm.Password = *password
+	}
+
+	if isActive != nil {
+		dm.IsActive = *isActive
+	}
+
+	err = validate(&dm)
+	if err != nil {
+		return dm, err
+	}
+
+	if password != nil {
+		dm.Password, err = s.secret.seal(*password)
+		if err != nil {
+			return dm, err
+		}
+	}
+
+	dm.LastError = ""
+	if reset {
+		dm.UIDValidity = 0
+		dm.LastUID = 0
+	}
+
+	return dm, s.repo.Update(&dm, reset)
+}
+
+func (s *Service) Delete(ctx context.Context, projectUUID, uid uuid.UUID) error {
+	_, err := s.Get(ctx, projectUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(uid)
+}
+
+// SealPasswords encrypts the passwords stored before the encryption.
+func (s *Service) SealPasswords(_ context.Context) (int, error) {
+	dms, err := s.repo.GetUnsealed()
+	if err != nil || len(dms) == 0 {
+		return 0, err
+	}
+
+	for _, dm := range dms {
+		sealed, err := s.secret.seal(dm.Password)
+		if err != nil {
+			return 0, err
+		}
+
+		err = s.repo.SavePassword(dm.UUID, sealed)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(dms), nil
+}
+
+func validate(dm *domain.Mailbox) error {
+	errs, ok := helpers.ValidationStruct(*dm)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package mailbox
+
+import (
+	"errors"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	_ "github.com/emersion/go-message/charset" // decodes koi8-r, windows-1251 and other legacy charsets
+	"github.com/emersion/go-message/mail"
+	"github.com/krisch/crm-backend/domain"
+)
+
+const maxAttachmentSize = 25 << 20
+
+var (
+	htmlBreaks = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>|</tr>`)
+	htmlTags   = regexp.MustCompile(`(?s)<style.*?</style>|<script.*?</script>|<[^>]*>`)
+)
+
+// ParseMessage reads an RFC 5322 message. The first text/plain part is the
+// body, text/html is used with the tags stripped when there is no plain
+// text. Attachments larger than maxAttachmentSize are skipped.
+func ParseMessage(uid uint32, r io.Reader) (msg domain.MailMessage, err error) {
+	mr, err := mail.CreateReader(r)
+	if err != nil && mr == nil {
+		return msg, err
+	}
+
+	msg.UID = uid
+
+	h := mr.Header
+
+	msg.Subject, _ = h.Subject()
+	msg.MessageID, _ = h.MessageID()
+
+	inReplyTo, _ := h.MsgIDList("In-Reply-To")
+	references, _ := h.MsgIDList("References")
+	msg.InReplyTo = append(inReplyTo, references...)
+
+	from, _ := h.AddressList("From")
+	if len(from) > 0 {
+		msg.Sender = strings.ToLower(from[0].Address)
+		msg.SenderName = from[0].Name
+	}
+
+	msg.ReceivedAt, err = h.Date()
+	if err != nil || msg.ReceivedAt.IsZero() {
+		msg.ReceivedAt = time.Now()
+	}
+
+	var htmlBody string
+
+	for {
+		p, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return msg, err
+		}
+
+		switch ph := p.Header.(type) {
+		case *mail.InlineHeader:
+			ct, _, _ := ph.ContentType()
+			if ct != "text/plain" && ct != "text/html" {
+				continue
+			}
+
+			b, err := io.ReadAll(p.Body)
+			if err != nil {
+				return msg, err
+			}
+
+			if ct == "text/plain" && msg.BodyText == "" {
+				msg.BodyText = string(b)
+			}
+
+			if ct == "text/html" && htmlBody == "" {
+				htmlBody = string(b)
+			}
+		case *mail.AttachmentHeader:
+			name, _ := ph.Filename()
+			if name == "" {
+				name = "attachment"
+			}
+
+			b, err := io.ReadAll(io.LimitReader(p.Body, maxAttachmentSize+1))
+			if err != nil {
+				return msg, err
+			}
+
+			if len(b) > maxAttachmentSize {
+				continue
+			}
+
+			msg.Attachments = append(msg.Attachments, domain.MailAttachment{
+				Name:    name,
+				Content: b,
+			})
+		}
+	}
+
+	if msg.BodyText == "" && htmlBody != "" {
+		msg.BodyText = stripHTML(htmlBody)
+	}
+
+	msg.BodyText = strings.TrimSpace(strings.ReplaceAll(msg.BodyText, "\r\n", "\n"))
+
+	return msg, nil
+}
+
+func stripHTML(s string) string {
+	s = htmlBreaks.ReplaceAllString(s, "\n")
+	s = htmlTags.ReplaceAllString(s, "")
+
+	return html.UnescapeString(s)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n])
+}
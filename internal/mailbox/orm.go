@@ -0,0 +1,69 @@
+package mailbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type Mailbox struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+	CompanyUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+	ProjectUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Email    string `gorm:"type:varchar(100);not null;"`
+	Password string `gorm:"type:text;default:'';not null;"`
+	Domain   string `gorm:"type:varchar(250);not null;"`
+	IsActive bool   `gorm:"type:bool;default:true;not null;"`
+
+	UIDValidity   int64      `gorm:"column:uid_validity;type:bigint;default:0;not null;"`
+	LastUID       int64      `gorm:"column:last_uid;type:bigint;default:0;not null;"`
+	LastFetchedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	LastError     string     `gorm:"type:text;default:'';not null;"`
+	NextFetchAt   time.Time  `gorm:"type:timestamptz;default:now();not null"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+func (o Mailbox) toDomain() domain.Mailbox {
+	return domain.Mailbox{
+		UUID:           o.UUID,
+		FederationUUID: o.FederationUUID,
+		CompanyUUID:    o.CompanyUUID,
+		ProjectUUID:    o.ProjectUUID,
+		CreatedBy:      o.CreatedBy,
+		CreatedByUUID:  o.CreatedByUUID,
+		Email:          o.Email,
+		Password:       o.Password,
+		Domain:         o.Domain,
+		IsActive:       o.IsActive,
+		UIDValidity:    uint32(o.UIDValidity),
+		LastUID:        uint32(o.LastUID),
+		LastFetchedAt:  o.LastFetchedAt,
+		LastError:      o.LastError,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+		DeletedAt:      o.DeletedAt,
+	}
+}
+
+type MailboxEmail struct {
+	UUID        uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	MailboxUUID uuid.UUID  `gorm:"type:uuid;not null;"`
+	MessageID   string     `gorm:"type:varchar(250);default:'';not null;"`
+	BodyHash    string     `gorm:"type:varchar(64);not null;"`
+	Sender      string     `gorm:"type:varchar(250);default:'';not null;"`
+	Subject     string     `gorm:"type:text;default:'';not null;"`
+	ReceivedAt  time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	TaskUUID    uuid.UUID  `gorm:"type:uuid;not null;"`
+	CommentUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
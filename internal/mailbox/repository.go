@@ -0,0 +1,263 @@
+package mailbox
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm *domain.Mailbox) error {
+	return r.gorm.DB.Create(&Mailbox{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		CreatedBy:      dm.CreatedBy,
+		CreatedByUUID:  dm.CreatedByUUID,
+		Email:          dm.Email,
+		Password:       dm.Password,
+		Domain:         dm.Domain,
+		IsActive:       dm.IsActive,
+	}).Error
+}
+
+func (r *Repository) GetByProject(projectUUID uuid.UUID) (dms []domain.Mailbox, err error) {
+	orms := []Mailbox{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Order("created_at desc").
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item Mailbox, _ int) domain.Mailbox {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) GetByUUID(uid uuid.UUID) (dm domain.Mailbox, err error) {
+	orm := Mailbox{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("почтовый ящик не найден")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return orm.toDomain(), nil
+}
+
+// Update saves the settings. Changing the server or the login starts the
+// mailbox from scratch.
+func (r *Repository) Update(dm *domain.Mailbox, reset bool) error {
+	values := map[string]interface{}{
+		"email":      dm.Email,
+		"password":   dm.Password,
+		"domain":     dm.Domain,
+		"is_active":  dm.IsActive,
+		"last_error": "",
+		"updated_at": time.Now(),
+	}
+
+	if reset {
+		values["uid_validity"] = 0
+		values["last_uid"] = 0
+		values["next_fetch_at"] = time.Now()
+	}
+
+	res := r.gorm.DB.
+		Model(&Mailbox{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(values)
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("почтовый ящик не найден")
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Mailbox{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("почтовый ящик не найден")
+	}
+
+	return nil
+}
+
+// GetUnsealed returns the mailboxes with the passwords stored before the
+// encryption.
+func (r *Repository) GetUnsealed() (dms []domain.Mailbox, err error) {
+	orms := []Mailbox{}
+
+	err = r.gorm.DB.
+		Where("password NOT LIKE ?", sealedPrefix+"%").
+		Where("deleted_at is null").
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item Mailbox, _ int) domain.Mailbox {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) SavePassword(uid uuid.UUID, password string) error {
+	return r.gorm.DB.
+		Model(&Mailbox{}).
+		Where("uuid = ?", uid).
+		Update("password", password).Error
+}
+
+// ClaimMailboxes picks active mailboxes due for polling and moves their next
+// fetch forward by lease, so concurrent workers skip them.
+func (r *Repository) ClaimMailboxes(limit int, lease time.Duration) (dms []domain.Mailbox, err error) {
+	orms := []Mailbox{}
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		return tx.Raw(`
+			UPDATE mailboxes SET next_fetch_at = now() + make_interval(secs => ?)
+			WHERE uuid IN (
+				SELECT uuid FROM mailboxes
+				WHERE is_active = true
+					AND deleted_at IS NULL
+					AND next_fetch_at <= now()
+				ORDER BY next_fetch_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *`, lease.Seconds(), limit).Scan(&orms).Error
+	})
+
+	dms = helpers.Map(orms, func(item Mailbox, _ int) domain.Mailbox {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+// SaveState stores the position in the inbox after a poll and schedules the
+// next one.
+func (r *Repository) SaveState(dm domain.Mailbox, next time.Duration) error {
+	return r.gorm.DB.
+		Model(&Mailbox{}).
+		Where("uuid = ?", dm.UUID).
+		Updates(map[string]interface{}{
+			"uid_validity":    int64(dm.UIDValidity),
+			"last_uid":        int64(dm.LastUID),
+			"last_fetched_at": dm.LastFetchedAt,
+			"last_error":      dm.LastError,
+			"next_fetch_at":   time.Now().Add(next),
+		}).Error
+}
+
+// IsKnown reports whether the message was already turned into a task or a
+// comment.
+func (r *Repository) IsKnown(mailboxUUID uuid.UUID, messageID, bodyHash string) (bool, error) {
+	var count int64
+
+	q := r.gorm.DB.
+		Model(&MailboxEmail{}).
+		Where("mailbox_uuid = ?", mailboxUUID)
+
+	if messageID != "" {
+		q = q.Where("message_id = ?", messageID)
+	} else {
+		q = q.Where("body_hash = ?", bodyHash)
+	}
+
+	err := q.Count(&count).Error
+
+	return count > 0, err
+}
+
+// IsSender reports whether the sender wrote to the task through the mailbox
+// before.
+func (r *Repository) IsSender(mailboxUUID, taskUUID uuid.UUID, sender string) (bool, error) {
+	var count int64
+
+	err := r.gorm.DB.
+		Model(&MailboxEmail{}).
+		Where("mailbox_uuid = ?", mailboxUUID).
+		Where("task_uuid = ?", taskUUID).
+		Where("sender = ?", sender).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+// FindThread returns the task created or commented by one of the messages.
+func (r *Repository) FindThread(mailboxUUID uuid.UUID, messageIDs []string) (taskUUID uuid.UUID, found bool, err error) {
+	if len(messageIDs) == 0 {
+		return taskUUID, false, nil
+	}
+
+	orm := MailboxEmail{}
+
+	res := r.gorm.DB.
+		Where("mailbox_uuid = ?", mailboxUUID).
+		Where("message_id IN ?", messageIDs).
+		Order("created_at desc").
+		Limit(1).
+		Find(&orm)
+
+	if res.Error != nil {
+		return taskUUID, false, res.Error
+	}
+
+	return orm.TaskUUID, res.RowsAffected > 0, nil
+}
+
+func (r *Repository) SaveEmail(mailboxUUID uuid.UUID, msg domain.MailMessage, taskUUID uuid.UUID, commentUUID *uuid.UUID) error {
+	return r.gorm.DB.Create(&MailboxEmail{
+		UUID:        uuid.New(),
+		MailboxUUID: mailboxUUID,
+		MessageID:   truncate(msg.MessageID, 250),
+		BodyHash:    msg.BodyHash(),
+		Sender:      truncate(msg.Sender, 250),
+		Subject:     msg.Subject,
+		ReceivedAt:  msg.ReceivedAt,
+		TaskUUID:    taskUUID,
+		CommentUUID: commentUUID,
+	}).Error
+}
@@ -0,0 +1,84 @@
+package mailbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sealedPrefix marks the encrypted password, the passwords stored before the
+// encryption have none.
+const sealedPrefix = "enc:"
+
+var ErrNoSecret = errors.New("не задан ключ шифрования паролей почтовых ящиков")
+
+// sealer encrypts the mailbox passwords at rest with AES-GCM, the key is the
+// sha256 of MAIL_INGEST_SECRET. The nil sealer has no key.
+type sealer struct {
+	aead cipher.AEAD
+}
+
+func newSealer(secret string) *sealer {
+	if secret == "" {
+		return nil
+	}
+
+	key := sha256.Sum256([]byte(secret))
+
+	// the key is 32 bytes, the cipher is always made
+	block, _ := aes.NewCipher(key[:])
+	aead, _ := cipher.NewGCM(block)
+
+	return &sealer{aead: aead}
+}
+
+func (s *sealer) seal(password string) (string, error) {
+	if s == nil {
+		return "", ErrNoSecret
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := s.aead.Seal(nonce, nonce, []byte(password), nil)
+
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts the stored password, the password stored before the
+// encryption is returned as is.
+func (s *sealer) open(stored string) (string, error) {
+	encoded, ok := strings.CutPrefix(stored, sealedPrefix)
+	if !ok {
+		return stored, nil
+	}
+
+	if s == nil {
+		return "", ErrNoSecret
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return "", fmt.Errorf("пароль почтового ящика поврежден")
+	}
+
+	n := s.aead.NonceSize()
+
+	password, err := s.aead.Open(nil, sealed[:n], sealed[n:], nil)
+	if err != nil {
+		return "", fmt.Errorf("не удалось расшифровать пароль почтового ящика: %w", err)
+	}
+
+	return string(password), nil
+}
+
+func isSealed(stored string) bool {
+	return strings.HasPrefix(stored, sealedPrefix)
+}
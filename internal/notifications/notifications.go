@@ -59,3 +59,11 @@ func (s *Service) HideNotification(ctx context.Context, typeName, email string,
 	defer Span(NewSpan(ctx, "HideNotification"))()
 	return s.repo.HideNotification(email, typeName+":"+uid.String())
 }
+
+func (s *Service) CreateApproval(email string, taskUUID uuid.UUID) error {
+	return s.repo.StoreNotification(email, "approval", taskUUID)
+}
+
+func (s *Service) CreateFileInfected(email string, taskUUID uuid.UUID) error {
+	return s.repo.StoreNotification(email, "file_infected", taskUUID)
+}
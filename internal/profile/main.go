@@ -436,6 +436,10 @@ func (s *Service) UpdatePreference(uid uuid.UUID, key, value string) error {
 	return s.repo.UpdatePreference(uid, key, value)
 }
 
+func (s *Service) GetPreferencesByEmails(emails []string) (map[string]domain.ProfilePreferences, error) {
+	return s.repo.GetPreferencesByEmails(emails)
+}
+
 func (s *Service) ChangePreferences(uid uuid.UUID, prefs domain.ProfilePreferences) (err error) {
 	j, err := json.Marshal(prefs)
 	if err != nil {
@@ -449,6 +453,10 @@ func (s *Service) ChangePreferences(uid uuid.UUID, prefs domain.ProfilePreferenc
 		}
 	}
 
+	if prefs.WeeklyCapacity != nil && (*prefs.WeeklyCapacity < 1 || *prefs.WeeklyCapacity > 100) {
+		return errors.New("загрузка в неделю должна быть от 1 до 100 задач")
+	}
+
 	err = s.repo.gorm.DB.
 		Exec("UPDATE users SET updated_at = NOW(), preferences = preferences || ? WHERE uuid = ?", j, uid).
 		Error
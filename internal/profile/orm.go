@@ -37,7 +37,8 @@ type User struct {
 }
 
 type UserPreferences struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone       *string `json:"timezone,omitempty"`
+	WeeklyCapacity *int    `json:"weekly_capacity,omitempty"`
 }
 
 func (j *UserPreferences) Scan(value interface{}) error {
@@ -130,7 +130,8 @@ func (r *Repository) GetUser(uid uuid.UUID, fields ...string) (user domain.User,
 		Color:    orm.Color,
 
 		Preferences: domain.ProfilePreferences{
-			Timezone: orm.Preferences.Timezone,
+			Timezone:       orm.Preferences.Timezone,
+			WeeklyCapacity: orm.Preferences.WeeklyCapacity,
 		},
 
 		CreatedAt: orm.CreatedAt,
@@ -630,6 +631,30 @@ func (r *Repository) UpdatePreference(uid uuid.UUID, key, value string) error {
 	return err
 }
 
+func (r *Repository) GetPreferencesByEmails(emails []string) (mp map[string]domain.ProfilePreferences, err error) {
+	orms := []User{}
+	mp = make(map[string]domain.ProfilePreferences)
+
+	if len(emails) == 0 {
+		return mp, nil
+	}
+
+	err = r.gorm.DB.
+		Select("email, preferences").
+		Where("email in (?)", emails).
+		Where("deleted_at is null").
+		Find(&orms).Error
+
+	for _, orm := range orms {
+		mp[orm.Email] = domain.ProfilePreferences{
+			Timezone:       orm.Preferences.Timezone,
+			WeeklyCapacity: orm.Preferences.WeeklyCapacity,
+		}
+	}
+
+	return mp, err
+}
+
 func (r *Repository) CreateSurvey(survey domain.Survey) (err error) {
 	orm := Survey{
 		UUID:      survey.UUID,
@@ -1,7 +1,14 @@
 package reminders
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
 
 func (s *Service) OnReminderWasUpdatedOrCreated(fn func(uuid.UUID, uuid.UUID, []string) error) {
 	s.onReminderWasUpdatedOrCreated = fn
 }
+
+func (s *Service) OnReminderCreated(fn func(domain.Reminder) error) {
+	s.onReminderCreated = fn
+}
@@ -3,6 +3,7 @@ package reminders
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -17,6 +18,7 @@ type Service struct {
 	dict *dictionary.Service
 
 	onReminderWasUpdatedOrCreated func(uuid.UUID, uuid.UUID, []string) error
+	onReminderCreated             func(domain.Reminder) error
 }
 
 func New(repo *Repository, dict *dictionary.Service) *Service {
@@ -37,12 +39,26 @@ func (s *Service) ReminderWasUpdatedOrCreated(uid, taskUUID uuid.UUID, people []
 	return nil
 }
 
+func (s *Service) ReminderWasCreated(r domain.Reminder) {
+	if s.onReminderCreated == nil {
+		return
+	}
+
+	err := s.onReminderCreated(r)
+	if err != nil {
+		logrus.WithError(err).Error("ReminderWasCreated error")
+	}
+}
+
 func (s *Service) Create(r domain.Reminder) (err error) {
 	if r.DateFrom != nil && r.DateTo != nil && !helpers.IsTheSameDay(*r.DateFrom, *r.DateTo) {
 		return fmt.Errorf("даты должны быть в один день")
 	}
 
 	err = s.repo.Create(r)
+	if err == nil {
+		s.ReminderWasCreated(r)
+	}
 
 	if r.UserUUID != nil {
 		cuser, cu := s.dict.FindUserByUUID(*r.UserUUID)
@@ -138,6 +154,10 @@ func (s *Service) GetByUser(uid uuid.UUID) (dms []domain.Reminder, err error) {
 	return dms, err
 }
 
+func (s *Service) GetByUserForPeriod(uid uuid.UUID, from, to time.Time) ([]domain.Reminder, error) {
+	return s.repo.GetByUserForPeriod(uid, from, to)
+}
+
 func (s *Service) GetByTask(uid uuid.UUID) (dms []domain.Reminder, err error) {
 	dms, err = s.repo.GetByTask(uid)
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type Reminder struct {
@@ -23,3 +24,21 @@ type Reminder struct {
 	UpdatedAt time.Time
 	DeletedAt *time.Time
 }
+
+func (o Reminder) toDomain() domain.Reminder {
+	return domain.Reminder{
+		UUID:          o.UUID,
+		CreatedBy:     o.CreatedBy,
+		CreatedByUUID: o.CreatedByUUID,
+		TaskUUID:      o.TaskUUID,
+		DateFrom:      o.DateFrom,
+		DateTo:        o.DateTo,
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+		Description:   o.Description,
+		Comment:       o.Comment,
+		Type:          o.Type,
+		UserUUID:      o.UserUUID,
+		Status:        o.Status,
+	}
+}
@@ -2,6 +2,7 @@ package reminders
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -114,6 +115,30 @@ func (r *Repository) GetByUser(uid uuid.UUID) (dms []domain.Reminder, err error)
 	return dms, nil
 }
 
+// GetByUserForPeriod returns reminders of the user overlapping the period, a
+// reminder with one date only is a point in time.
+func (r *Repository) GetByUserForPeriod(uid uuid.UUID, from, to time.Time) (dms []domain.Reminder, err error) {
+	orm := []Reminder{}
+
+	err = r.gorm.DB.
+		Where("(created_by_uuid = ? or user_uuid = ?)", uid, uid).
+		Where("coalesce(date_from, date_to) < ?", to).
+		Where("coalesce(date_to, date_from) >= ?", from).
+		Where("deleted_at IS NULL").
+		Order("coalesce(date_from, date_to)").
+		Find(&orm).
+		Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = lo.Map(orm, func(item Reminder, _ int) domain.Reminder {
+		return item.toDomain()
+	})
+
+	return dms, nil
+}
+
 func (r *Repository) ChangeField(uid uuid.UUID, fieldName string, value interface{}) error {
 	res := r.gorm.DB.
 		Model(&Reminder{}).
@@ -0,0 +1,129 @@
+package s3
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ArchiveFile is the stored file put into the folder of the archive, the
+// empty folder is the root, subfolders are separated by slashes.
+type ArchiveFile struct {
+	UUID   uuid.UUID
+	Folder string
+}
+
+// WriteArchive streams the files from the storage into the zip without
+// temporary files. The original names are kept and deduplicated within the
+// folder, the files which are not clean are skipped.
+func (s3 *ServicePrivate) WriteArchive(ctx context.Context, w io.Writer, items []ArchiveFile) error {
+	files, err := s3.repo.GetFiles(lo.Map(items, func(item ArchiveFile, _ int) uuid.UUID {
+		return item.UUID
+	}))
+	if err != nil {
+		return err
+	}
+
+	byUUID := lo.KeyBy(files, func(file File) uuid.UUID {
+		return file.UUID
+	})
+
+	zw := zip.NewWriter(w)
+	names := archiveNames{}
+
+	for _, item := range items {
+		file, ok := byUUID[item.UUID]
+		if !ok || file.scanErr() != nil {
+			continue
+		}
+
+		err = s3.archiveFile(ctx, zw, names.add(item.Folder, file), file)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func (s3 *ServicePrivate) archiveFile(ctx context.Context, zw *zip.Writer, name string, file File) error {
+	obj, err := s3.storage.Get(ctx, file.ObjectName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file.ObjectName, err)
+	}
+	defer obj.Close()
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: file.CreatedAt,
+	}
+
+	// images and media are compressed already
+	if kind := previewKind(file.MimeType); kind == previewKindImage || kind == previewKindMedia {
+		header.Method = zip.Store
+	}
+
+	fw, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, obj)
+
+	return err
+}
+
+// archiveNames makes the entry names unique, the names are compared case
+// insensitive as the file systems of the users do.
+type archiveNames map[string]bool
+
+func (names archiveNames) add(folder string, file File) string {
+	folders := lo.FilterMap(strings.Split(folder, "/"), func(item string, _ int) (string, bool) {
+		item = archiveName(item)
+		return item, item != ""
+	})
+	folder = strings.Join(folders, "/")
+
+	name := archiveName(file.Name)
+	if name == "" {
+		name = file.UUID.String()
+	}
+
+	ext := path.Ext(name)
+	if ext == "" && file.Ext != "" {
+		ext = file.Ext
+		name += ext
+	}
+
+	base := strings.TrimSuffix(name, ext)
+	entry := path.Join(folder, name)
+
+	for i := 1; names[strings.ToLower(entry)]; i++ {
+		entry = path.Join(folder, fmt.Sprintf("%s (%d)%s", base, i, ext))
+	}
+
+	names[strings.ToLower(entry)] = true
+
+	return entry
+}
+
+// archiveName makes the name safe for the entry or the folder of the zip.
+func archiveName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r == '/', r == '\\', r == ':', r < ' ':
+			return '_'
+		}
+
+		return r
+	}, name)
+
+	return strings.Trim(strings.TrimSpace(name), ".")
+}
@@ -0,0 +1,110 @@
+package s3
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestArchiveNames(t *testing.T) {
+	names := archiveNames{}
+
+	tests := []struct {
+		folder string
+		file   File
+		want   string
+	}{
+		{"", File{Name: "Договор.pdf"}, "Договор.pdf"},
+		{"", File{Name: "договор.PDF"}, "договор (1).PDF"},
+		{"", File{Name: "Договор.pdf"}, "Договор (2).pdf"},
+		{"comments", File{Name: "Договор.pdf"}, "comments/Договор.pdf"},
+		{"12 Поставка/comments", File{Name: "../../etc/passwd"}, "12 Поставка/comments/_.._etc_passwd"},
+		{"1/2: a", File{Name: "акт", Ext: ".docx"}, "1/2_ a/акт.docx"},
+		{"../", File{Name: "..", UUID: uuid.MustParse("6f0a0c47-7f3f-4f4e-9a55-3f1c2a1b2c3d"), Ext: ".txt"}, "6f0a0c47-7f3f-4f4e-9a55-3f1c2a1b2c3d.txt"},
+	}
+
+	for _, tt := range tests {
+		if got := names.add(tt.folder, tt.file); got != tt.want {
+			t.Errorf("add(%q, %q) = %q, want %q", tt.folder, tt.file.Name, got, tt.want)
+		}
+	}
+}
+
+func TestArchiveFile(t *testing.T) {
+	ctx := context.Background()
+
+	s3 := &ServicePrivate{storage: NewMemoryStorage(StorageConf{BucketName: "private"})}
+
+	files := []File{
+		{ObjectName: "f/task/t/1.txt", MimeType: "text/plain", CreatedAt: time.Now()},
+		{ObjectName: "f/task/t/2.png", MimeType: "image/png", CreatedAt: time.Now()},
+	}
+
+	for _, file := range files {
+		err := s3.storage.Put(ctx, file.ObjectName, strings.NewReader(file.ObjectName), int64(len(file.ObjectName)), file.MimeType)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := bytes.Buffer{}
+	zw := zip.NewWriter(&buf)
+
+	for i, file := range files {
+		if err := s3.archiveFile(ctx, zw, []string{"a.txt", "b/c.png"}[i], file); err != nil {
+			t.Fatalf("archiveFile() error = %v", err)
+		}
+	}
+
+	if err := s3.archiveFile(ctx, zw, "missing.txt", File{ObjectName: "f/none"}); err == nil {
+		t.Error("archiveFile() of the missing object returned no error")
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]struct {
+		content string
+		method  uint16
+	}{
+		"a.txt":   {"f/task/t/1.txt", zip.Deflate},
+		"b/c.png": {"f/task/t/2.png", zip.Store},
+	}
+
+	for _, f := range zr.File {
+		w, ok := want[f.Name]
+		if !ok {
+			t.Errorf("unexpected entry %q", f.Name)
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+
+		if string(data) != w.content || f.Method != w.method {
+			t.Errorf("entry %q = %q method %d", f.Name, data, f.Method)
+		}
+
+		delete(want, f.Name)
+	}
+
+	if len(want) > 0 {
+		t.Errorf("entries are missing: %v", want)
+	}
+}
@@ -0,0 +1,218 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/sirupsen/logrus"
+)
+
+// fileSHA256 is the hex sha256 of the local file.
+func fileSHA256(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// storeFile hashes and stores the local file within the quota, the shared
+// content is counted once.
+func (s3 *ServicePrivate) storeFile(ctx context.Context, file *File, filePath string) error {
+	sum, err := fileSHA256(filePath)
+	if err != nil {
+		return err
+	}
+
+	file.SHA256 = sum
+
+	err = s3.reserve(*file)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s3.storeObject(ctx, file, filePath)
+	if err != nil || !stored {
+		s3.release(*file)
+	}
+
+	return err
+}
+
+// storeObject stores the local file as the object of the file unless the
+// federation has the same content, then the file points to the stored object
+// and false is returned.
+func (s3 *ServicePrivate) storeObject(ctx context.Context, file *File, filePath string) (bool, error) {
+	federationUUID, ok := objectFederation(file.ObjectName)
+	if !ok || file.SHA256 == "" {
+		return true, putFile(ctx, s3.storage, file.ObjectName, filePath, file.MimeType)
+	}
+
+	objectName, err := s3.repo.RefBlob(federationUUID, file.SHA256)
+	if err != nil {
+		return false, err
+	}
+
+	if objectName != "" {
+		file.ObjectName = objectName
+		return false, nil
+	}
+
+	err = putFile(ctx, s3.storage, file.ObjectName, filePath, file.MimeType)
+	if err != nil {
+		return false, err
+	}
+
+	objectName, err = s3.repo.AcquireBlob(FileBlob{
+		FederationUUID: federationUUID,
+		SHA256:         file.SHA256,
+		ObjectName:     file.ObjectName,
+		Size:           file.Size,
+	})
+	if err != nil {
+		s3.discardObject(ctx, file.ObjectName)
+		return false, err
+	}
+
+	if objectName == file.ObjectName {
+		return true, nil
+	}
+
+	// the same content was stored meanwhile
+	s3.discardObject(ctx, file.ObjectName)
+	file.ObjectName = objectName
+
+	return false, nil
+}
+
+// shareFile stores the file pointing to the content of the src, nothing is
+// uploaded or copied. False is returned when the content is not shared.
+func (s3 *ServicePrivate) shareFile(file, src File) (File, bool, error) {
+	federationUUID, ok := objectFederation(src.ObjectName)
+	if !ok || src.SHA256 == "" {
+		return file, false, nil
+	}
+
+	objectName, err := s3.repo.RefBlob(federationUUID, src.SHA256)
+	if err != nil || objectName == "" {
+		return file, false, err
+	}
+
+	file.ObjectName = objectName
+	file.SHA256 = src.SHA256
+	file.PreviewObjectName = ""
+
+	if objectName == src.ObjectName {
+		file.PreviewObjectName = src.PreviewObjectName
+	}
+
+	err = s3.repo.Create(file)
+	if err != nil {
+		if errDrop := s3.dropFile(context.Background(), file); errDrop != nil {
+			logrus.WithError(errDrop).WithField("object", file.ObjectName).Error("drop shared file")
+		}
+
+		return file, false, err
+	}
+
+	s3.enqueueFile(file)
+
+	return file, true, nil
+}
+
+// dropFile removes the objects of the file unless other files share its
+// content, the usage is released for the removed objects.
+func (s3 *ServicePrivate) dropFile(ctx context.Context, file File) error {
+	federationUUID, ok := objectFederation(file.ObjectName)
+	if ok && file.SHA256 != "" {
+		last, err := s3.repo.ReleaseBlob(federationUUID, file.SHA256)
+		if err != nil {
+			return err
+		}
+
+		if !last {
+			return nil
+		}
+
+		// the preview is made of the shared object, it goes with the object
+		file.PreviewObjectName = previewObjectName(file.ObjectName)
+	}
+
+	err := s3.removeObjects(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	s3.release(file)
+
+	return nil
+}
+
+// discardObject removes the object which is not used by any file.
+func (s3 *ServicePrivate) discardObject(ctx context.Context, objectName string) {
+	if err := s3.storage.Remove(ctx, objectName); err != nil {
+		logrus.WithError(err).WithField("object", objectName).Error("discard object")
+	}
+}
+
+// GetHashTasks returns the tasks of the federation with the content, the
+// content is attached by the hash only from the tasks the user can see.
+func (s3 *ServicePrivate) GetHashTasks(federatonUUID uuid.UUID, hash string) ([]uuid.UUID, error) {
+	return s3.repo.GetHashTasks(federatonUUID, strings.ToLower(hash))
+}
+
+// UploadTaskFileByHash attaches the content already stored in the federation
+// to the task, so the client skips the upload of the known content. The
+// source file is of the visible tasks.
+func (s3 *ServicePrivate) UploadTaskFileByHash(federatonUUID, taskUUID uuid.UUID, hash string, visible []uuid.UUID, fileName string, userUUID uuid.UUID) (file File, err error) {
+	src, err := s3.repo.GetFileByHash(federatonUUID, strings.ToLower(hash), visible)
+	if err != nil {
+		return file, err
+	}
+
+	if src.ScanStatus == domain.FileScanInfected {
+		return file, domain.ErrFileInfected
+	}
+
+	file = src
+	file.UUID = uuid.New()
+	file.ChainUUID = uuid.Nil
+	file.Version = 1
+	file.IsCurrent = true
+	file.Type = "task"
+	file.TypeUUID = taskUUID
+	file.CreatedBy = userUUID
+	file.CreatedAt = time.Now()
+	file.DeletedAt = nil
+	file.ToDeletedAt = nil
+
+	if fileName != "" {
+		file.Name = fileName
+	}
+
+	file, ok, err := s3.shareFile(file, src)
+	if err != nil {
+		return file, err
+	}
+
+	if !ok {
+		return file, dto.NotFoundErr("файл с таким содержимым не найден")
+	}
+
+	return file, nil
+}
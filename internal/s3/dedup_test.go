@@ -0,0 +1,70 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSHA256(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "a.txt")
+
+	err := os.WriteFile(src, []byte("abc"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum, err := fileSHA256(src)
+	if err != nil {
+		t.Fatalf("fileSHA256() error = %v", err)
+	}
+
+	if sum != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Errorf("fileSHA256() = %s", sum)
+	}
+
+	if _, err = fileSHA256(filepath.Join(t.TempDir(), "none")); err == nil {
+		t.Error("fileSHA256() of the missing file returned no error")
+	}
+}
+
+// The objects outside of the federation folders and the old files without
+// the hash are not shared, they are stored and removed as they are.
+func TestStoreObjectNotShared(t *testing.T) {
+	ctx := context.Background()
+
+	s3 := &ServicePrivate{storage: NewMemoryStorage(StorageConf{BucketName: "private"})}
+
+	src := filepath.Join(t.TempDir(), "a.txt")
+
+	err := os.WriteFile(src, []byte("счет"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := []File{
+		{ObjectName: "tmp/a.txt", SHA256: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", MimeType: "text/plain"},
+		{ObjectName: "6f0a0c47-7f3f-4f4e-9a55-3f1c2a1b2c3d/task/t/a.txt", MimeType: "text/plain"},
+	}
+
+	for _, file := range files {
+		stored, err := s3.storeObject(ctx, &file, src)
+		if err != nil || !stored {
+			t.Fatalf("storeObject(%s) = %v %v", file.ObjectName, stored, err)
+		}
+
+		if _, err = s3.storage.Get(ctx, file.ObjectName); err != nil {
+			t.Errorf("object %s is not stored: %v", file.ObjectName, err)
+		}
+	}
+
+	if err = s3.dropFile(ctx, files[0]); err != nil {
+		t.Fatalf("dropFile() error = %v", err)
+	}
+
+	if _, err = s3.storage.Get(ctx, files[0].ObjectName); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("dropFile() kept the object: %v", err)
+	}
+}
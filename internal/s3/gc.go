@@ -0,0 +1,355 @@
+package s3
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const photoPrefix = "photos-"
+
+// GCConf is the run of the garbage collector. The objects and the rows newer
+// than Grace are skipped as they may be stored right now.
+type GCConf struct {
+	DryRun    bool
+	Retention time.Duration
+	Grace     time.Duration
+
+	// Rate is the deletes per second, zero is unlimited
+	Rate int
+}
+
+// GCReport is what the garbage collector found, all of it is deleted unless
+// it is the dry run.
+type GCReport struct {
+	// Orphans are the objects of the federations without the file rows
+	Orphans []ObjectInfo
+	// Missing are the file rows without the objects
+	Missing []File
+	// Expired are the files of the tasks and comments deleted before the
+	// retention and the files left by the interrupted delete
+	Expired []File
+	// Photos are the sizes of the profile photos which are no longer made
+	// and the photos of the users without one
+	Photos []ObjectInfo
+	// Blobs are the shared contents whose refs differ from their files, the
+	// refs are of the files
+	Blobs []FileBlob
+
+	Deleted int
+}
+
+// Bytes is the size of the found objects.
+func (r GCReport) Bytes() int64 {
+	sumObjects := func(obj ObjectInfo) int64 {
+		return obj.Size
+	}
+
+	return lo.SumBy(r.Orphans, sumObjects) + lo.SumBy(r.Photos, sumObjects) + lo.SumBy(r.Expired, func(file File) int64 {
+		return file.Size
+	})
+}
+
+// Log writes every found item and the totals.
+func (r GCReport) Log(dryRun bool) {
+	for _, obj := range r.Orphans {
+		logrus.WithField("object", obj.Name).WithField("size", obj.Size).Info("gc: object without file")
+	}
+
+	for _, file := range r.Missing {
+		logrus.WithField("file_uuid", file.UUID).WithField("object", file.ObjectName).Info("gc: file without object")
+	}
+
+	for _, file := range r.Expired {
+		logrus.WithField("file_uuid", file.UUID).WithField("object", file.ObjectName).WithField("size", file.Size).Info("gc: expired file")
+	}
+
+	for _, obj := range r.Photos {
+		logrus.WithField("object", obj.Name).WithField("size", obj.Size).Info("gc: stale photo")
+	}
+
+	for _, blob := range r.Blobs {
+		logrus.WithField("sha256", blob.SHA256).WithField("object", blob.ObjectName).WithField("refs", blob.Refs).Info("gc: blob refs drift")
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"dry_run": dryRun,
+		"orphans": len(r.Orphans),
+		"missing": len(r.Missing),
+		"expired": len(r.Expired),
+		"photos":  len(r.Photos),
+		"blobs":   len(r.Blobs),
+		"deleted": r.Deleted,
+	}).Infof("gc: %s found", megabytes(r.Bytes()))
+}
+
+// CollectGarbage reconciles the bucket with the files table both ways and
+// removes the files of the deleted tasks and comments. The refs of the shared
+// contents are recounted first, so the interrupted delete does not uncount
+// them twice. The usage of the removed objects is released.
+func (s3 *ServicePrivate) CollectGarbage(ctx context.Context, conf GCConf) (report GCReport, err error) {
+	now := time.Now()
+
+	report.Blobs, err = s3.repo.GetBlobDrift(now.Add(-conf.Grace))
+	if err != nil {
+		return report, err
+	}
+
+	if !conf.DryRun {
+		err = s3.repo.FixBlobRefs(report.Blobs, now.Add(-conf.Grace))
+		if err != nil {
+			return report, err
+		}
+	}
+
+	// the object is stored before its row is created, the new objects are
+	// kept by the grace
+	objects, err := s3.storage.ListObjects(ctx, "")
+	if err != nil {
+		return report, err
+	}
+
+	files, err := s3.repo.GetStoredFiles()
+	if err != nil {
+		return report, err
+	}
+
+	blobs, err := s3.repo.GetBlobObjects()
+	if err != nil {
+		return report, err
+	}
+
+	report.Expired, err = s3.repo.GetExpiredFiles(now.Add(-conf.Grace), now.Add(-conf.Retention))
+	if err != nil {
+		return report, err
+	}
+
+	report.Orphans, report.Missing = reconcile(objects, files, blobs, now.Add(-conf.Grace))
+
+	expired := lo.SliceToMap(report.Expired, func(file File) (uuid.UUID, bool) {
+		return file.UUID, true
+	})
+	report.Missing = lo.Reject(report.Missing, func(file File, _ int) bool {
+		return expired[file.UUID]
+	})
+
+	if conf.DryRun {
+		return report, nil
+	}
+
+	limiter := newGCLimiter(conf.Rate)
+	defer limiter.stop()
+
+	for _, obj := range report.Orphans {
+		if err = limiter.wait(ctx); err != nil {
+			return report, err
+		}
+
+		if err = s3.storage.Remove(ctx, obj.Name); err != nil {
+			return report, err
+		}
+
+		report.Deleted++
+	}
+
+	for _, file := range report.Expired {
+		if err = limiter.wait(ctx); err != nil {
+			return report, err
+		}
+
+		if err = s3.dropFile(ctx, file); err != nil {
+			return report, err
+		}
+
+		if err = s3.repo.DeleteFiles([]uuid.UUID{file.UUID}); err != nil {
+			return report, err
+		}
+
+		report.Deleted++
+	}
+
+	// the objects are gone, only the refs and the usage are released
+	for _, file := range report.Missing {
+		if err = s3.dropFile(ctx, file); err != nil {
+			return report, err
+		}
+	}
+
+	err = s3.repo.DeleteFiles(lo.Map(report.Missing, func(file File, _ int) uuid.UUID {
+		return file.UUID
+	}))
+	if err != nil {
+		return report, err
+	}
+
+	report.Deleted += len(report.Missing)
+
+	return report, nil
+}
+
+// reconcile finds the objects of the federations not known by the files and
+// the files older than before without the objects. The previews are known
+// objects too, the shared contents keep theirs while the blobs are counted,
+// even when the file the preview was made for is deleted.
+func reconcile(objects []ObjectInfo, files []File, blobs []string, before time.Time) (orphans []ObjectInfo, missing []File) {
+	known := map[string]bool{}
+	for _, file := range files {
+		known[file.ObjectName] = true
+		if file.PreviewObjectName != "" {
+			known[file.PreviewObjectName] = true
+		}
+	}
+
+	for _, objectName := range blobs {
+		known[objectName] = true
+		known[previewObjectName(objectName)] = true
+	}
+
+	stored := map[string]bool{}
+	for _, obj := range objects {
+		stored[obj.Name] = true
+
+		// the other objects of the bucket are not of the files
+		if _, ok := objectFederation(obj.Name); !ok {
+			continue
+		}
+
+		if !known[obj.Name] && obj.Modified.Before(before) {
+			orphans = append(orphans, obj)
+		}
+	}
+
+	for _, file := range files {
+		if !stored[file.ObjectName] && file.CreatedAt.Before(before) {
+			missing = append(missing, file)
+		}
+	}
+
+	return orphans, missing
+}
+
+// CollectGarbage removes the profile photos of the sizes which are no longer
+// made and the photos of the users without one.
+func (s3 *Service) CollectGarbage(ctx context.Context, conf GCConf) (report GCReport, err error) {
+	now := time.Now()
+
+	objects, err := s3.storage.ListObjects(ctx, photoPrefix)
+	if err != nil {
+		return report, err
+	}
+
+	uids, err := s3.repo.GetPhotoUsers()
+	if err != nil {
+		return report, err
+	}
+
+	users := lo.SliceToMap(uids, func(uid uuid.UUID) (uuid.UUID, bool) {
+		return uid, true
+	})
+
+	report.Photos = stalePhotos(objects, s3.cropWidth, users, now.Add(-conf.Grace))
+
+	if conf.DryRun {
+		return report, nil
+	}
+
+	limiter := newGCLimiter(conf.Rate)
+	defer limiter.stop()
+
+	for _, obj := range report.Photos {
+		if err = limiter.wait(ctx); err != nil {
+			return report, err
+		}
+
+		if err = s3.storage.Remove(ctx, obj.Name); err != nil {
+			return report, err
+		}
+
+		report.Deleted++
+	}
+
+	return report, nil
+}
+
+// stalePhotos finds the photos older than before which are not of the widths
+// or not of the users with the photo.
+func stalePhotos(objects []ObjectInfo, widths []int, users map[uuid.UUID]bool, before time.Time) (stale []ObjectInfo) {
+	for _, obj := range objects {
+		uid, width, ok := parsePhotoObjectName(obj.Name)
+		if !ok || !obj.Modified.Before(before) {
+			continue
+		}
+
+		if !users[uid] || !lo.Contains(widths, width) {
+			stale = append(stale, obj)
+		}
+	}
+
+	return stale
+}
+
+// parsePhotoObjectName is the reverse of GetPhotoObjectName.
+func parsePhotoObjectName(name string) (uid uuid.UUID, width int, ok bool) {
+	rest, found := strings.CutPrefix(name, photoPrefix)
+	if !found || len(rest) < 36 {
+		return uid, 0, false
+	}
+
+	uid, err := uuid.Parse(rest[:36])
+	if err != nil {
+		return uid, 0, false
+	}
+
+	size, found := strings.CutSuffix(rest[36:], ".jpg")
+	if !found {
+		return uid, 0, false
+	}
+
+	if size == "" {
+		return uid, OriginalPhotoSize, true
+	}
+
+	width, err = strconv.Atoi(strings.TrimPrefix(size, ".w"))
+	if err != nil || !strings.HasPrefix(size, ".w") || width <= 0 {
+		return uid, 0, false
+	}
+
+	return uid, width, true
+}
+
+// gcLimiter spaces the deletes out, zero rate is unlimited.
+type gcLimiter struct {
+	ticker *time.Ticker
+}
+
+func newGCLimiter(rate int) *gcLimiter {
+	if rate <= 0 {
+		return &gcLimiter{}
+	}
+
+	return &gcLimiter{ticker: time.NewTicker(time.Second / time.Duration(rate))}
+}
+
+func (l *gcLimiter) wait(ctx context.Context) error {
+	if l.ticker == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.ticker.C:
+		return nil
+	}
+}
+
+func (l *gcLimiter) stop() {
+	if l.ticker != nil {
+		l.ticker.Stop()
+	}
+}
@@ -0,0 +1,119 @@
+package s3
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-48 * time.Hour)
+	before := now.Add(-24 * time.Hour)
+
+	fed := "6f0a0c47-7f3f-4f4e-9a55-3f1c2a1b2c3d"
+
+	objects := []ObjectInfo{
+		{Name: fed + "/task/t/a.pdf", Modified: old},
+		{Name: fed + "/task/t/a.pdf" + previewSuffix, Modified: old},
+		{Name: fed + "/task/t/orphan.pdf", Modified: old},
+		{Name: fed + "/task/t/new.pdf", Modified: now},
+		{Name: "backup/dump.sql", Modified: old},
+		{Name: fed + "/task/s/shared.pdf", Modified: old},
+		{Name: fed + "/task/s/shared" + previewSuffix, Modified: old},
+	}
+
+	files := []File{
+		{UUID: uuid.New(), ObjectName: fed + "/task/t/a.pdf", PreviewObjectName: fed + "/task/t/a.pdf" + previewSuffix, CreatedAt: old},
+		{UUID: uuid.New(), ObjectName: fed + "/task/t/lost.pdf", CreatedAt: old},
+		{UUID: uuid.New(), ObjectName: fed + "/task/t/uploading.pdf", CreatedAt: now},
+		{UUID: uuid.New(), ObjectName: fed + "/task/s/shared.pdf", CreatedAt: old},
+	}
+
+	// the file the preview was made for is deleted, the file sharing its
+	// content has no preview name
+	blobs := []string{fed + "/task/s/shared.pdf"}
+
+	orphans, missing := reconcile(objects, files, blobs, before)
+
+	names := lo.Map(orphans, func(obj ObjectInfo, _ int) string {
+		return obj.Name
+	})
+	if len(names) != 1 || names[0] != fed+"/task/t/orphan.pdf" {
+		t.Errorf("reconcile() orphans = %v", names)
+	}
+
+	if len(missing) != 1 || missing[0].UUID != files[1].UUID {
+		t.Errorf("reconcile() missing = %v", missing)
+	}
+}
+
+func TestParsePhotoObjectName(t *testing.T) {
+	s3 := &Service{}
+	uid := uuid.New()
+
+	for _, size := range []int{OriginalPhotoSize, SmallPhotoSize, 100} {
+		got, width, ok := parsePhotoObjectName(s3.GetPhotoObjectName(uid, size))
+		if !ok || got != uid || width != size {
+			t.Errorf("parsePhotoObjectName(%d) = %v %d %v", size, got, width, ok)
+		}
+	}
+
+	for _, name := range []string{"photos-" + uid.String() + ".w0.jpg", "photos-" + uid.String() + ".png", "photos-abc.jpg", "avatar.jpg"} {
+		if _, _, ok := parsePhotoObjectName(name); ok {
+			t.Errorf("parsePhotoObjectName(%q) is ok", name)
+		}
+	}
+}
+
+func TestStalePhotos(t *testing.T) {
+	s3 := &Service{}
+	now := time.Now()
+	old := now.Add(-48 * time.Hour)
+
+	user, gone := uuid.New(), uuid.New()
+	widths := []int{OriginalPhotoSize, SmallPhotoSize, MediumPhotoSize}
+
+	objects := []ObjectInfo{
+		{Name: s3.GetPhotoObjectName(user, OriginalPhotoSize), Modified: old},
+		{Name: s3.GetPhotoObjectName(user, SmallPhotoSize), Modified: old},
+		{Name: s3.GetPhotoObjectName(user, 100), Modified: old},
+		{Name: s3.GetPhotoObjectName(gone, SmallPhotoSize), Modified: old},
+		{Name: s3.GetPhotoObjectName(gone, MediumPhotoSize), Modified: now},
+	}
+
+	stale := stalePhotos(objects, widths, map[uuid.UUID]bool{user: true}, now.Add(-time.Hour))
+
+	names := lo.Map(stale, func(obj ObjectInfo, _ int) string {
+		return obj.Name
+	})
+	if len(names) != 2 || names[0] != objects[2].Name || names[1] != objects[3].Name {
+		t.Errorf("stalePhotos() = %v", names)
+	}
+}
+
+func TestGCLimiter(t *testing.T) {
+	limiter := newGCLimiter(50)
+	defer limiter.stop()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("5 deletes at 50/s took %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := newGCLimiter(0).wait(ctx); err == nil {
+		t.Error("wait() of the canceled context returned no error")
+	}
+}
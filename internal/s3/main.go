@@ -8,20 +8,12 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/internal/helpers"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 )
 
 type Service struct {
-	endpoint        string
-	accessKeyID     string
-	secretAccessKey string
-	bucketName      string
-	location        string // "ru-central1"
-	useSSL          bool
-	publicURL       string
+	storage Storage
 
 	repo *Repository
 
@@ -45,6 +37,7 @@ const (
 )
 
 type Conf struct {
+	Driver          string
 	Endpoint        string
 	AccessKeyID     string
 	SecretAccessKey string
@@ -52,19 +45,40 @@ type Conf struct {
 	Location        string
 	UseSSL          bool
 	PublicURL       string
+
+	LocalPath  string
+	BackendURL string
+	Secret     string
 }
 
-func New(conf Conf, repo *Repository) *Service {
+func New(conf Conf, repo *Repository) (*Service, error) {
+	bucketName := conf.BucketName
+	if bucketName == "" && (conf.Driver == DriverLocal || conf.Driver == DriverMemory) {
+		bucketName = "public"
+	}
+
+	storage, err := NewStorage(StorageConf{
+		Driver:          conf.Driver,
+		Endpoint:        conf.Endpoint,
+		AccessKeyID:     conf.AccessKeyID,
+		SecretAccessKey: conf.SecretAccessKey,
+		BucketName:      bucketName,
+		Location:        conf.Location,
+		UseSSL:          conf.UseSSL,
+
+		LocalPath:  conf.LocalPath,
+		BackendURL: conf.BackendURL,
+		Secret:     conf.Secret,
+		Public:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	s3 := &Service{
-		endpoint:        conf.Endpoint,
-		accessKeyID:     conf.AccessKeyID,
-		secretAccessKey: conf.SecretAccessKey,
-		bucketName:      conf.BucketName,
-		location:        conf.Location,
-		useSSL:          conf.UseSSL,
-		publicURL:       conf.PublicURL,
-		repo:            repo,
-		cropWidth:       []int{OriginalPhotoSize, SmallPhotoSize, LargePhotoSize, MediumPhotoSize},
+		storage:   storage,
+		repo:      repo,
+		cropWidth: []int{OriginalPhotoSize, SmallPhotoSize, LargePhotoSize, MediumPhotoSize},
 
 		toResize:       make(chan ToUpload, 1000),
 		toUpload:       make(chan ToUpload, 1000),
@@ -89,44 +103,19 @@ func New(conf Conf, repo *Repository) *Service {
 		go s3.ToResize()
 	}
 
-	return s3
+	return s3, nil
 }
 
-func (s3 *Service) Upload(filePath, contentType, objectName string) error {
-	ctx := context.Background()
-
-	// Initialize minio client object.
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
-	if err != nil {
-		return err
-	}
-
-	err = minioClient.MakeBucket(ctx, s3.bucketName, minio.MakeBucketOptions{Region: s3.location})
-	if err != nil {
-		exists, errBucketExists := minioClient.BucketExists(ctx, s3.bucketName)
-		if errBucketExists != nil || !exists {
-			return err
-		}
-	} else {
-		logrus.Infof("S3: successfully created %s\n", s3.bucketName)
-	}
-
-	// Upload the test file with FPutObject
-	info, err := minioClient.FPutObject(ctx, s3.bucketName, objectName, filePath, minio.PutObjectOptions{ContentType: contentType})
-	if err != nil {
-		return err
-	}
-
-	logrus.Debugf("successfully uploaded %s of size %d\n", objectName, info.Size)
+func (s3 *Service) Storage() Storage {
+	return s3.storage
+}
 
-	return nil
+func (s3 *Service) Upload(filePath, contentType, objectName string) error {
+	return putFile(context.Background(), s3.storage, objectName, filePath, contentType)
 }
 
 func (s3 *Service) URL(objectName string) string {
-	return fmt.Sprintf("https://%s.%s/%s", s3.bucketName, s3.endpoint, objectName)
+	return s3.storage.URL(objectName)
 }
 
 func (s3 *Service) UploadPhoto(ctx context.Context, filePath string, userUUID uuid.UUID) (err error) {
@@ -184,18 +173,7 @@ func (s3 *Service) DeletePhoto(uid uuid.UUID) (err error) {
 				objectName := s3.GetPhotoObjectName(uid, size)
 				logrus.Debug("deleting photo: ", objectName)
 
-				// Initialize minio client object.
-				minioClient, err := minio.New(s3.endpoint, &minio.Options{
-					Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-					Secure: s3.useSSL,
-				})
-				if err != nil {
-					return err
-				}
-
-				err = minioClient.RemoveObject(ctx, s3.bucketName, objectName, minio.RemoveObjectOptions{
-					ForceDelete: true,
-				})
+				err := s3.storage.Remove(ctx, objectName)
 				if err != nil {
 					logrus.Error(err)
 					return err
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type File struct {
@@ -12,6 +13,11 @@ type File struct {
 	Type     string    `gorm:"type:int;not null"`
 	TypeUUID uuid.UUID `gorm:"type:uuid;not null"`
 
+	// ChainUUID is the uuid of the first version of the file.
+	ChainUUID uuid.UUID `gorm:"type:uuid;not null"`
+	Version   int       `gorm:"type:int;default:1;not null"`
+	IsCurrent bool      `gorm:"type:boolean;default:true;not null"`
+
 	Name       string `gorm:"type:varchar(50);default:'';not null"`
 	ObjectName string `gorm:"type:varchar(250);default:'';not null"`
 	Size       int64  `gorm:"type:bigint;default:0;not null"`
@@ -20,6 +26,21 @@ type File struct {
 	ImgWidth   int  `gorm:"type:int;default:0;not null"`
 	ImgHeight  int  `gorm:"type:int;default:0;not null"`
 
+	// PreviewObjectName is the thumbnail of the image or the first page of
+	// the pdf, it is stored next to the original by the preview workers.
+	PreviewObjectName string `gorm:"type:varchar(250);default:'';not null"`
+	DurationMs        int64  `gorm:"type:bigint;default:0;not null"`
+
+	// ScanStatus is pending until the scanner checks the object, the file
+	// is given out only when it is clean.
+	ScanStatus    string     `gorm:"type:varchar(20);default:'clean';not null"`
+	ScanSignature string     `gorm:"type:varchar(250);default:'';not null"`
+	ScannedAt     *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	// SHA256 is the hex hash of the content, the files of the federation
+	// with the same hash share the object. It is empty for the old files.
+	SHA256 string `gorm:"column:sha256;type:varchar(64);default:'';not null"`
+
 	Ext        string `gorm:"type:varchar(10);default:'';not null"`
 	MimeType   string `gorm:"type:varchar(20);default:'';not null"`
 	BucketName string `gorm:"type:varchar(200);default:'';not null"`
@@ -31,3 +52,67 @@ type File struct {
 	DeletedAt   *time.Time `gorm:"type:timestamptz;default:NULL;"`
 	ToDeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
 }
+
+// scanErr tells why the file can not be given out.
+func (f File) scanErr() error {
+	switch f.ScanStatus {
+	case domain.FileScanPending:
+		return domain.ErrFileScanPending
+	case domain.FileScanInfected:
+		return domain.ErrFileInfected
+	}
+
+	return nil
+}
+
+// FileText is the extracted text of the file, the search vector is generated
+// by the database.
+type FileText struct {
+	FileUUID  uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	Content   string    `gorm:"type:text;default:'';not null"`
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type FileTextHit struct {
+	File
+
+	TaskUUID uuid.UUID
+	TaskID   int
+	TaskName string
+	Snippet  string
+}
+
+// FileBlob is the content stored once in the federation, Refs counts the
+// files pointing to its object.
+type FileBlob struct {
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	SHA256         string    `gorm:"column:sha256;type:varchar(64);not null;primary_key:true"`
+	ObjectName     string    `gorm:"type:varchar(250);not null"`
+	Size           int64     `gorm:"type:bigint;default:0;not null"`
+	Refs           int       `gorm:"type:int;default:0;not null"`
+	CreatedAt      time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt      time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+// StorageUsage is the stored bytes of the federation, it is counted on upload
+// and delete and recomputed from the bucket by the cli.
+type StorageUsage struct {
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	Bytes          int64     `gorm:"type:bigint;default:0;not null"`
+	Files          int       `gorm:"type:int;default:0;not null"`
+	UpdatedAt      time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (u *StorageUsage) TableName() string {
+	return "storage_usage"
+}
+
+// StorageUsageRow is the part of the usage grouped by the project or the
+// mime type.
+type StorageUsageRow struct {
+	CompanyUUID uuid.UUID
+	ProjectUUID uuid.UUID
+	MimeType    string
+	Bytes       int64
+	Files       int
+}
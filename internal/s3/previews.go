@@ -0,0 +1,287 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	previewKindImage = "image"
+	previewKindPDF   = "pdf"
+	previewKindMedia = "media"
+
+	previewTimeout = 2 * time.Minute
+	previewSuffix  = ".preview.jpg"
+)
+
+// Preview is what is derived from the uploaded file: the thumbnail object and
+// the dimensions and duration of the media.
+type Preview struct {
+	ObjectName string
+	Width      int
+	Height     int
+	Duration   time.Duration
+}
+
+func (p Preview) IsZero() bool {
+	return p.ObjectName == "" && p.Width == 0 && p.Height == 0 && p.Duration == 0
+}
+
+// fileJob is the stored file handed to the file workers, Scan is set while
+// the file waits for the scanner.
+type fileJob struct {
+	UUID       uuid.UUID
+	ObjectName string
+	MimeType   string
+	Scan       bool
+}
+
+// previewer makes previews and texts of local files. Pdf pages are rendered
+// by pdftoppm and read by pdftotext, media is probed by ffprobe, the files
+// are skipped without them.
+type previewer struct {
+	width     int
+	pdftoppm  string
+	pdftotext string
+	ffprobe   string
+}
+
+func previewKind(mime string) string {
+	switch {
+	case mime == "image/jpeg", mime == "image/png", mime == "image/gif":
+		return previewKindImage
+	case mime == "application/pdf":
+		return previewKindPDF
+	case strings.HasPrefix(mime, "video/"), strings.HasPrefix(mime, "audio/"):
+		return previewKindMedia
+	}
+
+	return ""
+}
+
+// previewObjectName keeps the preview next to the original object.
+func previewObjectName(objectName string) string {
+	return strings.TrimSuffix(objectName, path.Ext(objectName)) + previewSuffix
+}
+
+// make returns the preview and the path of the thumbnail in dir, the path is
+// empty when there is no thumbnail.
+func (p previewer) make(ctx context.Context, src, dir, mime string) (Preview, string, error) {
+	switch previewKind(mime) {
+	case previewKindImage:
+		thumb := filepath.Join(dir, "preview.jpg")
+
+		width, height, err := helpers.ThumbnailImage(src, thumb, p.width)
+		if err != nil {
+			return Preview{}, "", err
+		}
+
+		return Preview{Width: width, Height: height}, thumb, nil
+
+	case previewKindPDF:
+		out, err := exec.CommandContext(ctx, p.pdftoppm,
+			"-f", "1", "-l", "1", "-singlefile", "-jpeg",
+			"-scale-to", strconv.Itoa(p.width),
+			src, filepath.Join(dir, "page"),
+		).CombinedOutput()
+		if err != nil {
+			return Preview{}, "", fmt.Errorf("pdftoppm: %w: %s", err, out)
+		}
+
+		return Preview{}, filepath.Join(dir, "page.jpg"), nil
+
+	case previewKindMedia:
+		out, err := exec.CommandContext(ctx, p.ffprobe,
+			"-v", "error",
+			"-show_entries", "format=duration:stream=width,height",
+			"-of", "json",
+			src,
+		).Output()
+		if err != nil {
+			return Preview{}, "", fmt.Errorf("ffprobe: %w", err)
+		}
+
+		preview, err := parseProbe(out)
+
+		return preview, "", err
+	}
+
+	return Preview{}, "", nil
+}
+
+type probe struct {
+	Streams []struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func parseProbe(data []byte) (preview Preview, err error) {
+	pr := probe{}
+
+	err = json.Unmarshal(data, &pr)
+	if err != nil {
+		return preview, err
+	}
+
+	for _, stream := range pr.Streams {
+		if stream.Width > 0 && stream.Height > 0 {
+			preview.Width, preview.Height = stream.Width, stream.Height
+			break
+		}
+	}
+
+	if pr.Format.Duration != "" {
+		seconds, err := strconv.ParseFloat(pr.Format.Duration, 64)
+		if err != nil {
+			return preview, err
+		}
+
+		preview.Duration = time.Duration(seconds * float64(time.Second))
+	}
+
+	return preview, nil
+}
+
+// enqueueFile hands the stored file to the file workers, they scan it, make
+// the preview and extract the text. The queue is bounded, the file stays
+// without them when it is full, pending files are handed again later.
+func (s3 *ServicePrivate) enqueueFile(file File) {
+	if s3.jobs == nil || file.ScanStatus == domain.FileScanInfected {
+		return
+	}
+
+	job := fileJob{
+		UUID:       file.UUID,
+		ObjectName: file.ObjectName,
+		MimeType:   file.MimeType,
+		Scan:       file.ScanStatus == domain.FileScanPending,
+	}
+
+	if !job.Scan && previewKind(file.MimeType) == "" && textKind(file.MimeType, file.ObjectName) == "" {
+		return
+	}
+
+	select {
+	case s3.jobs <- job:
+	default:
+		logrus.WithField("file_uuid", file.UUID).Warn("file queue is full")
+	}
+}
+
+func (s3 *ServicePrivate) processFiles() {
+	for job := range s3.jobs {
+		err := s3.processFile(job)
+		if err != nil {
+			logrus.WithError(err).WithField("file_uuid", job.UUID).Error("file processing error")
+		}
+	}
+}
+
+func (s3 *ServicePrivate) processFile(job fileJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("file panic: %v: %s", r, debug.Stack())
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+
+	dir, err := os.MkdirTemp("", "preview")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "src"+path.Ext(job.ObjectName))
+
+	err = s3.download(ctx, job.ObjectName, src)
+	if err != nil {
+		return err
+	}
+
+	if job.Scan {
+		clean, err := s3.processScan(ctx, job, src)
+		if err != nil || !clean {
+			return err
+		}
+	}
+
+	if textKind(job.MimeType, job.ObjectName) != "" {
+		err = s3.processText(ctx, job, src)
+		if err != nil {
+			logrus.WithError(err).WithField("file_uuid", job.UUID).Error("file text error")
+		}
+	}
+
+	if previewKind(job.MimeType) == "" {
+		return nil
+	}
+
+	return s3.processPreview(ctx, job, src, dir)
+}
+
+func (s3 *ServicePrivate) processPreview(ctx context.Context, job fileJob, src, dir string) error {
+	preview, thumb, err := s3.previewer.make(ctx, src, dir, job.MimeType)
+	if errors.Is(err, exec.ErrNotFound) {
+		logrus.WithField("file_uuid", job.UUID).Debug("preview tool is not installed: ", err)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if thumb != "" {
+		preview.ObjectName = previewObjectName(job.ObjectName)
+
+		err = putFile(ctx, s3.storage, preview.ObjectName, thumb, "image/jpeg")
+		if err != nil {
+			return err
+		}
+	}
+
+	if preview.IsZero() {
+		return nil
+	}
+
+	return s3.repo.SetPreview(job.UUID, preview)
+}
+
+func (s3 *ServicePrivate) download(ctx context.Context, objectName, to string) error {
+	obj, err := s3.storage.Get(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	f, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, obj)
+
+	return err
+}
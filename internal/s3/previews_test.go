@@ -0,0 +1,113 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPreviewKind(t *testing.T) {
+	tests := map[string]string{
+		"image/jpeg":       previewKindImage,
+		"image/png":        previewKindImage,
+		"application/pdf":  previewKindPDF,
+		"video/mp4":        previewKindMedia,
+		"audio/mpeg":       previewKindMedia,
+		"application/zip":  "",
+		"image/svg+xml":    "",
+		"text/plain; utf8": "",
+	}
+
+	for mime, want := range tests {
+		if got := previewKind(mime); got != want {
+			t.Errorf("previewKind(%q) = %q, want %q", mime, got, want)
+		}
+	}
+
+	if got := previewObjectName("f/task/t/a.pdf"); got != "f/task/t/a.preview.jpg" {
+		t.Errorf("previewObjectName() = %q", got)
+	}
+}
+
+func TestPreviewerImage(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.png")
+
+	img := image.NewNRGBA(image.Rect(0, 0, 800, 600))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+
+	f, err := os.Create(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	preview, thumb, err := previewer{width: 400}.make(context.Background(), src, dir, "image/png")
+	if err != nil {
+		t.Fatalf("make() error = %v", err)
+	}
+
+	if preview.Width != 800 || preview.Height != 600 {
+		t.Errorf("make() size = %dx%d", preview.Width, preview.Height)
+	}
+
+	f, err = os.Open(thumb)
+	if err != nil {
+		t.Fatalf("thumbnail is not saved: %v", err)
+	}
+	defer f.Close()
+
+	cfg, err := jpeg.DecodeConfig(f)
+	if err != nil {
+		t.Fatalf("thumbnail is not jpeg: %v", err)
+	}
+
+	if cfg.Width != 400 || cfg.Height != 300 {
+		t.Errorf("thumbnail size = %dx%d", cfg.Width, cfg.Height)
+	}
+}
+
+func TestPreviewerMissingTool(t *testing.T) {
+	p := previewer{width: 400, pdftoppm: "pdftoppm-missing", ffprobe: "ffprobe-missing"}
+
+	_, _, err := p.make(context.Background(), "a.pdf", t.TempDir(), "application/pdf")
+	if !errors.Is(err, exec.ErrNotFound) {
+		t.Errorf("make() pdf error = %v", err)
+	}
+
+	_, _, err = p.make(context.Background(), "a.mp4", t.TempDir(), "video/mp4")
+	if !errors.Is(err, exec.ErrNotFound) {
+		t.Errorf("make() media error = %v", err)
+	}
+}
+
+func TestParseProbe(t *testing.T) {
+	preview, err := parseProbe([]byte(`{"streams":[{},{"width":1920,"height":1080}],"format":{"duration":"12.500000"}}`))
+	if err != nil {
+		t.Fatalf("parseProbe() error = %v", err)
+	}
+
+	if preview.Width != 1920 || preview.Height != 1080 || preview.Duration != 12500*time.Millisecond {
+		t.Errorf("parseProbe() = %+v", preview)
+	}
+
+	preview, err = parseProbe([]byte(`{"streams":[{}],"format":{"duration":"3.2"}}`))
+	if err != nil || preview.Width != 0 || preview.Duration != 3200*time.Millisecond {
+		t.Errorf("parseProbe() audio = %+v %v", preview, err)
+	}
+
+	if _, err = parseProbe([]byte(`{"format":{"duration":"n/a"}}`)); err == nil {
+		t.Error("parseProbe() accepted invalid duration")
+	}
+}
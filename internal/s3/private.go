@@ -2,36 +2,37 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/url"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/helpers"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
 type ServicePrivate struct {
-	endpoint        string
-	accessKeyID     string
-	secretAccessKey string
-	bucketName      string
-	location        string // "ru-central1"
-	useSSL          bool
-	publicURL       string
-	backendURL      string
+	storage    Storage
+	backendURL string
 
 	repo  *Repository
 	cache *cache.Service
+
+	jobs      chan fileJob
+	previewer previewer
+	scanner   Scanner
+	quota     int64
+
+	onFileInfected func(File) error
 }
 
 type ConfPrivate struct {
+	Driver          string
 	Endpoint        string
 	BackendURL      string
 	AccessKeyID     string
@@ -40,24 +41,95 @@ type ConfPrivate struct {
 	Location        string
 	UseSSL          bool
 	PublicURL       string
+
+	LocalPath string
+	Secret    string
+
+	// Quota is the bytes a federation may store, zero is unlimited
+	Quota int64
+
+	// Uploaded files are scanned, previewed and indexed by FileWorkers, zero
+	// disables them
+	FileWorkers int
+	FileQueue   int
+
+	PreviewWidth     int
+	PreviewPdftoppm  string
+	PreviewPdftotext string
+	PreviewFfprobe   string
+
+	// ScanDriver is empty when the files are not scanned
+	ScanDriver    string
+	ScanClamdAddr string
+	ScanTimeout   time.Duration
 }
 
-func NewPrivate(conf ConfPrivate, repo *Repository, cs *cache.Service) *ServicePrivate {
+func NewPrivate(conf ConfPrivate, repo *Repository, cs *cache.Service) (*ServicePrivate, error) {
+	bucketName := conf.BucketName
+	if bucketName == "" && (conf.Driver == DriverLocal || conf.Driver == DriverMemory) {
+		bucketName = "private"
+	}
+
+	storage, err := NewStorage(StorageConf{
+		Driver:          conf.Driver,
+		Endpoint:        conf.Endpoint,
+		AccessKeyID:     conf.AccessKeyID,
+		SecretAccessKey: conf.SecretAccessKey,
+		BucketName:      bucketName,
+		Location:        conf.Location,
+		UseSSL:          conf.UseSSL,
+
+		LocalPath:  conf.LocalPath,
+		BackendURL: conf.BackendURL,
+		Secret:     conf.Secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scanner, err := NewScanner(ScannerConf{
+		Driver:  conf.ScanDriver,
+		Addr:    conf.ScanClamdAddr,
+		Timeout: conf.ScanTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if scanner != nil && conf.FileWorkers == 0 {
+		return nil, errors.New("проверка файлов антивирусом требует обработчиков файлов")
+	}
+
 	s3 := &ServicePrivate{
 		repo:  repo,
 		cache: cs,
 
-		endpoint:        conf.Endpoint,
-		accessKeyID:     conf.AccessKeyID,
-		secretAccessKey: conf.SecretAccessKey,
-		bucketName:      conf.BucketName,
-		location:        conf.Location,
-		useSSL:          conf.UseSSL,
-		publicURL:       conf.PublicURL,
-		backendURL:      conf.BackendURL,
+		storage:    storage,
+		backendURL: conf.BackendURL,
+
+		previewer: previewer{
+			width:     conf.PreviewWidth,
+			pdftoppm:  conf.PreviewPdftoppm,
+			pdftotext: conf.PreviewPdftotext,
+			ffprobe:   conf.PreviewFfprobe,
+		},
+		scanner: scanner,
+		quota:   conf.Quota,
+	}
+
+	if conf.FileWorkers > 0 {
+		s3.jobs = make(chan fileJob, conf.FileQueue)
+
+		for i := 0; i < conf.FileWorkers; i++ {
+			go s3.processFiles()
+		}
 	}
 
-	return s3
+	return s3, nil
+}
+
+func (s3 *ServicePrivate) Storage() Storage {
+	return s3.storage
 }
 
 func (s3 *ServicePrivate) UploadTaskFile(federatonUUID, taskUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (file File, err error) {
@@ -84,8 +156,8 @@ func (s3 *ServicePrivate) UploadTaskFile(federatonUUID, taskUUID uuid.UUID, file
 		ImgHeight: fileDTO.Height,
 
 		MimeType:   fileDTO.ContentType,
-		BucketName: s3.bucketName,
-		Endpoint:   s3.endpoint,
+		BucketName: s3.storage.Bucket(),
+		Endpoint:   s3.storage.Endpoint(),
 		CreatedBy:  userUUID,
 	}
 
@@ -116,98 +188,255 @@ func (s3 *ServicePrivate) UploadTaskCommentFile(federatonUUID, taskUUID, comment
 		ImgHeight: fileDTO.Height,
 
 		MimeType:   fileDTO.ContentType,
-		BucketName: s3.bucketName,
-		Endpoint:   s3.endpoint,
+		BucketName: s3.storage.Bucket(),
+		Endpoint:   s3.storage.Endpoint(),
 		CreatedBy:  userUUID,
 	}
 
 	return s3.uploadFile(file, filePath)
 }
 
-func (s3 *ServicePrivate) uploadFile(file File, filePath string) (File, error) {
-	err := s3.repo.Create(file)
+// CopyFileToTask registers the file as a task file, the content is shared
+// within the federation and the object is copied into the task folder only
+// for the old files without the hash.
+func (s3 *ServicePrivate) CopyFileToTask(federatonUUID, taskUUID, fileUUID, userUUID uuid.UUID) (file File, err error) {
+	src, err := s3.repo.GetFile(fileUUID)
 	if err != nil {
 		return file, err
 	}
 
-	ctx := context.Background()
+	file = src
+	file.UUID = uuid.New()
+	file.ChainUUID = uuid.Nil
+	file.Version = 1
+	file.IsCurrent = true
+	file.Type = "task"
+	file.TypeUUID = taskUUID
+	file.ObjectName = fmt.Sprintf("%s/task/%s/%s%s", federatonUUID, taskUUID, uuid.New().String(), src.Ext)
+	file.BucketName = s3.storage.Bucket()
+	file.Endpoint = s3.storage.Endpoint()
+	file.CreatedBy = userUUID
+	file.CreatedAt = time.Now()
+	file.DeletedAt = nil
+	file.ToDeletedAt = nil
+	file.PreviewObjectName = ""
+
+	if fed, _ := objectFederation(src.ObjectName); fed == federatonUUID {
+		shared, ok, err := s3.shareFile(file, src)
+		if err != nil || ok {
+			return shared, err
+		}
+	}
 
-	// Initialize minio client object.
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+	file.SHA256 = ""
+
+	err = s3.reserve(file)
 	if err != nil {
 		return file, err
 	}
 
-	err = minioClient.MakeBucket(ctx, file.BucketName, minio.MakeBucketOptions{Region: s3.location})
+	err = s3.storage.Copy(context.Background(), src.ObjectName, file.ObjectName)
 	if err != nil {
-		exists, errBucketExists := minioClient.BucketExists(ctx, s3.bucketName)
-		if errBucketExists != nil || !exists {
-			return file, err
-		}
-	} else {
-		logrus.Infof("S3: successfully created %s\n", file.BucketName)
+		s3.release(file)
+		return file, err
 	}
 
-	info, err := minioClient.FPutObject(ctx, file.BucketName, file.ObjectName, filePath, minio.PutObjectOptions{ContentType: file.MimeType})
+	err = s3.repo.Create(file)
 	if err != nil {
+		s3.discardObject(context.Background(), file.ObjectName)
+		s3.release(file)
+
 		return file, err
 	}
 
-	logrus.Debugf("successfully uploaded %s of size %d\n", file.ObjectName, info.Size)
+	s3.enqueueFile(file)
 
 	return file, err
 }
 
-func (s3 *ServicePrivate) DeleteFile(file File) error {
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+// UploadTaskFileVersion stores the upload as the new version of the task
+// file, prior versions are kept in the storage.
+func (s3 *ServicePrivate) UploadTaskFileVersion(federatonUUID, taskUUID, fileUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (file File, err error) {
+	base, err := s3.repo.GetTaskFile(taskUUID, fileUUID)
+	if err != nil {
+		return file, err
+	}
+
+	if base.Type != "task" {
+		return file, errors.New("версии поддерживаются только для файлов задачи")
+	}
+
+	ext := helpers.FileExt(filePath)
+	objectName := fmt.Sprintf("%s/task/%s/%s%s", federatonUUID, taskUUID, uuid.New().String(), ext)
+
+	fileDTO, err := NewFileDTO(fileName, filePath, objectName, userUUID)
 	if err != nil {
-		return fmt.Errorf("S3: %w", err)
+		return file, err
+	}
+
+	file = File{
+		UUID:      uuid.New(),
+		ChainUUID: base.ChainUUID,
+
+		Type:     base.Type,
+		TypeUUID: base.TypeUUID,
+
+		Name:       base.Name,
+		ObjectName: objectName,
+		Size:       fileDTO.Size,
+		Ext:        fileDTO.Ext,
+
+		ImgWidth:  fileDTO.Width,
+		ImgHeight: fileDTO.Height,
+
+		MimeType:   fileDTO.ContentType,
+		BucketName: s3.storage.Bucket(),
+		Endpoint:   s3.storage.Endpoint(),
+		CreatedBy:  userUUID,
+
+		ScanStatus: s3.scanStatus(),
 	}
 
 	ctx := context.Background()
 
-	err = minioClient.RemoveObject(ctx, file.BucketName, file.ObjectName, minio.RemoveObjectOptions{
-		ForceDelete: true,
-	})
+	err = s3.storeFile(ctx, &file, filePath)
 	if err != nil {
-		return fmt.Errorf("S3: %w", err)
+		return file, err
 	}
 
-	return err
+	file, err = s3.repo.AddVersion(file)
+	if err != nil {
+		if errDrop := s3.dropFile(ctx, file); errDrop != nil {
+			logrus.WithError(errDrop).WithField("object", file.ObjectName).Error("drop version object")
+		}
+
+		return file, err
+	}
+
+	s3.enqueueFile(file)
+
+	return file, nil
 }
 
-func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
-	file, err := s3.repo.GetFile(fileUUID)
+// GetTaskFileVersions returns all versions of the task file, the last first.
+func (s3 *ServicePrivate) GetTaskFileVersions(taskUUID, fileUUID uuid.UUID) (dmns []domain.File, err error) {
+	_, err = s3.repo.GetTaskFile(taskUUID, fileUUID)
 	if err != nil {
-		return err
+		return dmns, err
 	}
 
-	err = s3.repo.MarkForDelete(fileUUID)
+	files, err := s3.repo.GetVersions(fileUUID)
 	if err != nil {
-		return err
+		return dmns, err
 	}
 
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+	return lo.Map(files, func(item File, _ int) domain.File {
+		return domain.File{
+			UUID:      item.UUID,
+			Name:      item.Name,
+			Ext:       item.Ext,
+			Size:      item.Size,
+			URL:       fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, taskUUID, item.UUID),
+			CreatedAt: item.CreatedAt,
+			CreatedBy: item.CreatedBy,
+			Version:   item.Version,
+			Current:   item.IsCurrent,
+		}
+	}), nil
+}
+
+// RestoreTaskFileVersion makes the older version current again.
+func (s3 *ServicePrivate) RestoreTaskFileVersion(taskUUID, fileUUID uuid.UUID) (file File, err error) {
+	file, err = s3.repo.GetTaskFile(taskUUID, fileUUID)
+	if err != nil {
+		return file, err
+	}
+
+	err = s3.repo.SetCurrent(file.UUID)
 	if err != nil {
-		return fmt.Errorf("S3: %w", err)
+		return file, err
 	}
 
+	file.IsCurrent = true
+
+	return file, nil
+}
+
+func (s3 *ServicePrivate) uploadFile(file File, filePath string) (File, error) {
+	file.ScanStatus = s3.scanStatus()
+
 	ctx := context.Background()
 
-	err = minioClient.RemoveObject(ctx, file.BucketName, file.ObjectName, minio.RemoveObjectOptions{
-		ForceDelete: true,
-	})
+	err := s3.storeFile(ctx, &file, filePath)
+	if err != nil {
+		return file, err
+	}
+
+	err = s3.repo.Create(file)
+	if err != nil {
+		if errDrop := s3.dropFile(ctx, file); errDrop != nil {
+			logrus.WithError(errDrop).WithField("object", file.ObjectName).Error("drop file object")
+		}
+
+		return file, err
+	}
+
+	s3.enqueueFile(file)
+
+	return file, err
+}
+
+// scanStatus is the status of the new file, it waits for the scanner when
+// there is one.
+func (s3 *ServicePrivate) scanStatus() string {
+	if s3.scanner == nil {
+		return domain.FileScanClean
+	}
+
+	return domain.FileScanPending
+}
+
+// DeleteFile removes the objects of the file, the shared content is removed
+// with its last file.
+func (s3 *ServicePrivate) DeleteFile(file File) error {
+	return s3.dropFile(context.Background(), file)
+}
+
+// removeObjects removes the object of the file with its preview.
+func (s3 *ServicePrivate) removeObjects(ctx context.Context, file File) error {
+	if file.PreviewObjectName != "" {
+		err := s3.storage.Remove(ctx, file.PreviewObjectName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s3.storage.Remove(ctx, file.ObjectName)
+}
+
+// Delete removes all versions of the file.
+func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
+	versions, err := s3.repo.GetVersions(fileUUID)
 	if err != nil {
-		return fmt.Errorf("S3: %w", err)
+		return err
+	}
+
+	if len(versions) == 0 {
+		return dto.NotFoundErr("файл не найден")
+	}
+
+	err = s3.repo.MarkForDelete(fileUUID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	for _, version := range versions {
+		err = s3.dropFile(ctx, version)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = s3.repo.Delete(fileUUID)
@@ -220,6 +449,7 @@ func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
 	return err
 }
 
+// Rename renames all versions of the file.
 func (s3 *ServicePrivate) Rename(fileUUID uuid.UUID, name string) error {
 	err := s3.repo.Rename(fileUUID, name)
 	if err != nil {
@@ -230,33 +460,42 @@ func (s3 *ServicePrivate) Rename(fileUUID uuid.UUID, name string) error {
 }
 
 func (s3 *ServicePrivate) PresignedURL(name, objectName string) (res string, err error) {
-	// Initialize minio client object.
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+	return s3.storage.PresignedURL(context.Background(), objectName, name, time.Second*24*60*60)
+}
+
+func (s3 *ServicePrivate) PresignedURLFromFile(fileUUID uuid.UUID) (res string, err error) {
+	file, err := s3.repo.GetFile(fileUUID)
 	if err != nil {
 		return res, err
 	}
 
-	ctx := context.Background()
-
-	reqParams := make(url.Values)
-
-	reqParams.Set("response-content-disposition", fmt.Sprintf("filename=\"%q\"", name))
+	return s3.presignedFileURL(file)
+}
 
-	presignedURL, err := minioClient.PresignedGetObject(ctx, s3.bucketName, objectName, time.Second*24*60*60, reqParams)
+func (s3 *ServicePrivate) PresignedURLFromTaskFile(taskUUID, fileUUID uuid.UUID) (res string, err error) {
+	file, err := s3.repo.GetTaskFile(taskUUID, fileUUID)
 	if err != nil {
 		return res, err
 	}
 
-	return presignedURL.String(), err
+	return s3.presignedFileURL(file)
 }
 
-func (s3 *ServicePrivate) PresignedURLFromFile(fileUUID uuid.UUID) (res string, err error) {
-	file, err := s3.repo.GetFile(fileUUID)
+// FileURL is the temporary link of the just stored file, it is empty until
+// the file is scanned.
+func (s3 *ServicePrivate) FileURL(file File) (string, error) {
+	if file.scanErr() != nil {
+		return "", nil
+	}
+
+	return s3.PresignedURL(file.Name, file.ObjectName)
+}
+
+// presignedFileURL gives out only the clean files.
+func (s3 *ServicePrivate) presignedFileURL(file File) (string, error) {
+	err := file.scanErr()
 	if err != nil {
-		return res, err
+		return "", err
 	}
 
 	return s3.PresignedURL(file.Name, file.ObjectName)
@@ -271,7 +510,7 @@ func (s3 *ServicePrivate) GetTaskFiles(taskUUID uuid.UUID, openImages bool) (dmn
 	return lo.Map(files, func(item File, index int) domain.File {
 		fileURL := fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, item.TypeUUID, item.UUID)
 
-		if openImages && helpers.FileMimeToPreview(item.MimeType) {
+		if openImages && helpers.FileMimeToPreview(item.MimeType) && item.scanErr() == nil {
 			urlFromRedis, err := s3.cache.GetURL(context.Background(), item.UUID)
 			if err == nil && urlFromRedis != "" {
 				fileURL = urlFromRedis
@@ -288,13 +527,21 @@ func (s3 *ServicePrivate) GetTaskFiles(taskUUID uuid.UUID, openImages bool) (dmn
 		}
 
 		return domain.File{
-			UUID:      item.UUID,
-			Name:      item.Name,
-			Ext:       item.Ext,
-			Size:      item.Size,
-			URL:       fileURL,
-			CreatedAt: item.CreatedAt,
-			CreatedBy: item.CreatedBy,
+			UUID:       item.UUID,
+			Name:       item.Name,
+			Ext:        item.Ext,
+			Size:       item.Size,
+			URL:        fileURL,
+			PreviewURL: s3.previewURL(item, openImages),
+			Width:      item.ImgWidth,
+			Height:     item.ImgHeight,
+			DurationMs: item.DurationMs,
+			ScanStatus: item.ScanStatus,
+			SHA256:     item.SHA256,
+			CreatedAt:  item.CreatedAt,
+			CreatedBy:  item.CreatedBy,
+			Version:    item.Version,
+			Current:    item.IsCurrent,
 		}
 	}), err
 }
@@ -308,7 +555,7 @@ func (s3 *ServicePrivate) GetCommentFiles(uid uuid.UUID, openImages bool) (dmns
 	return lo.Map(files, func(item File, index int) domain.File {
 		fileURL := fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, item.TypeUUID, item.UUID)
 
-		if openImages && helpers.FileMimeToPreview(item.MimeType) {
+		if openImages && helpers.FileMimeToPreview(item.MimeType) && item.scanErr() == nil {
 			presignedURL, err := s3.PresignedURL(item.Name, item.ObjectName)
 			if err != nil {
 				logrus.Warn(err)
@@ -318,38 +565,89 @@ func (s3 *ServicePrivate) GetCommentFiles(uid uuid.UUID, openImages bool) (dmns
 		}
 
 		return domain.File{
-			UUID: item.UUID,
-			Name: item.Name,
-			Ext:  item.Ext,
-			Size: item.Size,
-			URL:  fileURL,
+			UUID:       item.UUID,
+			Name:       item.Name,
+			Ext:        item.Ext,
+			Size:       item.Size,
+			URL:        fileURL,
+			PreviewURL: s3.previewURL(item, openImages),
+			Width:      item.ImgWidth,
+			Height:     item.ImgHeight,
+			DurationMs: item.DurationMs,
+			ScanStatus: item.ScanStatus,
+			SHA256:     item.SHA256,
 		}
 	}), err
 }
 
-// @todo: in poc.
-func (s3 *ServicePrivate) DangerousWipeS3FederationData(existFederations []domain.Federation) (uids []string, err error) {
-	minioClient, err := minio.New(s3.endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
-		Secure: s3.useSSL,
-	})
+// previewURL is the temporary link of the file preview, it is empty until
+// the preview is made.
+func (s3 *ServicePrivate) previewURL(item File, open bool) string {
+	if !open || item.PreviewObjectName == "" {
+		return ""
+	}
+
+	previewURL, err := s3.PresignedURL(helpers.ParsePathFileName(item.Name)+".jpg", item.PreviewObjectName)
 	if err != nil {
-		return []string{}, fmt.Errorf("S3: %w", err)
+		logrus.Warn(err)
+		return ""
+	}
+
+	return previewURL
+}
+
+// SearchFiles finds the files of the federation tasks the user can see by
+// their extracted text, total is of all the pages.
+func (s3 *ServicePrivate) SearchFiles(federationUUID uuid.UUID, query, email string, limit, offset int) (hits []domain.FileSearchHit, total int64, err error) {
+	query = strings.TrimSpace(query)
+	if len([]rune(query)) < 2 {
+		return hits, 0, errors.New("поисковый запрос слишком короткий")
 	}
 
+	items, total, err := s3.repo.SearchTexts(federationUUID, query, email, limit, offset)
+	if err != nil {
+		return hits, total, err
+	}
+
+	return lo.Map(items, func(item FileTextHit, _ int) domain.FileSearchHit {
+		return domain.FileSearchHit{
+			File: domain.File{
+				UUID:      item.UUID,
+				Name:      item.Name,
+				Ext:       item.Ext,
+				Size:      item.Size,
+				URL:       fmt.Sprintf("%s/task/%s/upload/%s", s3.backendURL, item.TaskUUID, item.UUID),
+				CreatedAt: item.CreatedAt,
+				CreatedBy: item.CreatedBy,
+				Version:   item.Version,
+				Current:   item.IsCurrent,
+			},
+			TaskUUID: item.TaskUUID,
+			TaskID:   item.TaskID,
+			TaskName: item.TaskName,
+			Snippet:  item.Snippet,
+		}
+	}), total, nil
+}
+
+// @todo: in poc.
+func (s3 *ServicePrivate) DangerousWipeS3FederationData(existFederations []domain.Federation) (uids []string, err error) {
 	ctx := context.Background()
 
+	names, err := s3.storage.List(ctx, "")
+	if err != nil {
+		return []string{}, err
+	}
+
 	deletedTotal := 0
-	for i := range minioClient.ListObjects(ctx, s3.bucketName, minio.ListObjectsOptions{
-		Recursive: true,
-	}) {
+	for _, name := range names {
 		if deletedTotal > 1000 {
 			return []string{}, nil
 		}
 
 		hasPrefix := false
 		for _, federation := range existFederations {
-			if strings.HasPrefix(i.Key, federation.UUID.String()+"/") {
+			if strings.HasPrefix(name, federation.UUID.String()+"/") {
 				hasPrefix = true
 				break
 			}
@@ -357,14 +655,12 @@ func (s3 *ServicePrivate) DangerousWipeS3FederationData(existFederations []domai
 
 		if !hasPrefix {
 			deletedTotal++
-			logrus.WithField("key", i.Key).Info("deleting federation s3 data")
+			logrus.WithField("key", name).Info("deleting federation s3 data")
 
-			err = minioClient.RemoveObject(ctx, s3.bucketName, i.Key, minio.RemoveObjectOptions{
-				ForceDelete: true,
-			})
+			err = s3.storage.Remove(ctx, name)
 			if err != nil {
 				logrus.Error(err)
-				return []string{}, fmt.Errorf("S3: %w", err)
+				return []string{}, err
 			}
 		}
 
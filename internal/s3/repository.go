@@ -1,9 +1,14 @@
 package s3
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -17,6 +22,10 @@ func NewRepository(db *postgres.GDB) *Repository {
 }
 
 func (r *Repository) Create(orm File) error {
+	if orm.ChainUUID == uuid.Nil {
+		orm.ChainUUID = orm.UUID
+	}
+
 	err := r.gorm.DB.Create(&orm).Error
 	if err != nil {
 		return err
@@ -39,11 +48,47 @@ func (r *Repository) GetFile(fileUUID uuid.UUID) (file File, err error) {
 	return file, err
 }
 
+func (r *Repository) GetFiles(fileUUIDs []uuid.UUID) (files []File, err error) {
+	if len(fileUUIDs) == 0 {
+		return files, nil
+	}
+
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("uuid IN ?", fileUUIDs).
+		Where("deleted_at IS NULL").
+		Find(&files)
+
+	return files, res.Error
+}
+
+// GetTaskFile returns the file if it is attached to the task or to one of its
+// comments.
+func (r *Repository) GetTaskFile(taskUUID, fileUUID uuid.UUID) (file File, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("uuid = ?", fileUUID).
+		Where("(type = 'task' AND type_uuid = ?) OR (type = 'comment' AND type_uuid IN (SELECT uuid FROM comments WHERE task_uuid = ?))", taskUUID, taskUUID).
+		Where("deleted_at IS NULL").
+		Find(&file)
+
+	if res.Error != nil {
+		return file, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return file, dto.NotFoundErr("файл не найден")
+	}
+
+	return file, nil
+}
+
 func (r *Repository) GetTaskFiles(taskUUID uuid.UUID) (files []File, err error) {
 	res := r.gorm.DB.
 		Model(&File{}).
 		Where("type = ?", "task").
 		Where("type_uuid = ?", taskUUID).
+		Where("is_current").
 		Where("deleted_at IS NULL").
 		Find(&files)
 
@@ -55,6 +100,7 @@ func (r *Repository) GetCommentFiles(commnetUUID uuid.UUID) (files []File, err e
 		Model(&File{}).
 		Where("type = ?", "comment").
 		Where("type_uuid = ?", commnetUUID).
+		Where("is_current").
 		Where("deleted_at IS NULL").
 		Find(&files)
 
@@ -66,6 +112,7 @@ func (r *Repository) GetCommentsFiles(commnetsUUID []uuid.UUID) (files []File, e
 		Model(&File{}).
 		Where("type = ?", "comment").
 		Where("type_uuid = IN", commnetsUUID).
+		Where("is_current").
 		Where("deleted_at IS NULL").
 		Find(&files)
 
@@ -75,7 +122,7 @@ func (r *Repository) GetCommentsFiles(commnetsUUID []uuid.UUID) (files []File, e
 func (r *Repository) MarkForDelete(fileUUID uuid.UUID) error {
 	res := r.gorm.DB.
 		Model(&File{}).
-		Where("uuid = ?", fileUUID).
+		Where("chain_uuid = (?)", r.chainOf(fileUUID)).
 		UpdateColumn("to_deleted_at", "now()")
 
 	if res.RowsAffected == 0 {
@@ -88,7 +135,7 @@ func (r *Repository) MarkForDelete(fileUUID uuid.UUID) error {
 func (r *Repository) Delete(fileUUID uuid.UUID) error {
 	res := r.gorm.DB.
 		Model(&File{}).
-		Where("uuid = ?", fileUUID).
+		Where("chain_uuid = (?)", r.chainOf(fileUUID)).
 		Where("deleted_at IS NULL").
 		UpdateColumn("deleted_at", "now()")
 
@@ -102,7 +149,7 @@ func (r *Repository) Delete(fileUUID uuid.UUID) error {
 func (r *Repository) Rename(fileUUID uuid.UUID, name string) error {
 	res := r.gorm.DB.
 		Model(&File{}).
-		Where("uuid = ?", fileUUID).
+		Where("chain_uuid = (?)", r.chainOf(fileUUID)).
 		Where("deleted_at IS NULL").
 		UpdateColumn("name", name)
 
@@ -112,3 +159,480 @@ func (r *Repository) Rename(fileUUID uuid.UUID, name string) error {
 
 	return res.Error
 }
+
+// chainOf is the subquery of the versions chain of the file.
+func (r *Repository) chainOf(fileUUID uuid.UUID) *gorm.DB {
+	return r.gorm.DB.Model(&File{}).Select("chain_uuid").Where("uuid = ?", fileUUID)
+}
+
+// GetVersions returns all versions of the file, the last one first.
+func (r *Repository) GetVersions(fileUUID uuid.UUID) (files []File, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("chain_uuid = (?)", r.chainOf(fileUUID)).
+		Where("deleted_at IS NULL").
+		Order("version DESC").
+		Find(&files)
+
+	return files, res.Error
+}
+
+// AddVersion stores the file as the new current version of the chain.
+func (r *Repository) AddVersion(file File) (File, error) {
+	err := r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		last := File{}
+
+		res := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("chain_uuid = ?", file.ChainUUID).
+			Where("deleted_at IS NULL").
+			Order("version DESC").
+			Limit(1).
+			Find(&last)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("файл не найден")
+		}
+
+		err := tx.Model(&File{}).
+			Where("chain_uuid = ?", file.ChainUUID).
+			UpdateColumn("is_current", false).Error
+		if err != nil {
+			return err
+		}
+
+		file.Version = last.Version + 1
+		file.IsCurrent = true
+
+		return tx.Create(&file).Error
+	})
+
+	return file, err
+}
+
+// SetCurrent makes the version current in its chain.
+func (r *Repository) SetCurrent(fileUUID uuid.UUID) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		file := File{}
+
+		res := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uuid = ?", fileUUID).
+			Where("deleted_at IS NULL").
+			Find(&file)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("файл не найден")
+		}
+
+		return tx.Exec("UPDATE files SET is_current = (uuid = ?) WHERE chain_uuid = ? AND deleted_at IS NULL", file.UUID, file.ChainUUID).Error
+	})
+}
+
+// SetPreview stores what the preview workers derived from the file, zero
+// dimensions keep the ones detected at upload.
+func (r *Repository) SetPreview(fileUUID uuid.UUID, preview Preview) error {
+	values := map[string]interface{}{
+		"preview_object_name": preview.ObjectName,
+		"duration_ms":         preview.Duration.Milliseconds(),
+	}
+
+	if preview.Width > 0 && preview.Height > 0 {
+		values["img_width"] = preview.Width
+		values["img_height"] = preview.Height
+	}
+
+	return r.gorm.DB.
+		Model(&File{}).
+		Where("uuid = ?", fileUUID).
+		UpdateColumns(values).Error
+}
+
+// SetScan stores the result of the antivirus check.
+func (r *Repository) SetScan(fileUUID uuid.UUID, status, signature string) error {
+	return r.gorm.DB.
+		Model(&File{}).
+		Where("uuid = ?", fileUUID).
+		UpdateColumns(map[string]interface{}{
+			"scan_status":    status,
+			"scan_signature": signature,
+			"scanned_at":     time.Now(),
+		}).Error
+}
+
+// GetPendingScans returns the files uploaded before the time and still not
+// checked, the oldest first.
+func (r *Repository) GetPendingScans(before time.Time, limit int) (files []File, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("scan_status = ?", domain.FileScanPending).
+		Where("created_at < ?", before).
+		Where("deleted_at IS NULL").
+		Order("created_at").
+		Limit(limit).
+		Find(&files)
+
+	return files, res.Error
+}
+
+// SaveText stores the extracted text of the file, the text of the version is
+// replaced on extraction again.
+func (r *Repository) SaveText(fileUUID uuid.UUID, content string) error {
+	return r.gorm.DB.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "file_uuid"}},
+			DoUpdates: clause.AssignmentColumns([]string{"content", "created_at"}),
+		}).
+		Create(&FileText{
+			FileUUID:  fileUUID,
+			Content:   content,
+			CreatedAt: time.Now(),
+		}).Error
+}
+
+// SearchTexts finds the current files of the federation tasks and their
+// comments by the text, the best matches first. Only the tasks the user can
+// see are searched, so the page and the total are of the visible files.
+func (r *Repository) SearchTexts(federationUUID uuid.UUID, query, email string, limit, offset int) (hits []FileTextHit, total int64, err error) {
+	visible, visibleArgs := domain.TaskVisibleSQL("t", email)
+
+	from := `
+		FROM file_texts ft
+		JOIN files f ON f.uuid = ft.file_uuid
+		LEFT JOIN comments c ON f.type = 'comment' AND c.uuid = f.type_uuid
+		JOIN tasks t ON t.uuid = CASE WHEN f.type = 'task' THEN f.type_uuid ELSE c.task_uuid END,
+			websearch_to_tsquery('russian', ?) q
+		WHERE ft.tsv @@ q
+			AND t.federation_uuid = ?
+			AND t.deleted_at IS NULL
+			AND (f.type = 'task' OR c.deleted_at IS NULL)
+			AND f.is_current
+			AND f.deleted_at IS NULL
+			AND ` + visible
+
+	args := append([]interface{}{query, federationUUID}, visibleArgs...)
+
+	err = r.gorm.DB.Raw(`SELECT COUNT(*)`+from, args...).Scan(&total).Error
+	if err != nil || total == 0 {
+		return hits, total, err
+	}
+
+	err = r.gorm.DB.Raw(`
+		SELECT f.*, t.uuid AS task_uuid, t.id AS task_id, t.name AS task_name,
+			ts_headline('russian', ft.content, q, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5') AS snippet`+from+`
+		ORDER BY ts_rank(ft.tsv, q) DESC, f.created_at DESC
+		LIMIT ? OFFSET ?`, append(args, limit, offset)...).
+		Scan(&hits).Error
+
+	return hits, total, err
+}
+
+// ReserveUsage counts the new file of the federation, nothing is counted and
+// false is returned when the file does not fit the quota. Zero quota is
+// unlimited.
+func (r *Repository) ReserveUsage(federationUUID uuid.UUID, size, quota int64) (bool, error) {
+	if quota > 0 && size > quota {
+		return false, nil
+	}
+
+	res := r.gorm.DB.Exec(`
+		INSERT INTO storage_usage (federation_uuid, bytes, files, updated_at)
+		VALUES (?, ?, 1, now())
+		ON CONFLICT (federation_uuid) DO UPDATE
+		SET bytes = storage_usage.bytes + EXCLUDED.bytes,
+			files = storage_usage.files + 1,
+			updated_at = now()
+		WHERE ? = 0 OR storage_usage.bytes + EXCLUDED.bytes <= ?`, federationUUID, size, quota, quota)
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// ReleaseUsage uncounts the removed files of the federation.
+func (r *Repository) ReleaseUsage(federationUUID uuid.UUID, size int64, files int) error {
+	return r.gorm.DB.
+		Model(&StorageUsage{}).
+		Where("federation_uuid = ?", federationUUID).
+		UpdateColumns(map[string]interface{}{
+			"bytes":      gorm.Expr("GREATEST(bytes - ?, 0)", size),
+			"files":      gorm.Expr("GREATEST(files - ?, 0)", files),
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (r *Repository) GetUsage(federationUUID uuid.UUID) (usage StorageUsage, err error) {
+	res := r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Find(&usage)
+
+	usage.FederationUUID = federationUUID
+
+	return usage, res.Error
+}
+
+// ReplaceUsage stores the usage recomputed from the bucket, the federations
+// without objects are reset.
+func (r *Repository) ReplaceUsage(usages []StorageUsage) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("UPDATE storage_usage SET bytes = 0, files = 0, updated_at = now()").Error
+		if err != nil {
+			return err
+		}
+
+		if len(usages) == 0 {
+			return nil
+		}
+
+		return tx.
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "federation_uuid"}},
+				DoUpdates: clause.AssignmentColumns([]string{"bytes", "files", "updated_at"}),
+			}).
+			Create(&usages).Error
+	})
+}
+
+// usageFiles is the subquery of the stored files of the federation tasks and
+// their comments, all versions are counted.
+const usageFiles = `
+	FROM files f
+	LEFT JOIN comments c ON f.type = 'comment' AND c.uuid = f.type_uuid
+	JOIN tasks t ON t.uuid = CASE WHEN f.type = 'task' THEN f.type_uuid ELSE c.task_uuid END
+	WHERE t.federation_uuid = ?
+		AND f.deleted_at IS NULL`
+
+// GetUsageByProject breaks the stored bytes of the federation down by the
+// projects, the largest first.
+func (r *Repository) GetUsageByProject(federationUUID uuid.UUID) (rows []StorageUsageRow, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT t.company_uuid, t.project_uuid, SUM(f.size) AS bytes, COUNT(*) AS files`+usageFiles+`
+		GROUP BY t.company_uuid, t.project_uuid
+		ORDER BY bytes DESC`, federationUUID).
+		Scan(&rows).Error
+
+	return rows, err
+}
+
+// GetUsageByType breaks the stored bytes of the federation down by the mime
+// types, the largest first.
+func (r *Repository) GetUsageByType(federationUUID uuid.UUID) (rows []StorageUsageRow, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT f.mime_type, SUM(f.size) AS bytes, COUNT(*) AS files`+usageFiles+`
+		GROUP BY f.mime_type
+		ORDER BY bytes DESC`, federationUUID).
+		Scan(&rows).Error
+
+	return rows, err
+}
+
+// GetStoredFiles returns the stored files with the fields the bucket is
+// reconciled by.
+func (r *Repository) GetStoredFiles() (files []File, err error) {
+	res := r.gorm.DB.
+		Model(&File{}).
+		Select("uuid, object_name, preview_object_name, size, sha256, created_at").
+		Where("deleted_at IS NULL").
+		Find(&files)
+
+	return files, res.Error
+}
+
+// GetBlobObjects returns the objects of the shared contents still counted,
+// they are kept with their previews while any file refers to them.
+func (r *Repository) GetBlobObjects() (objectNames []string, err error) {
+	err = r.gorm.DB.
+		Model(&FileBlob{}).
+		Where("refs > 0").
+		Pluck("object_name", &objectNames).Error
+
+	return objectNames, err
+}
+
+// GetExpiredFiles returns the files marked for delete before marked, whose
+// delete was interrupted, and the files of the tasks and comments deleted
+// before deleted or removed at all.
+func (r *Repository) GetExpiredFiles(marked, deleted time.Time) (files []File, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT f.*
+		FROM files f
+		LEFT JOIN comments c ON f.type = 'comment' AND c.uuid = f.type_uuid
+		LEFT JOIN tasks t ON t.uuid = CASE WHEN f.type = 'task' THEN f.type_uuid ELSE c.task_uuid END
+		WHERE f.deleted_at IS NULL
+			AND (f.to_deleted_at < ?
+				OR t.deleted_at < ?
+				OR c.deleted_at < ?
+				OR (t.uuid IS NULL AND f.created_at < ?))
+		ORDER BY f.created_at`, marked, deleted, deleted, deleted).
+		Scan(&files).Error
+
+	return files, err
+}
+
+// DeleteFiles marks the files deleted without the versions chain, their
+// objects are removed by the caller.
+func (r *Repository) DeleteFiles(fileUUIDs []uuid.UUID) error {
+	if len(fileUUIDs) == 0 {
+		return nil
+	}
+
+	return r.gorm.DB.
+		Model(&File{}).
+		Where("uuid IN ?", fileUUIDs).
+		Where("deleted_at IS NULL").
+		UpdateColumn("deleted_at", "now()").Error
+}
+
+// GetPhotoUsers returns the users who have the profile photo.
+func (r *Repository) GetPhotoUsers() (uids []uuid.UUID, err error) {
+	err = r.gorm.DB.
+		Table("users").
+		Where("has_photo").
+		Pluck("uuid", &uids).Error
+
+	return uids, err
+}
+
+// AcquireBlob counts the file of the content, the object stored first in the
+// federation is kept for the content and its name is returned.
+func (r *Repository) AcquireBlob(blob FileBlob) (objectName string, err error) {
+	err = r.gorm.DB.Raw(`
+		INSERT INTO file_blobs (federation_uuid, sha256, object_name, size, refs, created_at, updated_at)
+		VALUES (?, ?, ?, ?, 1, now(), now())
+		ON CONFLICT (federation_uuid, sha256) DO UPDATE
+		SET refs = file_blobs.refs + 1,
+			updated_at = now()
+		RETURNING object_name`, blob.FederationUUID, blob.SHA256, blob.ObjectName, blob.Size).
+		Scan(&objectName).Error
+
+	return objectName, err
+}
+
+// RefBlob counts one more file of the content stored in the federation, the
+// empty name is returned when there is no such content.
+func (r *Repository) RefBlob(federationUUID uuid.UUID, hash string) (objectName string, err error) {
+	err = r.gorm.DB.Raw(`
+		UPDATE file_blobs
+		SET refs = refs + 1,
+			updated_at = now()
+		WHERE federation_uuid = ? AND sha256 = ? AND refs > 0
+		RETURNING object_name`, federationUUID, hash).
+		Scan(&objectName).Error
+
+	return objectName, err
+}
+
+// ReleaseBlob uncounts the file of the content, true is returned when it was
+// the last one and the object is to be removed.
+func (r *Repository) ReleaseBlob(federationUUID uuid.UUID, hash string) (bool, error) {
+	err := r.gorm.DB.
+		Model(&FileBlob{}).
+		Where("federation_uuid = ? AND sha256 = ?", federationUUID, hash).
+		UpdateColumns(map[string]interface{}{
+			"refs":       gorm.Expr("GREATEST(refs - 1, 0)"),
+			"updated_at": time.Now(),
+		}).Error
+	if err != nil {
+		return false, err
+	}
+
+	res := r.gorm.DB.
+		Where("federation_uuid = ? AND sha256 = ? AND refs = 0", federationUUID, hash).
+		Delete(&FileBlob{})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// GetHashTasks returns the tasks of the federation whose task or comment
+// files have the content.
+func (r *Repository) GetHashTasks(federationUUID uuid.UUID, hash string) (uids []uuid.UUID, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT DISTINCT t.uuid
+		FROM files f
+		LEFT JOIN comments c ON f.type = 'comment' AND c.uuid = f.type_uuid AND c.deleted_at IS NULL
+		JOIN tasks t ON t.uuid = CASE WHEN f.type = 'task' THEN f.type_uuid ELSE c.task_uuid END
+		WHERE f.sha256 = ?
+			AND f.object_name LIKE ?
+			AND f.deleted_at IS NULL
+			AND t.deleted_at IS NULL`, hash, federationUUID.String()+"/%").
+		Scan(&uids).Error
+
+	return uids, err
+}
+
+// GetFileByHash returns the first stored file of the federation with the
+// content among the task and comment files of the tasks.
+func (r *Repository) GetFileByHash(federationUUID uuid.UUID, hash string, taskUUIDs []uuid.UUID) (file File, err error) {
+	if len(taskUUIDs) == 0 {
+		return file, dto.NotFoundErr("файл с таким содержимым не найден")
+	}
+
+	res := r.gorm.DB.
+		Model(&File{}).
+		Where("sha256 = ?", hash).
+		Where("object_name LIKE ?", federationUUID.String()+"/%").
+		Where("deleted_at IS NULL").
+		Where(`(type = 'task' AND type_uuid IN ?)
+			OR (type = 'comment' AND type_uuid IN (SELECT uuid FROM comments WHERE task_uuid IN ? AND deleted_at IS NULL))`, taskUUIDs, taskUUIDs).
+		Order("created_at").
+		Limit(1).
+		Find(&file)
+
+	if res.Error != nil {
+		return file, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return file, dto.NotFoundErr("файл с таким содержимым не найден")
+	}
+
+	return file, nil
+}
+
+// GetBlobDrift returns the blobs not changed after before whose refs differ
+// from the stored files of their objects, the refs are of the files.
+func (r *Repository) GetBlobDrift(before time.Time) (blobs []FileBlob, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT b.federation_uuid, b.sha256, b.object_name, b.size, COUNT(f.uuid) AS refs, b.created_at, b.updated_at
+		FROM file_blobs b
+		LEFT JOIN files f ON f.sha256 = b.sha256 AND f.object_name = b.object_name AND f.deleted_at IS NULL
+		WHERE b.updated_at < ?
+		GROUP BY b.federation_uuid, b.sha256
+		HAVING COUNT(f.uuid) <> b.refs`, before).
+		Scan(&blobs).Error
+
+	return blobs, err
+}
+
+// FixBlobRefs stores the counted refs of the blobs unless they were changed
+// after before, the blobs without files are removed.
+func (r *Repository) FixBlobRefs(blobs []FileBlob, before time.Time) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for _, blob := range blobs {
+			err := tx.
+				Model(&FileBlob{}).
+				Where("federation_uuid = ? AND sha256 = ?", blob.FederationUUID, blob.SHA256).
+				Where("updated_at < ?", before).
+				UpdateColumns(map[string]interface{}{
+					"refs":       blob.Refs,
+					"updated_at": time.Now(),
+				}).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.
+				Where("federation_uuid = ? AND sha256 = ? AND refs = 0", blob.FederationUUID, blob.SHA256).
+				Delete(&FileBlob{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,114 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ScannerClamd = "clamd"
+	ScannerFake  = "fake"
+)
+
+// ScanResult is the verdict of the antivirus, Signature names the found
+// malware.
+type ScanResult struct {
+	Infected  bool
+	Signature string
+}
+
+// Scanner checks the uploaded files before they are given out. The private
+// service keeps the files pending until the scanner calls them clean.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (ScanResult, error)
+}
+
+type ScannerConf struct {
+	Driver  string
+	Addr    string
+	Timeout time.Duration
+}
+
+// NewScanner returns nil when the driver is empty, the files are not scanned
+// then.
+func NewScanner(conf ScannerConf) (Scanner, error) {
+	switch conf.Driver {
+	case "":
+		return nil, nil
+	case ScannerClamd:
+		return NewClamdScanner(conf), nil
+	case ScannerFake:
+		return NewFakeScanner(), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный драйвер антивируса: %s", conf.Driver)
+}
+
+// OnFileInfected is called when the scanner blocks the file, the uploader is
+// notified by it.
+func (s3 *ServicePrivate) OnFileInfected(fn func(File) error) {
+	s3.onFileInfected = fn
+}
+
+// processScan checks the local copy of the file, it returns false when the
+// file is infected and must not be processed further.
+func (s3 *ServicePrivate) processScan(ctx context.Context, job fileJob, src string) (bool, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	res, err := s3.scanner.Scan(ctx, f)
+	if err != nil {
+		return false, err
+	}
+
+	if !res.Infected {
+		return true, s3.repo.SetScan(job.UUID, domain.FileScanClean, "")
+	}
+
+	logrus.WithField("file_uuid", job.UUID).WithField("signature", res.Signature).Warn("infected file is blocked")
+
+	err = s3.repo.SetScan(job.UUID, domain.FileScanInfected, res.Signature)
+	if err != nil {
+		return false, err
+	}
+
+	if s3.onFileInfected == nil {
+		return false, nil
+	}
+
+	file, err := s3.repo.GetFile(job.UUID)
+	if err != nil {
+		return false, err
+	}
+
+	return false, s3.onFileInfected(file)
+}
+
+// RescanPending hands the files which are pending longer than the age to the
+// workers again, they were lost with the full queue, the restart or the
+// unavailable scanner.
+func (s3 *ServicePrivate) RescanPending(age time.Duration, limit int) (int, error) {
+	if s3.scanner == nil {
+		return 0, nil
+	}
+
+	files, err := s3.repo.GetPendingScans(time.Now().Add(-age), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, file := range files {
+		s3.enqueueFile(file)
+	}
+
+	return len(files), nil
+}
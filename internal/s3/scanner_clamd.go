@@ -0,0 +1,122 @@
+package s3
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const clamdChunkSize = 64 << 10
+
+// ClamdScanner streams the file to clamd by the INSTREAM command. Addr is
+// host:port or the path of the unix socket.
+type ClamdScanner struct {
+	network string
+	addr    string
+	timeout time.Duration
+}
+
+func NewClamdScanner(conf ScannerConf) *ClamdScanner {
+	network := "tcp"
+	if strings.HasPrefix(conf.Addr, "/") {
+		network = "unix"
+	}
+
+	return &ClamdScanner{
+		network: network,
+		addr:    conf.Addr,
+		timeout: conf.Timeout,
+	}
+}
+
+func (s *ClamdScanner) Scan(ctx context.Context, r io.Reader) (res ScanResult, err error) {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, s.network, s.addr)
+	if err != nil {
+		return res, fmt.Errorf("clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	err = clamdStream(conn, r)
+	if err != nil {
+		return res, fmt.Errorf("clamd: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return res, fmt.Errorf("clamd: %w", err)
+	}
+
+	return parseClamdReply(reply)
+}
+
+// clamdStream sends the chunks prefixed with their length in network order,
+// the zero length ends the stream.
+func clamdStream(w io.Writer, r io.Reader) error {
+	_, err := io.WriteString(w, "zINSTREAM\x00")
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4+clamdChunkSize)
+
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+
+			if _, errWrite := w.Write(buf[:4+n]); errWrite != nil {
+				return errWrite
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write([]byte{0, 0, 0, 0})
+
+	return err
+}
+
+// parseClamdReply reads "stream: OK" or "stream: <signature> FOUND".
+func parseClamdReply(reply string) (res ScanResult, err error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+
+	switch {
+	case strings.HasSuffix(reply, " OK"):
+		return res, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		signature := strings.TrimSuffix(reply, " FOUND")
+		if i := strings.Index(signature, ": "); i >= 0 {
+			signature = signature[i+2:]
+		}
+
+		return ScanResult{Infected: true, Signature: signature}, nil
+	}
+
+	return res, fmt.Errorf("clamd: %s", reply)
+}
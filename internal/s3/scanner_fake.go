@@ -0,0 +1,31 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+)
+
+// EICAR is the standard antivirus test file, every scanner finds it.
+const EICAR = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// FakeScanner finds only the EICAR test file, it is used in tests and dev
+// runs instead of clamd.
+type FakeScanner struct{}
+
+func NewFakeScanner() *FakeScanner {
+	return &FakeScanner{}
+}
+
+func (s *FakeScanner) Scan(_ context.Context, r io.Reader) (res ScanResult, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return res, err
+	}
+
+	if bytes.Contains(data, []byte(EICAR)) {
+		return ScanResult{Infected: true, Signature: "Eicar-Test-Signature"}, nil
+	}
+
+	return res, nil
+}
@@ -0,0 +1,181 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+// fakeClamd answers one INSTREAM command as clamd does, the stream is found
+// infected when it has the EICAR string.
+func fakeClamd(t *testing.T) (string, <-chan []byte) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	streams := make(chan []byte, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+
+		cmd, err := r.ReadString(0)
+		if err != nil || cmd != "zINSTREAM\x00" {
+			conn.Write([]byte("UNKNOWN COMMAND\x00"))
+			return
+		}
+
+		data := bytes.Buffer{}
+		size := make([]byte, 4)
+
+		for {
+			if _, err = io.ReadFull(r, size); err != nil {
+				return
+			}
+
+			n := binary.BigEndian.Uint32(size)
+			if n == 0 {
+				break
+			}
+
+			if _, err = io.CopyN(&data, r, int64(n)); err != nil {
+				return
+			}
+		}
+
+		streams <- data.Bytes()
+
+		if bytes.Contains(data.Bytes(), []byte(EICAR)) {
+			conn.Write([]byte("stream: Eicar-Signature FOUND\x00"))
+			return
+		}
+
+		conn.Write([]byte("stream: OK\x00"))
+	}()
+
+	return ln.Addr().String(), streams
+}
+
+func TestClamdScanner(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want ScanResult
+	}{
+		{"clean", bytes.Repeat([]byte("счет на оплату "), clamdChunkSize/8), ScanResult{}},
+		{"infected", []byte("prefix " + EICAR), ScanResult{Infected: true, Signature: "Eicar-Signature"}},
+		{"empty", []byte{}, ScanResult{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, streams := fakeClamd(t)
+
+			scanner := NewClamdScanner(ScannerConf{Addr: addr, Timeout: 5 * time.Second})
+
+			res, err := scanner.Scan(context.Background(), bytes.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+
+			if res != tt.want {
+				t.Errorf("Scan() = %+v, want %+v", res, tt.want)
+			}
+
+			if got := <-streams; !bytes.Equal(got, tt.data) {
+				t.Errorf("clamd got %d bytes, want %d", len(got), len(tt.data))
+			}
+		})
+	}
+}
+
+func TestClamdScannerUnavailable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := ln.Addr().String()
+	ln.Close()
+
+	_, err = NewClamdScanner(ScannerConf{Addr: addr}).Scan(context.Background(), strings.NewReader("a"))
+	if err == nil {
+		t.Error("Scan() of the closed port returned no error")
+	}
+}
+
+func TestParseClamdReply(t *testing.T) {
+	res, err := parseClamdReply("stream: OK\x00")
+	if err != nil || res.Infected {
+		t.Errorf("parseClamdReply() ok = %+v %v", res, err)
+	}
+
+	res, err = parseClamdReply("stream: Win.Test.EICAR_HDB-1 FOUND\n")
+	if err != nil || !res.Infected || res.Signature != "Win.Test.EICAR_HDB-1" {
+		t.Errorf("parseClamdReply() found = %+v %v", res, err)
+	}
+
+	_, err = parseClamdReply("INSTREAM size limit exceeded. ERROR\x00")
+	if err == nil {
+		t.Error("parseClamdReply() accepted the error")
+	}
+}
+
+func TestFakeScanner(t *testing.T) {
+	res, err := NewFakeScanner().Scan(context.Background(), strings.NewReader("Договор поставки"))
+	if err != nil || res.Infected {
+		t.Errorf("Scan() clean = %+v %v", res, err)
+	}
+
+	res, err = NewFakeScanner().Scan(context.Background(), strings.NewReader(EICAR))
+	if err != nil || !res.Infected || res.Signature == "" {
+		t.Errorf("Scan() eicar = %+v %v", res, err)
+	}
+}
+
+func TestNewScanner(t *testing.T) {
+	scanner, err := NewScanner(ScannerConf{})
+	if err != nil || scanner != nil {
+		t.Errorf("NewScanner() empty = %v %v", scanner, err)
+	}
+
+	if _, err = NewScanner(ScannerConf{Driver: "drweb"}); err == nil {
+		t.Error("NewScanner() accepted unknown driver")
+	}
+
+	if s := NewClamdScanner(ScannerConf{Addr: "/var/run/clamd.ctl"}); s.network != "unix" {
+		t.Errorf("NewClamdScanner() network = %q", s.network)
+	}
+}
+
+func TestFileScanErr(t *testing.T) {
+	tests := map[string]error{
+		domain.FileScanPending:  domain.ErrFileScanPending,
+		domain.FileScanInfected: domain.ErrFileInfected,
+		domain.FileScanClean:    nil,
+	}
+
+	for status, want := range tests {
+		if err := (File{ScanStatus: status}).scanErr(); !errors.Is(err, want) {
+			t.Errorf("scanErr(%q) = %v, want %v", status, err, want)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DriverS3     = "s3"
+	DriverLocal  = "local"
+	DriverMemory = "memory"
+)
+
+var (
+	ErrObjectNotFound   = errors.New("объект не найден")
+	ErrInvalidSignature = errors.New("неверная подпись ссылки")
+	ErrURLExpired       = errors.New("срок действия ссылки истек")
+)
+
+// Storage is a bucket of the object storage. Public and private services
+// work through it, so the driver is chosen by config.
+type Storage interface {
+	Driver() string
+	Bucket() string
+	Endpoint() string
+
+	Put(ctx context.Context, objectName string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, objectName string) (io.ReadCloser, error)
+	Copy(ctx context.Context, srcObjectName, dstObjectName string) error
+	Remove(ctx context.Context, objectName string) error
+	List(ctx context.Context, prefix string) ([]string, error)
+	// ListObjects is List with the sizes of the objects.
+	ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error)
+
+	// URL is the public link of the object.
+	URL(objectName string) string
+	// PresignedURL is the temporary link, fileName is used for download.
+	PresignedURL(ctx context.Context, objectName, fileName string, expires time.Duration) (string, error)
+}
+
+type ObjectInfo struct {
+	Name     string
+	Size     int64
+	Modified time.Time
+}
+
+// objectNames drops the sizes of the listed objects.
+func objectNames(objects []ObjectInfo, err error) ([]string, error) {
+	names := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		names = append(names, obj.Name)
+	}
+
+	return names, err
+}
+
+// ServedStorage is a storage without own http server, its objects are served
+// by the app on /storage/{bucket}/{objectName}.
+type ServedStorage interface {
+	Storage
+	Verify(objectName string, query url.Values, now time.Time) error
+}
+
+type StorageConf struct {
+	Driver string
+
+	// s3
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	BucketName      string
+	Location        string
+	UseSSL          bool
+
+	// local and memory
+	LocalPath  string
+	BackendURL string
+	Secret     string
+	Public     bool
+}
+
+func NewStorage(conf StorageConf) (Storage, error) {
+	switch conf.Driver {
+	case DriverS3, "":
+		return NewMinioStorage(conf)
+	case DriverLocal:
+		return NewLocalStorage(conf), nil
+	case DriverMemory:
+		return NewMemoryStorage(conf), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный драйвер хранилища: %s", conf.Driver)
+}
+
+// signer emulates presigned urls for the storages served by the app.
+type signer struct {
+	backendURL string
+	bucket     string
+	secret     []byte
+	public     bool
+}
+
+func newSigner(conf StorageConf) signer {
+	return signer{
+		backendURL: strings.TrimRight(conf.BackendURL, "/"),
+		bucket:     conf.BucketName,
+		secret:     []byte(conf.Secret),
+		public:     conf.Public,
+	}
+}
+
+func (s signer) URL(objectName string) string {
+	return fmt.Sprintf("%s/storage/%s/%s", s.backendURL, url.PathEscape(s.bucket), escapeObjectName(objectName))
+}
+
+func (s signer) PresignedURL(objectName, fileName string, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("filename", fileName)
+	query.Set("signature", s.sign(objectName, fileName, expires))
+
+	return s.URL(objectName) + "?" + query.Encode()
+}
+
+// Verify checks the signature of the link, public buckets are open.
+func (s signer) Verify(objectName string, query url.Values, now time.Time) error {
+	if s.public && query.Get("signature") == "" {
+		return nil
+	}
+
+	expires := query.Get("expires")
+	sign := s.sign(objectName, query.Get("filename"), expires)
+
+	if !hmac.Equal([]byte(sign), []byte(query.Get("signature"))) {
+		return ErrInvalidSignature
+	}
+
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if now.Unix() > unix {
+		return ErrURLExpired
+	}
+
+	return nil
+}
+
+func (s signer) sign(objectName, fileName, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(s.bucket + "\n" + objectName + "\n" + fileName + "\n" + expires))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func escapeObjectName(objectName string) string {
+	parts := strings.Split(objectName, "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+
+	return strings.Join(parts, "/")
+}
+
+func putFile(ctx context.Context, storage Storage, objectName, filePath, contentType string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	return storage.Put(ctx, objectName, f, stat.Size(), contentType)
+}
+
+// ObjectContentType guesses the content type by the object extension.
+func ObjectContentType(objectName string) string {
+	contentType := mime.TypeByExtension(path.Ext(objectName))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return contentType
+}
+
+// cleanObjectName rejects names escaping the bucket.
+func cleanObjectName(objectName string) (string, error) {
+	name := path.Clean("/" + objectName)
+	if name == "/" || strings.Contains(objectName, "..") {
+		return "", ErrObjectNotFound
+	}
+
+	return strings.TrimPrefix(name, "/"), nil
+}
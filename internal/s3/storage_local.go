@@ -0,0 +1,177 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LocalStorage keeps objects in the local folder, the links are served by the
+// app and signed like s3 presigned urls.
+type LocalStorage struct {
+	root   string
+	bucket string
+	signer signer
+}
+
+func NewLocalStorage(conf StorageConf) *LocalStorage {
+	return &LocalStorage{
+		root:   filepath.Join(conf.LocalPath, conf.BucketName),
+		bucket: conf.BucketName,
+		signer: newSigner(conf),
+	}
+}
+
+func (s *LocalStorage) Driver() string {
+	return DriverLocal
+}
+
+func (s *LocalStorage) Bucket() string {
+	return s.bucket
+}
+
+func (s *LocalStorage) Endpoint() string {
+	return DriverLocal
+}
+
+func (s *LocalStorage) path(objectName string) (string, error) {
+	name, err := cleanObjectName(objectName)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(name)), nil
+}
+
+func (s *LocalStorage) Put(_ context.Context, objectName string, r io.Reader, _ int64, _ string) error {
+	p, err := s.path(objectName)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(p), 0o755)
+	if err != nil {
+		return err
+	}
+
+	// write to temp file first, readers never see a partial object
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+func (s *LocalStorage) Get(_ context.Context, objectName string) (io.ReadCloser, error) {
+	p, err := s.path(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+
+	return f, err
+}
+
+func (s *LocalStorage) Copy(ctx context.Context, srcObjectName, dstObjectName string) error {
+	src, err := s.Get(ctx, srcObjectName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return s.Put(ctx, dstObjectName, src, -1, "")
+}
+
+func (s *LocalStorage) Remove(_ context.Context, objectName string) error {
+	p, err := s.path(objectName)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *LocalStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	return objectNames(s.ListObjects(ctx, prefix))
+}
+
+func (s *LocalStorage) ListObjects(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	objects := []ObjectInfo{}
+
+	err := filepath.WalkDir(s.root, func(p string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, ObjectInfo{Name: name, Size: info.Size(), Modified: info.ModTime()})
+
+		return nil
+	})
+
+	return objects, err
+}
+
+func (s *LocalStorage) URL(objectName string) string {
+	return s.signer.URL(objectName)
+}
+
+func (s *LocalStorage) PresignedURL(_ context.Context, objectName, fileName string, expires time.Duration) (string, error) {
+	return s.signer.PresignedURL(objectName, fileName, time.Now().Add(expires)), nil
+}
+
+func (s *LocalStorage) Verify(objectName string, query url.Values, now time.Time) error {
+	return s.signer.Verify(objectName, query, now)
+}
+
+var _ ServedStorage = (*LocalStorage)(nil)
@@ -0,0 +1,158 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryStorage keeps objects in memory, it is used in tests and dev runs.
+// Links are the same as of the local storage.
+type MemoryStorage struct {
+	bucket string
+	signer signer
+
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+}
+
+type memoryObject struct {
+	data     []byte
+	modified time.Time
+}
+
+func NewMemoryStorage(conf StorageConf) *MemoryStorage {
+	return &MemoryStorage{
+		bucket:  conf.BucketName,
+		signer:  newSigner(conf),
+		objects: make(map[string]memoryObject),
+	}
+}
+
+func (s *MemoryStorage) Driver() string {
+	return DriverMemory
+}
+
+func (s *MemoryStorage) Bucket() string {
+	return s.bucket
+}
+
+func (s *MemoryStorage) Endpoint() string {
+	return DriverMemory
+}
+
+func (s *MemoryStorage) Put(_ context.Context, objectName string, r io.Reader, _ int64, _ string) error {
+	name, err := cleanObjectName(objectName)
+	if err != nil {
+		return err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.objects[name] = memoryObject{data: data, modified: time.Now()}
+
+	return nil
+}
+
+func (s *MemoryStorage) Get(_ context.Context, objectName string) (io.ReadCloser, error) {
+	name, err := cleanObjectName(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	obj, ok := s.objects[name]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(obj.data)), nil
+}
+
+func (s *MemoryStorage) Copy(_ context.Context, srcObjectName, dstObjectName string) error {
+	src, err := cleanObjectName(srcObjectName)
+	if err != nil {
+		return err
+	}
+
+	dst, err := cleanObjectName(dstObjectName)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.objects[src]
+	if !ok {
+		return ErrObjectNotFound
+	}
+
+	// objects are never changed in place, so the data is shared
+	s.objects[dst] = memoryObject{data: obj.data, modified: time.Now()}
+
+	return nil
+}
+
+func (s *MemoryStorage) Remove(_ context.Context, objectName string) error {
+	name, err := cleanObjectName(objectName)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, name)
+
+	return nil
+}
+
+func (s *MemoryStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	return objectNames(s.ListObjects(ctx, prefix))
+}
+
+func (s *MemoryStorage) ListObjects(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	objects := []ObjectInfo{}
+	for name, obj := range s.objects {
+		if strings.HasPrefix(name, prefix) {
+			objects = append(objects, ObjectInfo{Name: name, Size: int64(len(obj.data)), Modified: obj.modified})
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name < objects[j].Name
+	})
+
+	return objects, nil
+}
+
+func (s *MemoryStorage) URL(objectName string) string {
+	return s.signer.URL(objectName)
+}
+
+func (s *MemoryStorage) PresignedURL(_ context.Context, objectName, fileName string, expires time.Duration) (string, error) {
+	return s.signer.PresignedURL(objectName, fileName, time.Now().Add(expires)), nil
+}
+
+func (s *MemoryStorage) Verify(objectName string, query url.Values, now time.Time) error {
+	return s.signer.Verify(objectName, query, now)
+}
+
+var _ ServedStorage = (*MemoryStorage)(nil)
@@ -0,0 +1,176 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/sirupsen/logrus"
+)
+
+// MinioStorage is the s3 compatible storage (Yandex Cloud, minio).
+type MinioStorage struct {
+	client   *minio.Client
+	endpoint string
+	bucket   string
+	location string
+
+	mu          sync.Mutex
+	bucketReady bool
+}
+
+func NewMinioStorage(conf StorageConf) (*MinioStorage, error) {
+	client, err := minio.New(conf.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(conf.AccessKeyID, conf.SecretAccessKey, ""),
+		Secure: conf.UseSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("S3: %w", err)
+	}
+
+	return &MinioStorage{
+		client:   client,
+		endpoint: conf.Endpoint,
+		bucket:   conf.BucketName,
+		location: conf.Location,
+	}, nil
+}
+
+func (s *MinioStorage) Driver() string {
+	return DriverS3
+}
+
+func (s *MinioStorage) Bucket() string {
+	return s.bucket
+}
+
+func (s *MinioStorage) Endpoint() string {
+	return s.endpoint
+}
+
+func (s *MinioStorage) ensureBucket(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.bucketReady {
+		return nil
+	}
+
+	err := s.client.MakeBucket(ctx, s.bucket, minio.MakeBucketOptions{Region: s.location})
+	if err != nil {
+		exists, errBucketExists := s.client.BucketExists(ctx, s.bucket)
+		if errBucketExists != nil || !exists {
+			return err
+		}
+	} else {
+		logrus.Infof("S3: successfully created %s\n", s.bucket)
+	}
+
+	s.bucketReady = true
+
+	return nil
+}
+
+func (s *MinioStorage) Put(ctx context.Context, objectName string, r io.Reader, size int64, contentType string) error {
+	err := s.ensureBucket(ctx)
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	info, err := s.client.PutObject(ctx, s.bucket, objectName, r, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	logrus.Debugf("successfully uploaded %s of size %d\n", objectName, info.Size)
+
+	return nil
+}
+
+func (s *MinioStorage) Get(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("S3: %w", err)
+	}
+
+	// GetObject is lazy, stat reports the missing object
+	_, err = obj.Stat()
+	if err != nil {
+		obj.Close()
+
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrObjectNotFound
+		}
+
+		return nil, fmt.Errorf("S3: %w", err)
+	}
+
+	return obj, nil
+}
+
+func (s *MinioStorage) Copy(ctx context.Context, srcObjectName, dstObjectName string) error {
+	_, err := s.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: s.bucket, Object: dstObjectName},
+		minio.CopySrcOptions{Bucket: s.bucket, Object: srcObjectName},
+	)
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MinioStorage) Remove(ctx context.Context, objectName string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, objectName, minio.RemoveObjectOptions{
+		ForceDelete: true,
+	})
+	if err != nil {
+		return fmt.Errorf("S3: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MinioStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	return objectNames(s.ListObjects(ctx, prefix))
+}
+
+func (s *MinioStorage) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	objects := []ObjectInfo{}
+
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if obj.Err != nil {
+			return objects, fmt.Errorf("S3: %w", obj.Err)
+		}
+
+		objects = append(objects, ObjectInfo{Name: obj.Key, Size: obj.Size, Modified: obj.LastModified})
+	}
+
+	return objects, nil
+}
+
+func (s *MinioStorage) URL(objectName string) string {
+	return fmt.Sprintf("https://%s.%s/%s", s.bucket, s.endpoint, objectName)
+}
+
+func (s *MinioStorage) PresignedURL(ctx context.Context, objectName, fileName string, expires time.Duration) (string, error) {
+	reqParams := make(url.Values)
+	reqParams.Set("response-content-disposition", fmt.Sprintf("filename=\"%q\"", fileName))
+
+	presignedURL, err := s.client.PresignedGetObject(ctx, s.bucket, objectName, expires, reqParams)
+	if err != nil {
+		return "", err
+	}
+
+	return presignedURL.String(), nil
+}
+
+var _ Storage = (*MinioStorage)(nil)
@@ -0,0 +1,137 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func servedStorages(t *testing.T, public bool) map[string]ServedStorage {
+	conf := StorageConf{
+		LocalPath:  t.TempDir(),
+		BackendURL: "http://localhost:8080/",
+		BucketName: "private",
+		Secret:     "secret",
+		Public:     public,
+	}
+
+	return map[string]ServedStorage{
+		DriverLocal:  NewLocalStorage(conf),
+		DriverMemory: NewMemoryStorage(conf),
+	}
+}
+
+func TestServedStorageObjects(t *testing.T) {
+	ctx := context.Background()
+
+	for driver, st := range servedStorages(t, false) {
+		t.Run(driver, func(t *testing.T) {
+			err := st.Put(ctx, "f/task/a.txt", strings.NewReader("hello"), 5, "text/plain")
+			if err != nil {
+				t.Fatalf("Put() error = %v", err)
+			}
+
+			err = st.Copy(ctx, "f/task/a.txt", "f/task/b.txt")
+			if err != nil {
+				t.Fatalf("Copy() error = %v", err)
+			}
+
+			obj, err := st.Get(ctx, "f/task/b.txt")
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			data, _ := io.ReadAll(obj)
+			obj.Close()
+
+			if string(data) != "hello" {
+				t.Errorf("Get() = %q", data)
+			}
+
+			names, err := st.List(ctx, "f/")
+			if err != nil || !reflect.DeepEqual(names, []string{"f/task/a.txt", "f/task/b.txt"}) {
+				t.Errorf("List() = %v %v", names, err)
+			}
+
+			objects, err := st.ListObjects(ctx, "f/task/b")
+			if err != nil || len(objects) != 1 || objects[0].Name != "f/task/b.txt" || objects[0].Size != 5 || objects[0].Modified.IsZero() {
+				t.Errorf("ListObjects() = %v %v", objects, err)
+			}
+
+			err = st.Remove(ctx, "f/task/a.txt")
+			if err != nil {
+				t.Fatalf("Remove() error = %v", err)
+			}
+
+			if _, err = st.Get(ctx, "f/task/a.txt"); !errors.Is(err, ErrObjectNotFound) {
+				t.Errorf("Get() removed error = %v", err)
+			}
+
+			if _, err = st.Get(ctx, "../private/f/task/b.txt"); !errors.Is(err, ErrObjectNotFound) {
+				t.Errorf("Get() outside of bucket error = %v", err)
+			}
+		})
+	}
+}
+
+func TestServedStoragePresignedURL(t *testing.T) {
+	now := time.Now()
+
+	for driver, st := range servedStorages(t, false) {
+		t.Run(driver, func(t *testing.T) {
+			link, err := st.PresignedURL(context.Background(), "f/task/a b.txt", "отчет.txt", time.Hour)
+			if err != nil {
+				t.Fatalf("PresignedURL() error = %v", err)
+			}
+
+			u, err := url.Parse(link)
+			if err != nil {
+				t.Fatalf("url.Parse() error = %v", err)
+			}
+
+			if u.Path != "/storage/private/f/task/a b.txt" {
+				t.Errorf("PresignedURL() path = %q", u.Path)
+			}
+
+			if err = st.Verify("f/task/a b.txt", u.Query(), now); err != nil {
+				t.Errorf("Verify() error = %v", err)
+			}
+
+			if err = st.Verify("f/task/other.txt", u.Query(), now); !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("Verify() other object error = %v", err)
+			}
+
+			if err = st.Verify("f/task/a b.txt", u.Query(), now.Add(2*time.Hour)); !errors.Is(err, ErrURLExpired) {
+				t.Errorf("Verify() expired error = %v", err)
+			}
+
+			if err = st.Verify("f/task/a b.txt", url.Values{}, now); !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("Verify() unsigned error = %v", err)
+			}
+		})
+	}
+}
+
+func TestServedStoragePublicURL(t *testing.T) {
+	for driver, st := range servedStorages(t, true) {
+		t.Run(driver, func(t *testing.T) {
+			if got := st.URL("photos-1.jpg"); got != "http://localhost:8080/storage/private/photos-1.jpg" {
+				t.Errorf("URL() = %q", got)
+			}
+
+			if err := st.Verify("photos-1.jpg", url.Values{}, time.Now()); err != nil {
+				t.Errorf("Verify() public error = %v", err)
+			}
+		})
+	}
+}
+
+func TestNewStorageUnknownDriver(t *testing.T) {
+	if _, err := NewStorage(StorageConf{Driver: "ftp"}); err == nil {
+		t.Error("NewStorage() accepted unknown driver")
+	}
+}
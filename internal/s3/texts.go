@@ -0,0 +1,279 @@
+package s3
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	textKindPDF   = "pdf"
+	textKindDOCX  = "docx"
+	textKindXLSX  = "xlsx"
+	textKindPlain = "plain"
+
+	mimeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	mimeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// maxTextSize bounds the stored text of the file, the rest is not searched
+	maxTextSize = 1 << 20
+)
+
+// textKind is the way to extract the text of the file. Office documents are
+// zip archives and are told by the extension when the mime type is generic.
+func textKind(mime, objectName string) string {
+	ext := strings.ToLower(path.Ext(objectName))
+
+	switch {
+	case mime == "application/pdf":
+		return textKindPDF
+	case mime == mimeDOCX, mime == "application/zip" && ext == ".docx":
+		return textKindDOCX
+	case mime == mimeXLSX, mime == "application/zip" && ext == ".xlsx":
+		return textKindXLSX
+	case strings.HasPrefix(mime, "text/"):
+		return textKindPlain
+	}
+
+	return ""
+}
+
+// text extracts the text of the local file.
+func (p previewer) text(ctx context.Context, src, kind string) (string, error) {
+	switch kind {
+	case textKindPDF:
+		out, err := exec.CommandContext(ctx, p.pdftotext, "-enc", "UTF-8", src, "-").Output()
+		if err != nil {
+			return "", fmt.Errorf("pdftotext: %w", err)
+		}
+
+		return cleanText(out), nil
+
+	case textKindDOCX:
+		return docxText(src)
+
+	case textKindXLSX:
+		return xlsxText(src)
+
+	case textKindPlain:
+		f, err := os.Open(src)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+
+		data, err := io.ReadAll(io.LimitReader(f, maxTextSize))
+		if err != nil {
+			return "", err
+		}
+
+		return cleanText(data), nil
+	}
+
+	return "", nil
+}
+
+func (s3 *ServicePrivate) processText(ctx context.Context, job fileJob, src string) error {
+	text, err := s3.previewer.text(ctx, src, textKind(job.MimeType, job.ObjectName))
+	if errors.Is(err, exec.ErrNotFound) {
+		logrus.WithField("file_uuid", job.UUID).Debug("text tool is not installed: ", err)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+
+	return s3.repo.SaveText(job.UUID, text)
+}
+
+// docxText reads the paragraphs of word/document.xml.
+func docxText(src string) (string, error) {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		if f.Name == "word/document.xml" {
+			return xmlText(f, "t", "p")
+		}
+	}
+
+	return "", errors.New("docx: нет word/document.xml")
+}
+
+// xlsxText reads the shared strings and the cell values of all sheets, the
+// numbers are stored in the sheets.
+func xlsxText(src string) (string, error) {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+
+	sheets := []*zip.File{}
+	parts := []string{}
+
+	for _, f := range zr.File {
+		switch {
+		case f.Name == "xl/sharedStrings.xml":
+			text, err := xmlText(f, "t", "si")
+			if err != nil {
+				return "", err
+			}
+
+			parts = append(parts, text)
+
+		case strings.HasPrefix(f.Name, "xl/worksheets/") && strings.HasSuffix(f.Name, ".xml"):
+			sheets = append(sheets, f)
+		}
+	}
+
+	sort.Slice(sheets, func(i, j int) bool {
+		return sheets[i].Name < sheets[j].Name
+	})
+
+	for _, f := range sheets {
+		text, err := sheetValues(f)
+		if err != nil {
+			return "", err
+		}
+
+		parts = append(parts, text)
+	}
+
+	return cleanText([]byte(strings.Join(parts, "\n"))), nil
+}
+
+// xmlText joins the character data of the text elements, every block element
+// ends with the new line.
+func xmlText(f *zip.File, textElem, blockElem string) (string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	buf := bytes.Buffer{}
+	inText := false
+
+	dec := xml.NewDecoder(io.LimitReader(rc, maxTextSize*8))
+	for buf.Len() < maxTextSize {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			inText = t.Name.Local == textElem
+		case xml.EndElement:
+			inText = false
+			if t.Name.Local == blockElem {
+				buf.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				buf.Write(t)
+			}
+		}
+	}
+
+	return cleanText(buf.Bytes()), nil
+}
+
+// sheetValues returns the values of the cells which are not shared strings.
+func sheetValues(f *zip.File) (string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	values := []string{}
+	shared, inValue := false, false
+
+	dec := xml.NewDecoder(io.LimitReader(rc, maxTextSize*8))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "c":
+				shared = false
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "t" && attr.Value == "s" {
+						shared = true
+					}
+				}
+			case "v", "t":
+				inValue = !shared
+			}
+		case xml.EndElement:
+			inValue = false
+		case xml.CharData:
+			if inValue {
+				values = append(values, sheetValue(string(t)))
+			}
+		}
+	}
+
+	return strings.Join(values, " "), nil
+}
+
+// sheetValue drops the exponent excel uses for long numbers, so the INN or
+// the account number is found as typed.
+func sheetValue(v string) string {
+	if !strings.ContainsAny(v, "Ee") {
+		return v
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return v
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// cleanText makes the text storable: valid utf-8 without NUL bytes and not
+// longer than maxTextSize.
+func cleanText(data []byte) string {
+	if len(data) > maxTextSize {
+		data = data[:maxTextSize]
+	}
+
+	text := strings.ToValidUTF8(string(data), "")
+
+	return strings.ReplaceAll(text, "\x00", "")
+}
@@ -0,0 +1,125 @@
+package s3
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeZip(t *testing.T, name string, files map[string]string) string {
+	t.Helper()
+
+	src := filepath.Join(t.TempDir(), name)
+
+	f, err := os.Create(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return src
+}
+
+func TestTextKind(t *testing.T) {
+	tests := []struct {
+		mime       string
+		objectName string
+		want       string
+	}{
+		{"application/pdf", "a.pdf", textKindPDF},
+		{mimeDOCX, "a.docx", textKindDOCX},
+		{"application/zip", "a.DOCX", textKindDOCX},
+		{mimeXLSX, "a.xlsx", textKindXLSX},
+		{"application/zip", "a.xlsx", textKindXLSX},
+		{"application/zip", "a.zip", ""},
+		{"text/plain; charset=utf-8", "a.txt", textKindPlain},
+		{"image/png", "a.png", ""},
+	}
+
+	for _, tt := range tests {
+		if got := textKind(tt.mime, tt.objectName); got != tt.want {
+			t.Errorf("textKind(%q, %q) = %q, want %q", tt.mime, tt.objectName, got, tt.want)
+		}
+	}
+}
+
+func TestPreviewerTextDOCX(t *testing.T) {
+	src := writeZip(t, "a.docx", map[string]string{
+		"word/document.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>Договор поставки</w:t></w:r></w:p>
+<w:p><w:r><w:t xml:space="preserve">ИНН </w:t></w:r><w:r><w:t>7707083893</w:t></w:r></w:p>
+</w:body></w:document>`,
+	})
+
+	text, err := previewer{}.text(context.Background(), src, textKindDOCX)
+	if err != nil {
+		t.Fatalf("text() error = %v", err)
+	}
+
+	if text != "Договор поставки\nИНН 7707083893\n" {
+		t.Errorf("text() = %q", text)
+	}
+}
+
+func TestPreviewerTextXLSX(t *testing.T) {
+	src := writeZip(t, "a.xlsx", map[string]string{
+		"xl/sharedStrings.xml": `<sst><si><t>Контрагент</t></si><si><r><t>ООО </t></r><r><t>Ромашка</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row>
+<c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c>
+<c r="C1"><v>7.707083893E9</v></c><c r="D1" t="inlineStr"><is><t>КПП</t></is></c>
+</row></sheetData></worksheet>`,
+	})
+
+	text, err := previewer{}.text(context.Background(), src, textKindXLSX)
+	if err != nil {
+		t.Fatalf("text() error = %v", err)
+	}
+
+	for _, want := range []string{"Контрагент", "ООО Ромашка", "7707083893", "КПП"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text() = %q, has no %q", text, want)
+		}
+	}
+
+	if strings.Contains(text, "0 1") {
+		t.Errorf("text() has shared string indexes: %q", text)
+	}
+}
+
+func TestPreviewerTextPlain(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "a.txt")
+
+	err := os.WriteFile(src, []byte("счет\x00 \xffоплачен"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text, err := previewer{}.text(context.Background(), src, textKindPlain)
+	if err != nil {
+		t.Fatalf("text() error = %v", err)
+	}
+
+	if text != "счет оплачен" {
+		t.Errorf("text() = %q", text)
+	}
+}
@@ -0,0 +1,172 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// objectFederation is the federation of the object, the private objects are
+// stored under the federation folder.
+func objectFederation(objectName string) (uuid.UUID, bool) {
+	folder, _, found := strings.Cut(objectName, "/")
+	if !found {
+		return uuid.Nil, false
+	}
+
+	uid, err := uuid.Parse(folder)
+
+	return uid, err == nil
+}
+
+func quotaErr(used, size, quota int64) error {
+	return fmt.Errorf("%w: занято %s из %s, файл %s", domain.ErrStorageQuotaExceeded, megabytes(used), megabytes(quota), megabytes(size))
+}
+
+func megabytes(n int64) string {
+	return fmt.Sprintf("%.1f МБ", float64(n)/(1<<20))
+}
+
+// reserve counts the file in the usage of its federation before it is stored,
+// the file over the quota is rejected.
+func (s3 *ServicePrivate) reserve(file File) error {
+	federationUUID, ok := objectFederation(file.ObjectName)
+	if !ok {
+		return nil
+	}
+
+	ok, err := s3.repo.ReserveUsage(federationUUID, file.Size, s3.quota)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		return nil
+	}
+
+	usage, err := s3.repo.GetUsage(federationUUID)
+	if err != nil {
+		return err
+	}
+
+	return quotaErr(usage.Bytes, file.Size, s3.quota)
+}
+
+// release uncounts the files which were not stored or are removed.
+func (s3 *ServicePrivate) release(files ...File) {
+	for federationUUID, items := range lo.GroupBy(files, func(file File) uuid.UUID {
+		uid, _ := objectFederation(file.ObjectName)
+		return uid
+	}) {
+		if federationUUID == uuid.Nil {
+			continue
+		}
+
+		size := lo.SumBy(items, func(file File) int64 {
+			return file.Size
+		})
+
+		err := s3.repo.ReleaseUsage(federationUUID, size, len(items))
+		if err != nil {
+			logrus.WithError(err).WithField("federation_uuid", federationUUID).Error("storage usage release error")
+		}
+	}
+}
+
+// CheckQuota tells in advance whether the file of the size fits the quota of
+// the federation, the resumable uploads are checked before the first chunk.
+func (s3 *ServicePrivate) CheckQuota(federationUUID uuid.UUID, size int64) error {
+	if s3.quota == 0 {
+		return nil
+	}
+
+	usage, err := s3.repo.GetUsage(federationUUID)
+	if err != nil {
+		return err
+	}
+
+	if usage.Bytes+size > s3.quota {
+		return quotaErr(usage.Bytes, size, s3.quota)
+	}
+
+	return nil
+}
+
+// GetUsage returns the usage of the federation with the breakdown by projects
+// and mime types.
+func (s3 *ServicePrivate) GetUsage(federationUUID uuid.UUID) (dm domain.StorageUsage, err error) {
+	usage, err := s3.repo.GetUsage(federationUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	projects, err := s3.repo.GetUsageByProject(federationUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	types, err := s3.repo.GetUsageByType(federationUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	toItem := func(row StorageUsageRow, _ int) domain.StorageUsageItem {
+		return domain.StorageUsageItem{
+			CompanyUUID: row.CompanyUUID,
+			ProjectUUID: row.ProjectUUID,
+			MimeType:    row.MimeType,
+			Bytes:       row.Bytes,
+			Files:       row.Files,
+		}
+	}
+
+	return domain.StorageUsage{
+		FederationUUID: federationUUID,
+		Quota:          s3.quota,
+		Bytes:          usage.Bytes,
+		Files:          usage.Files,
+		Projects:       lo.Map(projects, toItem),
+		Types:          lo.Map(types, toItem),
+	}, nil
+}
+
+// RecomputeUsage counts the objects of the bucket by federations and replaces
+// the usage with them. Previews are not counted as they are not counted on
+// upload.
+func (s3 *ServicePrivate) RecomputeUsage(ctx context.Context) (int, error) {
+	objects, err := s3.storage.ListObjects(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	usages := map[uuid.UUID]*StorageUsage{}
+
+	for _, obj := range objects {
+		federationUUID, ok := objectFederation(obj.Name)
+		if !ok || strings.HasSuffix(obj.Name, previewSuffix) {
+			continue
+		}
+
+		usage, ok := usages[federationUUID]
+		if !ok {
+			usage = &StorageUsage{FederationUUID: federationUUID, UpdatedAt: now}
+			usages[federationUUID] = usage
+		}
+
+		usage.Bytes += obj.Size
+		usage.Files++
+	}
+
+	items := lo.MapToSlice(usages, func(_ uuid.UUID, usage *StorageUsage) StorageUsage {
+		return *usage
+	})
+
+	return len(items), s3.repo.ReplaceUsage(items)
+}
@@ -0,0 +1,37 @@
+package s3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestObjectFederation(t *testing.T) {
+	federationUUID := uuid.New()
+
+	uid, ok := objectFederation(federationUUID.String() + "/task/" + uuid.NewString() + "/a.pdf")
+	if !ok || uid != federationUUID {
+		t.Errorf("objectFederation() = %v %v", uid, ok)
+	}
+
+	for _, name := range []string{"photos-1.jpg", "tmp/a.pdf", ""} {
+		if _, ok = objectFederation(name); ok {
+			t.Errorf("objectFederation(%q) found the federation", name)
+		}
+	}
+}
+
+func TestQuotaErr(t *testing.T) {
+	err := quotaErr(9<<20, 3<<20, 10<<20)
+
+	if !errors.Is(err, domain.ErrStorageQuotaExceeded) {
+		t.Errorf("quotaErr() = %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "занято 9.0 МБ из 10.0 МБ, файл 3.0 МБ") {
+		t.Errorf("quotaErr() message = %q", err)
+	}
+}
@@ -111,7 +111,7 @@ func Seed(opt *configs.Configs) {
 
 	print(".")
 
-	items, t, err := a.CatalogService.GetData(
+	items, t, _, err := a.CatalogService.GetData(
 		dto.CatalogSearchDTO{
 			CatalogUUID: companiesCatalog.UUID,
 			Offset:      helpers.Ptr(0),
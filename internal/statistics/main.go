@@ -0,0 +1,131 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/federation"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repo *Repository
+	fs   *federation.Service
+	ts   *task.Service
+}
+
+func New(repo *Repository, fs *federation.Service, ts *task.Service) *Service {
+	return &Service{
+		repo: repo,
+		fs:   fs,
+		ts:   ts,
+	}
+}
+
+// Snapshot stores the current statistic of every project as the day. A
+// failed project does not stop the others, the failures are reported after.
+func (s *Service) Snapshot(_ context.Context, day time.Time) (n int, err error) {
+	projects, err := s.repo.GetProjects(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	failed := 0
+
+	for _, p := range projects {
+		stat, fields, err := s.fs.GetProjectStatistic(p.CompanyUUID, p.UUID)
+		if err != nil {
+			logrus.WithField("project_uuid", p.UUID).Error("statistic snapshot error: ", err)
+			continue
+		}
+
+		err = s.repo.SaveSnapshots([]domain.ProjectStatisticSnapshot{{
+			ProjectUUID:        p.UUID,
+			Day:                day,
+			TasksTotal:         stat.TasksTotal,
+			TasksActiveTotal:   stat.TasksActiveTotal,
+			TasksFinishedTotal: stat.TasksFinishedTotal,
+			TasksCanceledTotal: stat.TasksCanceledTotal,
+			TasksDeletedTotal:  stat.TasksDeletedTotal,
+			FieldStatistics: lo.Map(fields, func(item dto.FieldStatistics, _ int) domain.FieldStatistic {
+				return domain.FieldStatistic{
+					Hash:   item.Hash,
+					Name:   item.Name,
+					Count:  item.Count,
+					Total:  item.Total,
+					Filled: item.Filled,
+				}
+			}),
+		}}, true)
+		if err != nil {
+			logrus.WithField("project_uuid", p.UUID).Error("statistic snapshot save error: ", err)
+			failed++
+			continue
+		}
+
+		n++
+	}
+
+	if failed > 0 {
+		return n, fmt.Errorf("статистика не сохранена для %d проектов", failed)
+	}
+
+	return n, nil
+}
+
+// Backfill rebuilds counters of the project, or of all projects when
+// projectUUID is nil, for the days from the project creation (but not before
+// from) up to to.
+func (s *Service) Backfill(ctx context.Context, projectUUID *uuid.UUID, from, to time.Time) (n int, err error) {
+	if from.After(to) {
+		return 0, errors.New("начало периода позже окончания")
+	}
+
+	projects, err := s.repo.GetProjects(projectUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range projects {
+		tasks, err := s.ts.GetTasksHistory(ctx, p.UUID)
+		if err != nil {
+			return n, err
+		}
+
+		start := from
+		if p.CreatedAt.After(start) {
+			start = p.CreatedAt
+		}
+
+		items := domain.BuildStatisticHistory(p.UUID, tasks, start.In(to.Location()), to)
+
+		err = s.repo.SaveSnapshots(items, false)
+		if err != nil {
+			return n, err
+		}
+
+		logrus.WithField("project_uuid", p.UUID).Infof("statistic backfilled for %d days", len(items))
+		n += len(items)
+	}
+
+	return n, nil
+}
+
+func (s *Service) GetSnapshots(_ context.Context, projectUUID uuid.UUID, from, to time.Time) ([]domain.ProjectStatisticSnapshot, error) {
+	if from.After(to) {
+		return nil, errors.New("начало периода позже окончания")
+	}
+
+	if to.Sub(from) > time.Hour*24*domain.AnalyticsMaxDays {
+		return nil, errors.New("период не может быть больше года")
+	}
+
+	return s.repo.GetSnapshots(projectUUID, from, to)
+}
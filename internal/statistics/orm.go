@@ -0,0 +1,53 @@
+package statistics
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+)
+
+type ProjectStatisticSnapshot struct {
+	ProjectUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	Day         time.Time `gorm:"type:date;not null;primary_key:true"`
+
+	TasksTotal         int `gorm:"type:integer;default:0;not null"`
+	TasksActiveTotal   int `gorm:"type:integer;default:0;not null"`
+	TasksFinishedTotal int `gorm:"type:integer;default:0;not null"`
+	TasksCanceledTotal int `gorm:"type:integer;default:0;not null"`
+	TasksDeletedTotal  int `gorm:"type:integer;default:0;not null"`
+
+	FieldStatistics datatypes.JSON `gorm:"type:jsonb;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (o ProjectStatisticSnapshot) toDomain() domain.ProjectStatisticSnapshot {
+	dm := domain.ProjectStatisticSnapshot{
+		ProjectUUID:        o.ProjectUUID,
+		Day:                o.Day,
+		TasksTotal:         o.TasksTotal,
+		TasksActiveTotal:   o.TasksActiveTotal,
+		TasksFinishedTotal: o.TasksFinishedTotal,
+		TasksCanceledTotal: o.TasksCanceledTotal,
+		TasksDeletedTotal:  o.TasksDeletedTotal,
+	}
+
+	if len(o.FieldStatistics) > 0 {
+		err := json.Unmarshal(o.FieldStatistics, &dm.FieldStatistics)
+		if err != nil {
+			logrus.Error("[module:statistics] field statistics unmarshal: ", err)
+		}
+	}
+
+	return dm
+}
+
+type Project struct {
+	UUID        uuid.UUID
+	CompanyUUID uuid.UUID
+	CreatedAt   time.Time
+}
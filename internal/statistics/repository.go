@@ -0,0 +1,100 @@
+package statistics
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+// GetProjects returns active projects, all of them when uid is nil.
+func (r *Repository) GetProjects(uid *uuid.UUID) (orms []Project, err error) {
+	query := r.gorm.DB.
+		Table("projects").
+		Select("uuid, company_uuid, created_at").
+		Where("deleted_at is null")
+
+	if uid != nil {
+		query = query.Where("uuid = ?", *uid)
+	}
+
+	err = query.Order("created_at").Scan(&orms).Error
+
+	return orms, err
+}
+
+// SaveSnapshots upserts the days. Without fields the stored field statistics
+// are kept, so the backfill does not erase what the nightly job collected.
+func (r *Repository) SaveSnapshots(dms []domain.ProjectStatisticSnapshot, withFields bool) error {
+	if len(dms) == 0 {
+		return nil
+	}
+
+	orms := []ProjectStatisticSnapshot{}
+
+	for _, dm := range dms {
+		orm := ProjectStatisticSnapshot{
+			ProjectUUID:        dm.ProjectUUID,
+			Day:                domain.Day(dm.Day),
+			TasksTotal:         dm.TasksTotal,
+			TasksActiveTotal:   dm.TasksActiveTotal,
+			TasksFinishedTotal: dm.TasksFinishedTotal,
+			TasksCanceledTotal: dm.TasksCanceledTotal,
+			TasksDeletedTotal:  dm.TasksDeletedTotal,
+			CreatedAt:          time.Now(),
+		}
+
+		if withFields {
+			js, err := json.Marshal(dm.FieldStatistics)
+			if err != nil {
+				return err
+			}
+
+			orm.FieldStatistics = js
+		}
+
+		orms = append(orms, orm)
+	}
+
+	columns := []string{"tasks_total", "tasks_active_total", "tasks_finished_total", "tasks_canceled_total", "tasks_deleted_total", "created_at"}
+	if withFields {
+		columns = append(columns, "field_statistics")
+	}
+
+	return r.gorm.DB.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_uuid"}, {Name: "day"}},
+			DoUpdates: clause.AssignmentColumns(columns),
+		}).
+		CreateInBatches(&orms, 500).Error
+}
+
+func (r *Repository) GetSnapshots(projectUUID uuid.UUID, from, to time.Time) (dms []domain.ProjectStatisticSnapshot, err error) {
+	orms := []ProjectStatisticSnapshot{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("day between ? and ?", domain.Day(from), domain.Day(to)).
+		Order("day").
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item ProjectStatisticSnapshot, _ int) domain.ProjectStatisticSnapshot {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
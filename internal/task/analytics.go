@@ -0,0 +1,33 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// GetAnalytics computes flow metrics of the project tasks the viewer can
+// see, nil viewerEmail counts all of them.
+func (s *Service) GetAnalytics(ctx context.Context, filter domain.AnalyticsFilter, viewerEmail *string) (dm domain.Analytics, err error) {
+	if filter.From.After(filter.To) {
+		return dm, errors.New("начало периода позже окончания")
+	}
+
+	if filter.To.Sub(filter.From) > time.Hour*24*domain.AnalyticsMaxDays {
+		return dm, errors.New("период не может быть больше года")
+	}
+
+	tasks, err := s.repo.GetAnalyticsTasks(ctx, filter, viewerEmail)
+	if err != nil {
+		return dm, err
+	}
+
+	return domain.BuildAnalytics(tasks, filter.From, filter.To, time.Now()), nil
+}
+
+func (s *Service) GetTasksHistory(ctx context.Context, projectUUID uuid.UUID) ([]domain.Task, error) {
+	return s.repo.GetTasksHistory(ctx, projectUUID)
+}
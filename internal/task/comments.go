@@ -2,10 +2,13 @@ package task
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
 func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Comment) (err error) {
@@ -28,6 +31,8 @@ func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 		return err
 	}
 
+	s.CommentWasCreated(task, cm)
+
 	return nil
 }
 
@@ -60,7 +65,7 @@ func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUI
 		return err
 	}
 
-	err = s.commentService.DeleteComment(ctx, task.UUID, comentUID, nil)
+	err = s.commentService.DeleteComment(ctx, task.UUID, comentUID, deletedBy)
 	if err != nil {
 		return err
 	}
@@ -76,3 +81,147 @@ func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUI
 
 	return nil
 }
+
+// ReactComment toggles the reaction of the user, the author of the comment
+// is notified about new reactions.
+func (s *Service) ReactComment(ctx context.Context, taskUID, commentUID uuid.UUID, email, emoji string) (reactions []domain.Reaction, added bool, err error) {
+	cm, err := s.getTaskComment(ctx, taskUID, commentUID)
+	if err != nil {
+		return reactions, added, err
+	}
+
+	reactions, added, err = s.commentService.ReactComment(ctx, commentUID, email, emoji)
+	if err != nil {
+		return reactions, added, err
+	}
+
+	s.ResetCache(taskUID)
+
+	if added && cm.CreatedBy != email {
+		err = s.TaskWasUpdatedOrCreated(taskUID, []string{cm.CreatedBy})
+	}
+
+	return reactions, added, err
+}
+
+// VotePoll replaces the vote of the user, the author of the poll is
+// notified.
+func (s *Service) VotePoll(ctx context.Context, taskUID, commentUID uuid.UUID, email string, options []int) (cm domain.Comment, err error) {
+	_, err = s.getTaskComment(ctx, taskUID, commentUID)
+	if err != nil {
+		return cm, err
+	}
+
+	cm, err = s.commentService.VotePoll(ctx, commentUID, email, options)
+	if err != nil {
+		return cm, err
+	}
+
+	s.ResetCache(taskUID)
+
+	if cm.CreatedBy != email {
+		err = s.TaskWasUpdatedOrCreated(taskUID, []string{cm.CreatedBy})
+	}
+
+	return cm, err
+}
+
+// ConvertCommentToTask creates a child task of the comment's task. The text
+// becomes the description, mentioned people become co-workers and the files
+// of the comment are copied. The comment and the task are linked both ways.
+func (s *Service) ConvertCommentToTask(ctx context.Context, crtr domain.Creator, taskUID, commentUID uuid.UUID, name string) (child domain.Task, err error) {
+	parent, err := s.GetTask(ctx, taskUID, []string{})
+	if err != nil {
+		return child, err
+	}
+
+	cm, err := s.getTaskComment(ctx, taskUID, commentUID)
+	if err != nil {
+		return child, err
+	}
+
+	if cm.ConvertedTaskUUID != nil {
+		return child, errors.New("комментарий уже преобразован в задачу")
+	}
+
+	if name == "" {
+		name = cm.TaskName()
+	}
+
+	child, err = domain.NewTask(
+		name,
+		parent.FederationUUID,
+		parent.CompanyUUID,
+		parent.ProjectUUID,
+		crtr.Email,
+		nil,
+		[]string{},
+
+		cm.Comment,
+		parent.Path,
+		cm.Mentioned(),
+		"",
+		"",
+
+		parent.Priority,
+
+		nil,
+		"",
+		"",
+
+		nil,
+	)
+	if err != nil {
+		return child, err
+	}
+
+	child.SourceCommentUUID = &cm.UUID
+
+	child.ID, err = s.CreateTask(child)
+	if err != nil {
+		return child, err
+	}
+
+	// the concurrent conversion has linked its own task, this one is undone
+	err = s.commentService.LinkTask(cm.UUID, child.UUID)
+	if err != nil {
+		if errDelete := s.repo.DeleteTask(child.UUID); errDelete != nil {
+			logrus.WithError(errDelete).WithField("task_uuid", child.UUID).Error("DeleteTask error")
+		}
+
+		return child, err
+	}
+
+	s.ResetCache(parent.UUID)
+
+	// the comment is converted already, the files which are not copied are
+	// left in the comment
+	files, err := s.storage.GetCommentFiles(cm.UUID, false)
+	if err != nil {
+		logrus.WithError(err).WithField("comment_uuid", cm.UUID).Error("GetCommentFiles error")
+	}
+
+	for _, file := range files {
+		_, err = s.storage.CopyFileToTask(parent.FederationUUID, child.UUID, file.UUID, crtr.UUID)
+		if err != nil {
+			logrus.WithError(err).WithField("file_uuid", file.UUID).WithField("task_uuid", child.UUID).Error("CopyFileToTask error")
+		}
+	}
+
+	err = s.as.TaskCommentWasConverted(crtr, parent, child, cm.UUID)
+
+	return child, err
+}
+
+func (s *Service) getTaskComment(ctx context.Context, taskUID, commentUID uuid.UUID) (cm domain.Comment, err error) {
+	cm, err = s.commentService.GetComment(ctx, commentUID)
+	if err != nil {
+		return cm, err
+	}
+
+	if cm.TaskUUID != taskUID {
+		return cm, dto.NotFoundErr("комментарий не найден")
+	}
+
+	return cm, nil
+}
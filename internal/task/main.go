@@ -37,6 +37,10 @@ type Service struct {
 
 	onTaskUpdatedOrCreated func(uuid.UUID, []string) error
 	onOpenTask             func(uuid.UUID, string) error
+	onTaskEvent            func(domain.WebhookEvent, domain.Task) error
+	onCommentCreated       func(domain.Task, domain.Comment) error
+	onStatusChanged        func(domain.Task) error
+	onStatusChange         func(domain.Task, int) error
 }
 
 func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps *profile.Service, cs *comments.Service, storage *s3.ServicePrivate) *Service {
@@ -68,6 +72,67 @@ func (s *Service) TaskWasUpdatedOrCreated(uid uuid.UUID, people []string) error
 	return nil
 }
 
+// TaskEvent reports a typed task event (created, status changed...) to the
+// subscriber. Errors are logged only: the event must not break the change.
+func (s *Service) TaskEvent(event domain.WebhookEvent, task domain.Task) {
+	if s.onTaskEvent == nil {
+		return
+	}
+
+	err := s.onTaskEvent(event, task)
+	if err != nil {
+		logrus.WithError(err).WithField("event", event).Error("TaskEvent error")
+	}
+}
+
+func (s *Service) TaskEventByUUID(event domain.WebhookEvent, uid uuid.UUID) {
+	if s.onTaskEvent == nil {
+		return
+	}
+
+	task, err := s.repo.GetTask(context.Background(), uid)
+	if err != nil {
+		logrus.WithError(err).WithField("event", event).Error("TaskEvent error")
+		return
+	}
+
+	s.TaskEvent(event, task)
+}
+
+// CheckStatusChange asks the subscriber whether the task may be moved to the
+// status, its error rejects the move.
+func (s *Service) CheckStatusChange(task domain.Task, status int) error {
+	if s.onStatusChange == nil {
+		return nil
+	}
+
+	return s.onStatusChange(task, status)
+}
+
+// StatusChanged reports the new status of the task to the subscriber, errors
+// are logged only.
+func (s *Service) StatusChanged(task domain.Task) {
+	if s.onStatusChanged == nil {
+		return
+	}
+
+	err := s.onStatusChanged(task)
+	if err != nil {
+		logrus.WithError(err).WithField("task_uuid", task.UUID).Error("StatusChanged error")
+	}
+}
+
+func (s *Service) CommentWasCreated(task domain.Task, cm domain.Comment) {
+	if s.onCommentCreated == nil {
+		return
+	}
+
+	err := s.onCommentCreated(task, cm)
+	if err != nil {
+		logrus.WithError(err).Error("CommentWasCreated error")
+	}
+}
+
 func (s *Service) TaskWasOpen(uid uuid.UUID, email string) error {
 	if s.onOpenTask != nil {
 		return s.onOpenTask(uid, email)
@@ -110,6 +175,8 @@ func (s *Service) CreateTask(task domain.Task) (id int, err error) {
 		if err != nil {
 			logrus.Error("TaskWasUpdatedOrCreated error: ", err)
 		}
+
+		s.TaskEvent(domain.WebhookEventTaskCreated, task)
 	}
 
 	return orm.ID, err
@@ -151,6 +218,8 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 		if err != nil {
 			logrus.Error("TaskWasUpdatedOrCreated error: ", err)
 		}
+
+		s.TaskEvent(domain.WebhookEventTaskUpdated, task)
 	}
 
 	for _, field := range shouldUpdate {
@@ -398,6 +467,8 @@ func (s *Service) CreateTaskBatch(updaterEmail string, tasks []domain.Task) (err
 			if err != nil {
 				return err
 			}
+
+			s.TaskEvent(domain.WebhookEventTaskCreated, task)
 		}
 	}
 
@@ -464,6 +535,10 @@ func (s *Service) GetTaskGetTaskWithDeleted(ctx context.Context, uid uuid.UUID)
 	return dm, err
 }
 
+func (s *Service) GetTaskUUIDByID(projectUUID uuid.UUID, id int) (uuid.UUID, bool, error) {
+	return s.repo.GetTaskUUIDByID(projectUUID, id)
+}
+
 func (s *Service) GetTask(ctx context.Context, uid uuid.UUID, fields []string) (dm domain.Task, err error) {
 	dm, err = s.repo.GetTask(ctx, uid)
 	if err != nil {
@@ -479,7 +554,7 @@ func (s *Service) GetTask(ctx context.Context, uid uuid.UUID, fields []string) (
 
 	if len(fields) > 0 {
 		if lo.IndexOf(fields, "activities") != -1 {
-			actvts, total, err := s.as.GetTaskActivities(uid, 200, 0)
+			actvts, total, _, err := s.as.GetTaskActivities(uid, 200, 0, nil, false)
 			if err != nil {
 				return dm, err
 			}
@@ -493,45 +568,64 @@ func (s *Service) GetTask(ctx context.Context, uid uuid.UUID, fields []string) (
 	return dm, err
 }
 
-func (s *Service) GetActivities(taskUUID uuid.UUID, limit, offset int) (dms []domain.Activity, total int64, err error) {
-	dms, total, err = s.as.GetTaskActivities(taskUUID, limit, offset)
-
-	return dms, total, err
+func (s *Service) GetActivities(taskUUID uuid.UUID, limit, offset int, cursor *string, withTotal bool) (dms []domain.Activity, total int64, next string, err error) {
+	return s.as.GetTaskActivities(taskUUID, limit, offset, cursor, withTotal)
 }
 
-func (s *Service) GetTasksNames(ctx context.Context, uid []uuid.UUID) (taskWithName []domain.Task, err error) {
+// GetTasksNames returns names of the tasks the viewer can see.
+func (s *Service) GetTasksNames(ctx context.Context, uid []uuid.UUID, viewerEmail string) (taskWithName []domain.Task, err error) {
 	if len(uid) == 0 {
 		return taskWithName, nil
 	}
 
-	return s.repo.GetTaskNames(ctx, uid)
+	return s.repo.GetTaskNames(ctx, uid, viewerEmail)
 }
 
-func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, err error) {
+func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, next string, err error) {
 	allowSort := s.GetSortFields(filter.ProjectUUID)
 
-	dm, total, err = s.repo.GetTasks(ctx, filter, allowSort)
+	dm, total, next, err = s.repo.GetTasks(ctx, filter, allowSort)
 	if err != nil {
-		return dm, -1, err
+		return dm, -1, next, err
+	}
+
+	return dm, total, next, err
+}
+
+func (s *Service) GetOpenTasks(ctx context.Context, federationUUID uuid.UUID, projectUUID *uuid.UUID) ([]domain.Task, error) {
+	return s.repo.GetOpenTasks(ctx, federationUUID, projectUUID)
+}
+
+func (s *Service) GetDueTasks(ctx context.Context, federationUUIDs []uuid.UUID, email string, before time.Time) ([]domain.Task, error) {
+	if len(federationUUIDs) == 0 {
+		return []domain.Task{}, nil
+	}
+
+	return s.repo.GetDueTasks(ctx, federationUUIDs, email, before)
+}
+
+func (s *Service) GetOpenedTasks(ctx context.Context, federationUUIDs []uuid.UUID, userUUID uuid.UUID, email string, limit int) ([]domain.OpenedTask, error) {
+	if len(federationUUIDs) == 0 {
+		return []domain.OpenedTask{}, nil
 	}
 
-	return dm, total, err
+	return s.repo.GetOpenedTasks(ctx, federationUUIDs, userUUID, email, limit)
 }
 
-func (s *Service) GetTasksDto(ctx context.Context, filter dto.TaskSearchDTO) (dtos []dto.TaskDTOs, total int64, err error) {
-	dms, total, err := s.GetTasks(ctx, filter)
+func (s *Service) GetTasksDto(ctx context.Context, filter dto.TaskSearchDTO) (dtos []dto.TaskDTOs, total int64, next string, err error) {
+	dms, total, next, err := s.GetTasks(ctx, filter)
 	dtos = []dto.TaskDTOs{}
 
 	for _, dm := range dms {
 		d, err := dto.NewTaskDTOs(dm, s.dict), err
 		if err != nil {
-			return dtos, -1, err
+			return dtos, -1, next, err
 		}
 
 		dtos = append(dtos, d)
 	}
 
-	return dtos, total, err
+	return dtos, total, next, err
 }
 
 func (s *Service) ConvertToDto(dm domain.Task) (d dto.TaskDTO, err error) {
@@ -576,6 +670,8 @@ func (s *Service) PatchProject(crt domain.Creator, task domain.Task, project dto
 		return err
 	}
 
+	s.TaskEventByUUID(domain.WebhookEventTaskUpdated, task.UUID)
+
 	return err
 }
 
@@ -630,6 +726,11 @@ func (s *Service) PatchTaskParent(ctx context.Context, uid uuid.UUID, parentUUID
 		}
 	}
 
+	// the task left the subtrees of its old ancestors
+	s.repo.cache.ClearEpicProgress(ctx, stringsToUUIDs(task.Path))
+
+	s.TaskEventByUUID(domain.WebhookEventTaskUpdated, task.UUID)
+
 	// if parentLvl+len(task.Path) > 5 {
 	// 	// @todo: fix
 	// 	//return fmt.Errorf("вложенность не может быть больше 5. %v + %v > 5", parentLvl, len(task.Path))
@@ -680,6 +781,8 @@ func (s *Service) PatchName(crt domain.Creator, uid uuid.UUID, name string) (err
 		if err != nil {
 			return err
 		}
+
+		s.TaskEvent(domain.WebhookEventTaskUpdated, task)
 	}
 
 	_, err = s.as.TaskWasChangedActivity(crt, task.UUID, "name", task.Name, task.Dirty["name"])
@@ -705,6 +808,11 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 		}
 	}
 
+	err = s.CheckStatusChange(task, status)
+	if err != nil {
+		return stopUUID, path, err
+	}
+
 	sg, err := domain.NewStatusGraphFromMap(*project.StatusGraph)
 	if err != nil {
 		return stopUUID, path, err
@@ -758,6 +866,9 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 		if err != nil {
 			return stopUUID, path, err
 		}
+
+		s.StatusChanged(task)
+		s.TaskEvent(domain.WebhookEventTaskStatusChanged, task)
 	}
 
 	//
@@ -975,6 +1086,8 @@ func (s *Service) PatchTeam(ctx context.Context, crtr domain.Creator, uid uuid.U
 		return err
 	}
 
+	s.TaskEventByUUID(domain.WebhookEventTaskUpdated, task.UUID)
+
 	return err
 }
 
@@ -1010,6 +1123,8 @@ func (s *Service) DeleteTask(crt domain.Creator, uid uuid.UUID) (err error) {
 		return err
 	}
 
+	s.TaskEvent(domain.WebhookEventTaskDeleted, t)
+
 	for _, file := range files {
 		err := s.storage.Delete(file.UUID)
 		if err != nil {
@@ -1055,7 +1170,7 @@ func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUI
 }
 
 func (s *Service) ResetCache(uid uuid.UUID) {
-	s.repo.cache.ClearTask(context.TODO(), uid)
+	s.repo.ResetCache(uid)
 }
 
 func (s *Service) GetSortFields(projectUUID uuid.UUID) []string {
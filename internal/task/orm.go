@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/lib/pq"
 	"gorm.io/datatypes"
 )
@@ -26,6 +27,10 @@ type Task struct {
 	WatchBy     pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
 	AllPeople   pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
 
+	Visibility   string         `gorm:"type:varchar(20);default:'project';not null"`
+	AccessUsers  pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+	AccessGroups pq.StringArray `gorm:"type:uuid[];default:'{}';not null;"`
+
 	Tags pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
 
 	FederationUUID uuid.UUID `gorm:"type:uuid;not null" order:""`
@@ -40,11 +45,16 @@ type Task struct {
 	ChildrensTotal int            `gorm:"type:int;default:0;not null;" order:""`
 	ChildrensUUID  pq.StringArray `gorm:"type:uuid[];default:'{}';not null;"`
 
+	SourceCommentUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	VisibleChildrensTotal int `gorm:"->"`
+
 	CommentsTotal int `gorm:"type:int;default:0;not null;" order:""`
 
 	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 	FinishedAt *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
 	FinishTo   *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
+	StartAt    *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
 	ActivityAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 
 	Duration int `gorm:"type:int;default:0;not null"`
@@ -58,7 +68,8 @@ type Task struct {
 
 	Path string `gorm:"type:ltree;default:'';"`
 
-	Total int64 `gorm:"->"`
+	Total     int64  `gorm:"->"`
+	CursorKey string `gorm:"->"`
 
 	TaskEntities TE    `gorm:"type:jsonb;default:'{}';not null;"`
 	Stops        Stops `gorm:"type:jsonb;default:'[]';not null;"`
@@ -139,3 +150,21 @@ type CompanyFields struct {
 	DataType    int    `gorm:"type:int;not null;default:0"`
 	CompanyUUID string `gorm:"type:uuid;not null"`
 }
+
+type TaskDependency struct {
+	TaskUUID      uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	DependsOnUUID uuid.UUID `gorm:"type:uuid;not null;primary_key:true"`
+	ProjectUUID   uuid.UUID `gorm:"type:uuid;not null"`
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedAt     time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+func (o TaskDependency) toDomain() domain.TaskDependency {
+	return domain.TaskDependency{
+		TaskUUID:      o.TaskUUID,
+		DependsOnUUID: o.DependsOnUUID,
+		ProjectUUID:   o.ProjectUUID,
+		CreatedBy:     o.CreatedBy,
+		CreatedAt:     o.CreatedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// GetEpicProgress rolls up the subtree of the task the viewer can see. The
+// result is cached per viewer until any task of the subtree changes, see
+// Repository.ResetCache.
+func (s *Service) GetEpicProgress(ctx context.Context, uid uuid.UUID, viewer, weightField string) (dm domain.EpicProgress, err error) {
+	dm, found := s.repo.cache.GetEpicProgress(ctx, uid, viewer, weightField)
+	if found {
+		return dm, nil
+	}
+
+	epic, err := s.repo.GetTask(ctx, uid)
+	if err != nil {
+		return dm, err
+	}
+
+	descendants, err := s.repo.GetSubtreeTasks(ctx, uid)
+	if err != nil {
+		return dm, err
+	}
+
+	visible, err := s.repo.GetVisibleTaskUUIDs(lo.Map(descendants, func(item domain.Task, _ int) uuid.UUID {
+		return item.UUID
+	}), viewer)
+	if err != nil {
+		return dm, err
+	}
+
+	descendants = lo.Filter(descendants, func(item domain.Task, _ int) bool {
+		return lo.Contains(visible, item.UUID)
+	})
+
+	dm = domain.BuildEpicProgress(epic, descendants, weightField, time.Now())
+
+	s.repo.cache.CacheEpicProgress(ctx, viewer, dm)
+
+	return dm, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -16,10 +17,12 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -65,6 +68,10 @@ func (r *Repository) CreateTask(task domain.Task, batch bool) (orm *Task, err er
 		Icon:      task.Icon,
 		AllPeople: task.People,
 
+		Visibility:   task.Visibility,
+		AccessUsers:  task.AccessUsers,
+		AccessGroups: uuidsToStrings(task.AccessGroups),
+
 		Path: strings.Join(task.Path, "."),
 
 		Priority: task.Priority,
@@ -72,9 +79,13 @@ func (r *Repository) CreateTask(task domain.Task, batch bool) (orm *Task, err er
 		TaskEntities: task.TaskEntities,
 
 		FinishTo: task.FinishTo,
+		StartAt:  task.StartAt,
+		Duration: task.Duration,
 
 		FirstOpen: task.FirstOpen,
 
+		SourceCommentUUID: task.SourceCommentUUID,
+
 		Description: task.Description,
 	}
 
@@ -303,6 +314,10 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 
 		People: orm.AllPeople,
 
+		Visibility:   orm.Visibility,
+		AccessUsers:  orm.AccessUsers,
+		AccessGroups: stringsToUUIDs(orm.AccessGroups),
+
 		Icon:   orm.Icon,
 		Tags:   orm.Tags,
 		Status: orm.Status,
@@ -329,8 +344,10 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 			}
 		}),
 
+		StartAt:    orm.StartAt,
 		FinishTo:   orm.FinishTo,
 		FinishedAt: orm.FinishedAt,
+		Duration:   orm.Duration,
 
 		FirstOpen: orm.FirstOpen,
 
@@ -338,6 +355,8 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 		ChildrensUUID: lo.Map(orm.ChildrensUUID, func(item string, _ int) uuid.UUID {
 			return uuid.MustParse(item)
 		}),
+
+		SourceCommentUUID: orm.SourceCommentUUID,
 	}
 
 	return dm, nil
@@ -384,6 +403,10 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 
 		People: orm.AllPeople,
 
+		Visibility:   orm.Visibility,
+		AccessUsers:  orm.AccessUsers,
+		AccessGroups: stringsToUUIDs(orm.AccessGroups),
+
 		Icon:   orm.Icon,
 		Tags:   orm.Tags,
 		Status: orm.Status,
@@ -410,8 +433,10 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 			}
 		}),
 
+		StartAt:    orm.StartAt,
 		FinishTo:   orm.FinishTo,
 		FinishedAt: orm.FinishedAt,
+		Duration:   orm.Duration,
 
 		FirstOpen: orm.FirstOpen,
 	}
@@ -419,14 +444,17 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 	return dm, nil
 }
 
-func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID) (taskWithName []domain.Task, err error) {
+func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID, viewerEmail string) (taskWithName []domain.Task, err error) {
 	defer r.storeTime("GetTaskNames", tm())
 
+	where, args := domain.TaskVisibleSQL("tasks", viewerEmail)
+
 	err = r.gorm.DB.
 		Model(&Task{}).
 		Select("uuid, name").
 		Where("uuid in ?", uids).
 		Where("deleted_at is null").
+		Where(where, args...).
 		Find(&taskWithName).
 		Error
 	if err != nil {
@@ -453,29 +481,16 @@ func (r *Repository) GetSortFields() []string {
 	return allowSort
 }
 
-func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allowSort []string) (dms []domain.Task, total int64, err error) {
+// GetTasks returns a page of tasks. Without a cursor the page is taken by
+// offset, otherwise rows after the cursor are returned. next is empty on the
+// last page, total is -1 when a cursor is given without WithTotal.
+func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allowSort []string) (dms []domain.Task, total int64, next string, err error) {
 	defer r.storeTime("GetTasks", tm())
 
 	orms := []Task{}
 
-	query := r.gorm.DB
-
-	if len(allowSort) > 0 && filter.Order != nil {
-		by := "desc"
-		if filter.By != nil && *filter.By == "asc" {
-			by = "asc"
-		}
-
-		if helpers.InArray(*filter.Order, allowSort) {
-			if strings.HasPrefix(*filter.Order, "fields.") {
-				*filter.Order = "fields->>'" + strings.Replace(*filter.Order, "fields.", "", 1) + "'"
-			}
-
-			query = query.Order(*filter.Order + " " + by)
-		}
-	} else {
-		query = query.Order("created_at desc")
-	}
+	query := r.gorm.DB.Model(&Task{})
+	ks := r.taskKeyset(filter, allowSort)
 
 	if filter.Status != nil {
 		query = query.Where("status = ?", *filter.Status)
@@ -506,6 +521,17 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		query = query.Where("? = ANY (all_people)", filter.MyEmail)
 	}
 
+	sel, selArgs := ks.Select(), []interface{}{}
+
+	if filter.ViewerEmail != nil {
+		where, args := domain.TaskVisibleSQL("tasks", *filter.ViewerEmail)
+		query = query.Where(where, args...)
+
+		where, args = domain.TaskVisibleSQL("c", *filter.ViewerEmail)
+		sel += ", (SELECT count(*) FROM tasks c WHERE c.uuid = ANY (tasks.childrens_uuid) AND c.deleted_at IS NULL AND " + where + ") AS visible_childrens_total"
+		selArgs = args
+	}
+
 	if filter.IsEpic != nil {
 		if *filter.IsEpic {
 			query = query.Where("nlevel(path) = 1")
@@ -530,21 +556,35 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		query = query.Where("path ~ ?", *filter.Path)
 	}
 
-	if filter.Limit != nil {
-		query = query.Limit(*filter.Limit)
-	} else {
-		query = query.Limit(5)
-	}
+	query = query.Where("deleted_at is null")
+
+	limit := helpers.Deref(filter.Limit, 5)
+
+	if filter.Cursor != nil {
+		total = -1
+		if filter.WithTotal {
+			err = query.Session(&gorm.Session{}).Count(&total).Error
+			if err != nil {
+				return dms, -1, next, err
+			}
+		}
+
+		c, err := helpers.DecodeCursor(*filter.Cursor)
+		if err != nil {
+			return dms, -1, next, err
+		}
 
-	if filter.Offset != nil {
-		query = query.Offset(*filter.Offset)
+		where, args, err := ks.Where(c)
+		if err != nil {
+			return dms, -1, next, err
+		}
+
+		query = query.Where(where, args...).Select("*, "+sel, selArgs...)
 	} else {
-		query = query.Offset(0)
+		query = query.Offset(helpers.Deref(filter.Offset, 0)).Select("*, count(*) OVER() AS total, "+sel, selArgs...)
 	}
 
-	query = query.Where("deleted_at is null")
-
-	query = query.Select("*, count(*) OVER() AS total")
+	query = query.Order(ks.OrderBy()).Limit(limit + 1)
 
 	sql := query.ToSQL(func(tx *gorm.DB) *gorm.DB {
 		return tx.Find(&orms)
@@ -555,13 +595,23 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 	result := query.Find(&orms)
 
 	if result.Error != nil {
-		return dms, -1, result.Error
+		return dms, -1, next, result.Error
 	}
 
-	if len(orms) > 0 {
+	if len(orms) > 0 && filter.Cursor == nil {
 		total = orms[0].Total
 	}
 
+	if len(orms) > limit {
+		orms = orms[:limit]
+
+		last := orms[len(orms)-1]
+		next, err = ks.Next(last.CursorKey, last.UUID)
+		if err != nil {
+			return dms, total, next, err
+		}
+	}
+
 	dms = helpers.Map(orms, func(item Task, i int) domain.Task {
 		return domain.Task{
 			UUID:           item.UUID,
@@ -581,16 +631,43 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 			Fields:         item.Fields,
 
 			ActivityAt:     item.ActivityAt,
-			ChildrensTotal: item.ChildrensTotal,
+			ChildrensTotal: helpers.If(filter.ViewerEmail != nil, item.VisibleChildrensTotal, item.ChildrensTotal),
+			StartAt:        item.StartAt,
 			FinishTo:       item.FinishTo,
 			FinishedAt:     item.FinishedAt,
+			Duration:       item.Duration,
 
 			CreatedAt: item.CreatedAt,
 			UpdatedAt: item.UpdatedAt,
 		}
 	})
 
-	return dms, total, nil
+	return dms, total, next, nil
+}
+
+// taskKeyset returns the requested task ordering, created_at desc by default.
+func (r *Repository) taskKeyset(filter dto.TaskSearchDTO, allowSort []string) helpers.Keyset {
+	order, by := "created_at", "desc"
+
+	if filter.Order != nil && helpers.InArray(*filter.Order, allowSort) {
+		order = *filter.Order
+
+		if filter.By != nil && *filter.By == "asc" {
+			by = "asc"
+		}
+	}
+
+	column := helpers.KeysetColumn{Expr: order, Type: helpers.ColumnType(Task{}, order)}
+	if strings.HasPrefix(order, "fields.") {
+		column = helpers.KeysetColumn{Expr: "fields->>'" + strings.TrimPrefix(order, "fields.") + "'", Type: "text"}
+	}
+
+	return helpers.Keyset{
+		Name:    order + ":" + by,
+		Columns: []helpers.KeysetColumn{column},
+		UUID:    "uuid",
+		Desc:    by == "desc",
+	}
 }
 
 func (r *Repository) ChangeField(uid uuid.UUID, fieldName string, value interface{}) error {
@@ -746,8 +823,24 @@ func (r *Repository) UpdateTask(task domain.Task, shouldUpdate []string) (err er
 			err = r.ChangeField(task.UUID, "fields", task.Fields)
 		case "finish_to":
 			err = r.ChangeField(task.UUID, "finish_to", task.FinishTo)
+		case "start_at":
+			err = r.ChangeField(task.UUID, "start_at", task.StartAt)
+		case "duration":
+			err = r.ChangeField(task.UUID, "duration", task.Duration)
 		case "description":
 			err = r.ChangeField(task.UUID, "description", task.Description)
+		case "visibility":
+			err = r.gorm.DB.
+				Model(&Task{}).
+				Where("uuid = ?", task.UUID).
+				Updates(map[string]interface{}{
+					"visibility":    task.Visibility,
+					"access_users":  pq.StringArray(task.AccessUsers),
+					"access_groups": uuidsToStrings(task.AccessGroups),
+				}).Error
+			if err == nil {
+				go r.ResetCache(task.UUID)
+			}
 		}
 	}
 
@@ -784,4 +877,432 @@ func (r *Repository) DeleteTask(uid uuid.UUID) (err error) {
 
 func (r *Repository) ResetCache(uid uuid.UUID) {
 	r.cache.ClearTask(context.TODO(), uid)
+	r.resetProgress(uid)
+}
+
+// resetProgress drops cached progress of the task and all its ancestors.
+func (r *Repository) resetProgress(uid uuid.UUID) {
+	orm := Task{}
+
+	err := r.gorm.DB.
+		Select("path").
+		Where("uuid = ?", uid).
+		Take(&orm).Error
+	if err != nil {
+		logrus.Error("[module:task] resetProgress: ", err)
+		return
+	}
+
+	uids := lo.FilterMap(strings.Split(orm.Path, "."), func(item string, _ int) (uuid.UUID, bool) {
+		u, err := uuid.Parse(item)
+		return u, err == nil
+	})
+
+	r.cache.ClearEpicProgress(context.TODO(), append(uids, uid))
+}
+
+// GetSubtreeTasks returns descendants of the task with their stops.
+func (r *Repository) GetSubtreeTasks(_ context.Context, uid uuid.UUID) (dms []domain.Task, err error) {
+	defer r.storeTime("GetSubtreeTasks", tm())
+
+	orms := []Task{}
+
+	err = r.gorm.DB.
+		Select("uuid, path, status, fields, created_at, stops").
+		Where("path ~ ?", "*."+uid.String()+".*").
+		Where("uuid != ?", uid).
+		Where("deleted_at is null").
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:      item.UUID,
+			Path:      strings.Split(item.Path, "."),
+			Status:    item.Status,
+			Fields:    item.Fields,
+			CreatedAt: item.CreatedAt,
+			Stops: lo.Map(item.Stops, func(stop Stop, _ int) domain.Stop {
+				return domain.Stop{
+					UUID:      stop.UUID,
+					CreatedAt: stop.CreatedAt,
+					StatusID:  stop.StatusID,
+				}
+			}),
+		}
+	})
+
+	return dms, nil
+}
+
+func (r *Repository) GetTimelineTasks(_ context.Context, projectUUID uuid.UUID, rootUUID *uuid.UUID, viewerEmail *string) (dms []domain.Task, err error) {
+	defer r.storeTime("GetTimelineTasks", tm())
+
+	orms := []Task{}
+
+	query := r.gorm.DB.
+		Select("uuid, id, name, path, is_epic, status, start_at, finish_to, duration").
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null")
+
+	if viewerEmail != nil {
+		where, args := domain.TaskVisibleSQL("tasks", *viewerEmail)
+		query = query.Where(where, args...)
+	}
+
+	if rootUUID != nil {
+		query = query.Where("path ~ ?", "*."+rootUUID.String()+".*")
+	}
+
+	err = query.Order("path").Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:        item.UUID,
+			ID:          item.ID,
+			Name:        item.Name,
+			ProjectUUID: projectUUID,
+			Path:        strings.Split(item.Path, "."),
+			IsEpic:      item.IsEpic,
+			Status:      item.Status,
+			StartAt:     item.StartAt,
+			FinishTo:    item.FinishTo,
+			Duration:    item.Duration,
+		}
+	})
+
+	return dms, nil
+}
+
+// GetAnalyticsTasks returns tasks of the project created before the end of
+// the period with their stops.
+func (r *Repository) GetAnalyticsTasks(_ context.Context, filter domain.AnalyticsFilter, viewerEmail *string) (dms []domain.Task, err error) {
+	defer r.storeTime("GetAnalyticsTasks", tm())
+
+	orms := []Task{}
+
+	query := r.gorm.DB.
+		Select("uuid, id, name, status, priority, implement_by, created_at, stops").
+		Where("project_uuid = ?", filter.ProjectUUID).
+		Where("created_at <= ?", filter.To).
+		Where("deleted_at is null")
+
+	if viewerEmail != nil {
+		where, args := domain.TaskVisibleSQL("tasks", *viewerEmail)
+		query = query.Where(where, args...)
+	}
+
+	if filter.Tag != nil {
+		query = query.Where("? = ANY (tags)", *filter.Tag)
+	}
+
+	if filter.Priority != nil {
+		query = query.Where("priority = ?", *filter.Priority)
+	}
+
+	if filter.ImplementBy != nil {
+		query = query.Where("implement_by = ?", *filter.ImplementBy)
+	}
+
+	err = query.Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:        item.UUID,
+			ID:          item.ID,
+			Name:        item.Name,
+			ProjectUUID: filter.ProjectUUID,
+			Status:      item.Status,
+			Priority:    item.Priority,
+			ImplementBy: item.ImplementBy,
+			CreatedAt:   item.CreatedAt,
+			Stops: lo.Map(item.Stops, func(stop Stop, _ int) domain.Stop {
+				return domain.Stop{
+					UUID:      stop.UUID,
+					CreatedAt: stop.CreatedAt,
+					StatusID:  stop.StatusID,
+				}
+			}),
+		}
+	})
+
+	return dms, nil
+}
+
+// GetTasksHistory returns all tasks of the project including deleted ones
+// with the dates and stops needed to restore the project history.
+func (r *Repository) GetTasksHistory(_ context.Context, projectUUID uuid.UUID) (dms []domain.Task, err error) {
+	defer r.storeTime("GetTasksHistory", tm())
+
+	orms := []Task{}
+
+	err = r.gorm.DB.
+		Select("uuid, status, created_at, finished_at, deleted_at, stops").
+		Where("project_uuid = ?", projectUUID).
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:        item.UUID,
+			ProjectUUID: projectUUID,
+			Status:      item.Status,
+			CreatedAt:   item.CreatedAt,
+			FinishedAt:  item.FinishedAt,
+			DeletedAt:   item.DeletedAt,
+			Stops: lo.Map(item.Stops, func(stop Stop, _ int) domain.Stop {
+				return domain.Stop{
+					UUID:      stop.UUID,
+					CreatedAt: stop.CreatedAt,
+					StatusID:  stop.StatusID,
+				}
+			}),
+		}
+	})
+
+	return dms, nil
+}
+
+// GetDueTasks returns open tasks of the federations the user implements, is
+// responsible for or co-works on with a deadline before the moment.
+func (r *Repository) GetDueTasks(_ context.Context, federationUUIDs []uuid.UUID, email string, before time.Time) (dms []domain.Task, err error) {
+	defer r.storeTime("GetDueTasks", tm())
+
+	orms := []Task{}
+	where, args := domain.TaskVisibleSQL("tasks", email)
+
+	err = r.gorm.DB.
+		Select("uuid, id, name, federation_uuid, project_uuid, implement_by, responsible_by, status, priority, finish_to").
+		Where("federation_uuid in ?", federationUUIDs).
+		Where("(implement_by = ? OR responsible_by = ? OR ? = ANY (co_workers_by))", email, email, email).
+		Where("status not in (?)", []int{domain.StatusDone, domain.StatusCancel}).
+		Where("deleted_at is null").
+		Where("finish_to < ?", before).
+		Where(where, args...).
+		Order("finish_to").
+		Limit(domain.MyDayTasksLimit).
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:           item.UUID,
+			ID:             item.ID,
+			Name:           item.Name,
+			FederationUUID: item.FederationUUID,
+			ProjectUUID:    item.ProjectUUID,
+			ImplementBy:    item.ImplementBy,
+			ResponsibleBy:  item.ResponsibleBy,
+			Status:         item.Status,
+			Priority:       item.Priority,
+			FinishTo:       item.FinishTo,
+		}
+	})
+
+	return dms, nil
+}
+
+// GetOpenedTasks returns tasks of the federations the user has opened, the
+// last opened first.
+func (r *Repository) GetOpenedTasks(_ context.Context, federationUUIDs []uuid.UUID, userUUID uuid.UUID, email string, limit int) (dms []domain.OpenedTask, err error) {
+	defer r.storeTime("GetOpenedTasks", tm())
+
+	orms := []Task{}
+	where, args := domain.TaskVisibleSQL("tasks", email)
+	key := userUUID.String()
+
+	err = r.gorm.DB.
+		Select("uuid, id, name, status, first_open").
+		Where("federation_uuid in ?", federationUUIDs).
+		Where("first_open -> ? IS NOT NULL", key).
+		Where("deleted_at is null").
+		Where(where, args...).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "(first_open ->> ?)::timestamptz DESC",
+			Vars:               []interface{}{key},
+			WithoutParentheses: true,
+		}}).
+		Limit(limit).
+		Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.OpenedTask {
+		return domain.OpenedTask{
+			UUID:     item.UUID,
+			ID:       item.ID,
+			Name:     item.Name,
+			Status:   item.Status,
+			OpenedAt: item.FirstOpen[key],
+		}
+	})
+
+	return dms, nil
+}
+
+func (r *Repository) GetDependencies(projectUUID uuid.UUID) (dms []domain.TaskDependency, err error) {
+	orms := []TaskDependency{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Order("created_at").
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item TaskDependency, _ int) domain.TaskDependency {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) CreateDependency(dm domain.TaskDependency) error {
+	return r.gorm.DB.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&TaskDependency{
+			TaskUUID:      dm.TaskUUID,
+			DependsOnUUID: dm.DependsOnUUID,
+			ProjectUUID:   dm.ProjectUUID,
+			CreatedBy:     dm.CreatedBy,
+			CreatedAt:     dm.CreatedAt,
+		}).Error
+}
+
+func (r *Repository) DeleteDependency(taskUUID, dependsOnUUID uuid.UUID) error {
+	res := r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Where("depends_on_uuid = ?", dependsOnUUID).
+		Delete(&TaskDependency{})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("зависимость не найдена")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetOpenTasks(_ context.Context, federationUUID uuid.UUID, projectUUID *uuid.UUID) (dms []domain.Task, err error) {
+	defer r.storeTime("GetOpenTasks", tm())
+
+	orms := []Task{}
+
+	query := r.gorm.DB.
+		Select("uuid, project_uuid, implement_by, responsible_by, co_workers_by, status, priority, finish_to").
+		Where("federation_uuid = ?", federationUUID).
+		Where("status not in (?)", []int{domain.StatusDone, domain.StatusCancel}).
+		Where("deleted_at is null")
+
+	if projectUUID != nil {
+		query = query.Where("project_uuid = ?", *projectUUID)
+	}
+
+	err = query.Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return domain.Task{
+			UUID:           item.UUID,
+			FederationUUID: federationUUID,
+			ProjectUUID:    item.ProjectUUID,
+			ImplementBy:    item.ImplementBy,
+			ResponsibleBy:  item.ResponsibleBy,
+			CoWorkersBy:    item.CoWorkersBy,
+			Status:         item.Status,
+			Priority:       item.Priority,
+			FinishTo:       item.FinishTo,
+		}
+	})
+
+	return dms, nil
+}
+
+// GetTaskUUIDByID finds the task of the project by its numeric id.
+func (r *Repository) GetTaskUUIDByID(projectUUID uuid.UUID, id int) (uid uuid.UUID, found bool, err error) {
+	defer r.storeTime("GetTaskUUIDByID", tm())
+
+	uids := []uuid.UUID{}
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("id = ?", id).
+		Where("deleted_at is null").
+		Limit(1).
+		Pluck("uuid", &uids).
+		Error
+
+	if err != nil || len(uids) == 0 {
+		return uid, false, err
+	}
+
+	return uids[0], true, nil
+}
+
+// GetVisibleTaskUUIDs returns uuids of the given tasks the user can see.
+func (r *Repository) GetVisibleTaskUUIDs(uids []uuid.UUID, email string) (visible []uuid.UUID, err error) {
+	defer r.storeTime("GetVisibleTaskUUIDs", tm())
+
+	if len(uids) == 0 {
+		return visible, nil
+	}
+
+	where, args := domain.TaskVisibleSQL("tasks", email)
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Where("uuid in ?", uids).
+		Where("deleted_at is null").
+		Where(where, args...).
+		Pluck("uuid", &visible).
+		Error
+
+	return visible, err
+}
+
+// CountHiddenTasks counts the tasks of the project the user can not see.
+func (r *Repository) CountHiddenTasks(projectUUID uuid.UUID, email string) (count int64, err error) {
+	defer r.storeTime("CountHiddenTasks", tm())
+
+	where, args := domain.TaskVisibleSQL("tasks", email)
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Where("NOT "+where, args...).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+func uuidsToStrings(uids []uuid.UUID) pq.StringArray {
+	return lo.Map(uids, func(item uuid.UUID, _ int) string {
+		return item.String()
+	})
+}
+
+func stringsToUUIDs(items []string) []uuid.UUID {
+	return lo.FilterMap(items, func(item string, _ int) (uuid.UUID, bool) {
+		uid, err := uuid.Parse(item)
+		return uid, err == nil
+	})
 }
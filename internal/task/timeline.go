@@ -0,0 +1,158 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// GetTimeline builds the project timeline of tasks the viewer can see, nil
+// viewerEmail shows all of them.
+func (s *Service) GetTimeline(ctx context.Context, projectUUID uuid.UUID, rootUUID *uuid.UUID, viewerEmail *string) (items []domain.TimelineItem, err error) {
+	tasks, err := s.repo.GetTimelineTasks(ctx, projectUUID, rootUUID, viewerEmail)
+	if err != nil {
+		return items, err
+	}
+
+	deps, err := s.repo.GetDependencies(projectUUID)
+	if err != nil {
+		return items, err
+	}
+
+	if viewerEmail != nil {
+		shown := lo.SliceToMap(tasks, func(t domain.Task) (uuid.UUID, bool) {
+			return t.UUID, true
+		})
+
+		deps = lo.Filter(deps, func(d domain.TaskDependency, _ int) bool {
+			return shown[d.TaskUUID] && shown[d.DependsOnUUID]
+		})
+	}
+
+	return domain.BuildTimeline(tasks, deps), nil
+}
+
+func (s *Service) AddDependency(ctx context.Context, crtr domain.Creator, uid, dependsOnUUID uuid.UUID) (err error) {
+	task, err := s.repo.GetTask(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	dependsOn, err := s.repo.GetTask(ctx, dependsOnUUID)
+	if err != nil {
+		return err
+	}
+
+	dm, err := domain.NewTaskDependency(task, dependsOn, crtr.Email)
+	if err != nil {
+		return err
+	}
+
+	deps, err := s.repo.GetDependencies(task.ProjectUUID)
+	if err != nil {
+		return err
+	}
+
+	err = domain.CheckDependency(deps, uid, dependsOnUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.CreateDependency(dm)
+	if err != nil {
+		return err
+	}
+
+	s.TaskEvent(domain.WebhookEventTaskUpdated, task)
+
+	return nil
+}
+
+func (s *Service) DeleteDependency(ctx context.Context, uid, dependsOnUUID uuid.UUID) (err error) {
+	err = s.repo.DeleteDependency(uid, dependsOnUUID)
+	if err != nil {
+		return err
+	}
+
+	s.TaskEventByUUID(domain.WebhookEventTaskUpdated, uid)
+
+	return nil
+}
+
+// PatchSchedule changes planned dates of the task. With autoSchedule the
+// dependent tasks are moved forward, returns uuids of the moved tasks.
+func (s *Service) PatchSchedule(ctx context.Context, crtr domain.Creator, uid uuid.UUID, startAt, finishTo *time.Time, duration *int, autoSchedule bool) (moved []uuid.UUID, err error) {
+	task, err := s.GetTask(ctx, uid, []string{})
+	if err != nil {
+		return moved, err
+	}
+
+	err = task.PatchSchedule(startAt, finishTo, duration)
+	if err != nil {
+		return moved, err
+	}
+
+	shouldUpdate := []string{}
+	for _, field := range []string{"start_at", "finish_to", "duration"} {
+		if _, ok := task.Dirty[field]; ok {
+			shouldUpdate = append(shouldUpdate, field)
+		}
+	}
+
+	if len(shouldUpdate) == 0 {
+		return moved, errors.New("не указаны даты")
+	}
+
+	task.RawFields = map[string]interface{}{}
+
+	err = s.UpdateTask(crtr, task, shouldUpdate)
+	if err != nil {
+		return moved, err
+	}
+
+	if !autoSchedule {
+		return moved, nil
+	}
+
+	tasks, err := s.repo.GetTimelineTasks(ctx, task.ProjectUUID, nil, nil)
+	if err != nil {
+		return moved, err
+	}
+
+	deps, err := s.repo.GetDependencies(task.ProjectUUID)
+	if err != nil {
+		return moved, err
+	}
+
+	mp := make(map[uuid.UUID]*domain.Task, len(tasks))
+	for i := range tasks {
+		mp[tasks[i].UUID] = &tasks[i]
+	}
+
+	moved, err = domain.Reschedule(mp, deps, task.UUID)
+	if err != nil {
+		return moved, err
+	}
+
+	for _, m := range moved {
+		t, err := s.GetTask(ctx, m, []string{})
+		if err != nil {
+			return moved, err
+		}
+
+		t.StartAt = mp[m].StartAt
+		t.FinishTo = mp[m].FinishTo
+		t.RawFields = map[string]interface{}{}
+
+		err = s.UpdateTask(crtr, t, []string{"start_at", "finish_to"})
+		if err != nil {
+			return moved, err
+		}
+	}
+
+	return moved, nil
+}
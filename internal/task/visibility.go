@@ -0,0 +1,95 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// CheckVisible returns not found error if the task is hidden from the user,
+// so a hidden task can not be told apart from a missing one.
+func (s *Service) CheckVisible(_ context.Context, uid uuid.UUID, email string) error {
+	visible, err := s.repo.GetVisibleTaskUUIDs([]uuid.UUID{uid}, email)
+	if err != nil {
+		return err
+	}
+
+	if len(visible) == 0 {
+		return dto.NotFoundErr("задача не найдена")
+	}
+
+	return nil
+}
+
+// SeesAllTasks reports whether the user can see every task of the project,
+// the stored project totals can not be split by task and are shown to such
+// users only.
+func (s *Service) SeesAllTasks(_ context.Context, projectUUID uuid.UUID, email string) (bool, error) {
+	hidden, err := s.repo.CountHiddenTasks(projectUUID, email)
+
+	return hidden == 0, err
+}
+
+func (s *Service) FilterVisible(_ context.Context, uids []uuid.UUID, email string) ([]uuid.UUID, error) {
+	return s.repo.GetVisibleTaskUUIDs(uids, email)
+}
+
+// FilterViewers drops the users who can not see the task, used before
+// sending notifications.
+func (s *Service) FilterViewers(ctx context.Context, uid uuid.UUID, emails []string) ([]string, error) {
+	task, err := s.repo.GetTask(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Visibility == domain.TaskVisibilityProject {
+		return emails, nil
+	}
+
+	viewers := []string{}
+	for _, email := range emails {
+		if lo.Contains(task.People, email) {
+			viewers = append(viewers, email)
+			continue
+		}
+
+		visible, err := s.repo.GetVisibleTaskUUIDs([]uuid.UUID{uid}, email)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(visible) > 0 {
+			viewers = append(viewers, email)
+		}
+	}
+
+	return viewers, nil
+}
+
+func (s *Service) PatchVisibility(ctx context.Context, crtr domain.Creator, uid uuid.UUID, visibility string, users []string, groups []uuid.UUID) (err error) {
+	notFoundEmails := lo.Filter(users, func(email string, _ int) bool {
+		_, ok := s.dict.FindUser(email)
+		return !ok
+	})
+	if len(notFoundEmails) > 0 {
+		return fmt.Errorf("пользователи не найдены: %v", notFoundEmails)
+	}
+
+	task, err := s.GetTask(ctx, uid, []string{})
+	if err != nil {
+		return err
+	}
+
+	err = task.PatchVisibility(visibility, users, groups)
+	if err != nil {
+		return err
+	}
+
+	task.RawFields = map[string]interface{}{}
+
+	return s.UpdateTask(crtr, task, []string{"visibility"})
+}
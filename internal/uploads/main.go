@@ -0,0 +1,307 @@
+package uploads
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/sirupsen/logrus"
+)
+
+const expireBatch = 100
+
+// lockTTL is the lease of the writer, it is renewed while the chunk is
+// streamed and expires when the replica fails.
+const lockTTL = time.Minute
+
+// Service keeps resumable uploads: chunks are appended to the staged file on
+// disk and the completed file is stored as a regular task or comment file.
+// The staging path is shared by the replicas, the chunks of an upload are
+// written under the lease kept in the database.
+type Service struct {
+	repo    *Repository
+	storage *s3.ServicePrivate
+
+	path    string
+	maxSize int64
+	ttl     time.Duration
+}
+
+func New(repo *Repository, storage *s3.ServicePrivate, conf *configs.Configs) *Service {
+	return &Service{
+		repo:    repo,
+		storage: storage,
+
+		path:    conf.UPLOADS_TUS_PATH,
+		maxSize: int64(conf.UPLOADS_TUS_MAX_SIZE) * 1024 * 1024,
+		ttl:     time.Hour * time.Duration(conf.UPLOADS_TUS_EXPIRE),
+	}
+}
+
+func (s *Service) MaxSize() int64 {
+	return s.maxSize
+}
+
+func (s *Service) Create(_ context.Context, task domain.Task, commentUUID *uuid.UUID, userUUID uuid.UUID, fileName string, length int64) (dm domain.UploadSession, err error) {
+	dm, err = domain.NewUploadSession(task.FederationUUID, task.UUID, commentUUID, userUUID, fileName, length, s.maxSize, s.ttl, time.Now())
+	if err != nil {
+		return dm, err
+	}
+
+	err = s.storage.CheckQuota(task.FederationUUID, length)
+	if err != nil {
+		return dm, err
+	}
+
+	err = os.MkdirAll(s.path, 0o755)
+	if err != nil {
+		return dm, err
+	}
+
+	f, err := os.Create(s.stagedPath(dm))
+	if err != nil {
+		return dm, err
+	}
+	f.Close()
+
+	err = s.repo.Create(dm)
+	if err != nil {
+		os.Remove(s.stagedPath(dm))
+		return dm, err
+	}
+
+	return dm, nil
+}
+
+// Get returns the upload of the task, only its author can continue it.
+func (s *Service) Get(_ context.Context, taskUUID, uid, userUUID uuid.UUID) (dm domain.UploadSession, err error) {
+	dm, err = s.repo.Get(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if dm.TaskUUID != taskUUID || dm.CreatedBy != userUUID {
+		return dm, dto.NotFoundErr("загрузка не найдена")
+	}
+
+	return dm, nil
+}
+
+// Write appends the chunk at the offset, size is -1 when it is unknown. The
+// received part of an interrupted chunk is kept, so the client resumes from
+// the new offset. The completed upload is stored as the file.
+func (s *Service) Write(ctx context.Context, taskUUID, uid, userUUID uuid.UUID, offset, size int64, r io.Reader) (dm domain.UploadSession, err error) {
+	_, err = s.Get(ctx, taskUUID, uid, userUUID)
+	if err != nil {
+		return dm, err
+	}
+
+	unlock, err := s.lock(uid)
+	if err != nil {
+		return dm, err
+	}
+	defer unlock()
+
+	// the offset is of the previous writer
+	dm, err = s.repo.Get(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	err = dm.CheckChunk(offset, size, time.Now())
+	if err != nil {
+		return dm, err
+	}
+
+	// the upload was staged by a replica not sharing the path
+	f, err := os.OpenFile(s.stagedPath(dm), os.O_WRONLY, 0o644)
+	if errors.Is(err, os.ErrNotExist) {
+		logrus.WithField("upload_uuid", dm.UUID).WithField("path", s.path).Error("staged upload not found")
+		return dm, dto.NotFoundErr("загрузка не найдена")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+	defer f.Close()
+
+	// drops the tail of a chunk that was written but not counted
+	err = f.Truncate(dm.Offset)
+	if err != nil {
+		return dm, err
+	}
+
+	_, err = f.Seek(dm.Offset, io.SeekStart)
+	if err != nil {
+		return dm, err
+	}
+
+	n, copyErr := io.Copy(f, io.LimitReader(r, dm.Length-dm.Offset))
+
+	if n > 0 {
+		err = s.repo.Advance(dm.UUID, dm.Offset, dm.Offset+n)
+		if err != nil {
+			return dm, err
+		}
+
+		dm.Offset += n
+	}
+
+	if copyErr != nil {
+		return dm, copyErr
+	}
+
+	if !dm.Completed() {
+		return dm, nil
+	}
+
+	err = f.Close()
+	if err != nil {
+		return dm, err
+	}
+
+	return s.finish(dm)
+}
+
+// finish stores the staged file through the regular upload, it is repeated by
+// an empty chunk when the previous attempt failed.
+func (s *Service) finish(dm domain.UploadSession) (domain.UploadSession, error) {
+	var (
+		file s3.File
+		err  error
+	)
+
+	if dm.CommentUUID != nil {
+		file, err = s.storage.UploadTaskCommentFile(dm.FederationUUID, dm.TaskUUID, *dm.CommentUUID, dm.FileName, s.stagedPath(dm), dm.CreatedBy)
+	} else {
+		file, err = s.storage.UploadTaskFile(dm.FederationUUID, dm.TaskUUID, dm.FileName, s.stagedPath(dm), dm.CreatedBy)
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	err = s.repo.Finish(dm.UUID, file.UUID)
+	if err != nil {
+		return dm, err
+	}
+
+	now := time.Now()
+	dm.FileUUID = &file.UUID
+	dm.FinishedAt = &now
+
+	err = os.Remove(s.stagedPath(dm))
+	if err != nil {
+		logrus.WithField("upload_uuid", dm.UUID).Error("staged upload remove error: ", err)
+	}
+
+	return dm, nil
+}
+
+// Terminate drops the upload and its staged chunks.
+func (s *Service) Terminate(ctx context.Context, taskUUID, uid, userUUID uuid.UUID) error {
+	dm, err := s.Get(ctx, taskUUID, uid, userUUID)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.lock(uid)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return s.remove(dm)
+}
+
+// ExpireStale removes the expired uploads, it returns how many were removed.
+func (s *Service) ExpireStale(_ context.Context, now time.Time) (n int, err error) {
+	dms, err := s.repo.GetExpired(now, expireBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dm := range dms {
+		// the upload being written is removed by the next run
+		unlock, err := s.lock(dm.UUID)
+		if errors.Is(err, domain.ErrUploadLocked) {
+			continue
+		}
+
+		if err == nil {
+			err = s.remove(dm)
+			unlock()
+		}
+
+		if err != nil {
+			logrus.WithField("upload_uuid", dm.UUID).Error("expired upload remove error: ", err)
+			continue
+		}
+
+		n++
+	}
+
+	return n, nil
+}
+
+func (s *Service) remove(dm domain.UploadSession) error {
+	err := os.Remove(s.stagedPath(dm))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return s.repo.Delete(dm.UUID)
+}
+
+// stagedPath keeps the extension, so the mime type of the stored file is
+// detected the same way as for the multipart upload.
+func (s *Service) stagedPath(dm domain.UploadSession) string {
+	return filepath.Join(s.path, dm.UUID.String()+dm.Ext())
+}
+
+// lock takes the lease of the upload and renews it until unlock, the second
+// writer gets ErrUploadLocked.
+func (s *Service) lock(uid uuid.UUID) (unlock func(), err error) {
+	token := uuid.New()
+
+	err = s.repo.Lock(uid, token, lockTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(lockTTL / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := s.repo.Lock(uid, token, lockTTL)
+				if err != nil {
+					logrus.WithField("upload_uuid", uid).Error("upload lock renew error: ", err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+
+		err := s.repo.Unlock(uid, token)
+		if err != nil {
+			logrus.WithField("upload_uuid", uid).Error("upload unlock error: ", err)
+		}
+	}, nil
+}
@@ -0,0 +1,48 @@
+package uploads
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type UploadSession struct {
+	UUID           uuid.UUID  `gorm:"type:uuid;not null;primary_key:true"`
+	FederationUUID uuid.UUID  `gorm:"type:uuid;not null;"`
+	TaskUUID       uuid.UUID  `gorm:"type:uuid;not null;"`
+	CommentUUID    *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	CreatedBy      uuid.UUID  `gorm:"type:uuid;not null;"`
+
+	FileName     string `gorm:"type:varchar(250);not null;"`
+	UploadLength int64  `gorm:"type:bigint;not null;"`
+	UploadOffset int64  `gorm:"type:bigint;default:0;not null;"`
+
+	FileUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	ExpiresAt  time.Time  `gorm:"type:timestamptz;not null"`
+	FinishedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	LockToken   *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	LockedUntil *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+func (o UploadSession) toDomain() domain.UploadSession {
+	return domain.UploadSession{
+		UUID:           o.UUID,
+		FederationUUID: o.FederationUUID,
+		TaskUUID:       o.TaskUUID,
+		CommentUUID:    o.CommentUUID,
+		CreatedBy:      o.CreatedBy,
+		FileName:       o.FileName,
+		Length:         o.UploadLength,
+		Offset:         o.UploadOffset,
+		FileUUID:       o.FileUUID,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+		ExpiresAt:      o.ExpiresAt,
+		FinishedAt:     o.FinishedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package uploads
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+// Lock takes the lease of the upload for the ttl unless the other writer
+// holds it, the lease of the same token is extended. No connection is held
+// while the chunk is streamed, the lease of a failed replica expires.
+func (r *Repository) Lock(uid, token uuid.UUID, ttl time.Duration) error {
+	res := r.gorm.DB.Exec(`
+		UPDATE upload_sessions
+		SET lock_token = ?, locked_until = now() + ? * interval '1 millisecond'
+		WHERE uuid = ? AND (lock_token = ? OR locked_until IS NULL OR locked_until < now())`,
+		token, ttl.Milliseconds(), uid, token)
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrUploadLocked
+	}
+
+	return nil
+}
+
+// Unlock releases the lease of the token.
+func (r *Repository) Unlock(uid, token uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("uuid = ?", uid).
+		Where("lock_token = ?", token).
+		Updates(map[string]interface{}{
+			"lock_token":   nil,
+			"locked_until": nil,
+		}).Error
+}
+
+func (r *Repository) Create(dm domain.UploadSession) error {
+	return r.gorm.DB.Create(&UploadSession{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		TaskUUID:       dm.TaskUUID,
+		CommentUUID:    dm.CommentUUID,
+		CreatedBy:      dm.CreatedBy,
+		FileName:       dm.FileName,
+		UploadLength:   dm.Length,
+		UploadOffset:   dm.Offset,
+		CreatedAt:      dm.CreatedAt,
+		UpdatedAt:      dm.UpdatedAt,
+		ExpiresAt:      dm.ExpiresAt,
+	}).Error
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.UploadSession, err error) {
+	orm := UploadSession{}
+
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Take(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dm, dto.NotFoundErr("загрузка не найдена")
+	}
+
+	if err != nil {
+		return dm, err
+	}
+
+	return orm.toDomain(), nil
+}
+
+// Advance moves the offset only from the expected one, so a concurrent chunk
+// of the same upload can not be counted twice.
+func (r *Repository) Advance(uid uuid.UUID, from, to int64) error {
+	res := r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("uuid = ?", uid).
+		Where("upload_offset = ?", from).
+		Where("finished_at is null").
+		Updates(map[string]interface{}{
+			"upload_offset": to,
+			"updated_at":    time.Now(),
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrUploadOffsetMismatch
+	}
+
+	return nil
+}
+
+func (r *Repository) Finish(uid, fileUUID uuid.UUID) error {
+	now := time.Now()
+
+	return r.gorm.DB.
+		Model(&UploadSession{}).
+		Where("uuid = ?", uid).
+		Updates(map[string]interface{}{
+			"file_uuid":   fileUUID,
+			"finished_at": now,
+			"updated_at":  now,
+		}).Error
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	return r.gorm.DB.
+		Where("uuid = ?", uid).
+		Delete(&UploadSession{}).Error
+}
+
+// GetExpired returns the uploads expired before now. Finished ones are kept
+// until then, so the client can still ask for the offset.
+func (r *Repository) GetExpired(now time.Time, limit int) (dms []domain.UploadSession, err error) {
+	orms := []UploadSession{}
+
+	err = r.gorm.DB.
+		Where("expires_at < ?", now).
+		Order("expires_at").
+		Limit(limit).
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item UploadSession, _ int) domain.UploadSession {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
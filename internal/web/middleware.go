@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/internal/app"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
@@ -196,3 +197,45 @@ func checkAuth(ctx echo.Context, operationID string, j jwt.IJWT) (echo.Context,
 
 	return ctx, nil
 }
+
+// TaskVisibilityMiddeware answers "not found" on /task/{UUID} operations when
+// the task is hidden from the user. It needs claims, so it must run after
+// AuthMiddeware.
+func TaskVisibilityMiddeware(a *app.App) func(f omain.StrictHandlerFunc, operationID string) omain.StrictHandlerFunc {
+	return func(f omain.StrictHandlerFunc, operationID string) omain.StrictHandlerFunc {
+		return func(ctx echo.Context, i interface{}) (interface{}, error) {
+			uid, ok := taskUUIDFromPath(ctx.Request().URL.Path)
+			if !ok {
+				return f(ctx, i)
+			}
+
+			claims, ok := ctx.Request().Context().Value(claimsKey).(jwt.Claims)
+			if !ok {
+				return nil, ErrInvalidAuthHeader
+			}
+
+			err := a.TaskService.CheckVisible(ctx.Request().Context(), uid, claims.Email)
+			if err != nil {
+				logrus.Debugf("[operation:%v] task %s is not visible", operationID, uid)
+				return nil, err
+			}
+
+			return f(ctx, i)
+		}
+	}
+}
+
+func taskUUIDFromPath(path string) (uuid.UUID, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] != "task" {
+			continue
+		}
+
+		uid, err := uuid.Parse(parts[i+1])
+		return uid, err == nil
+	}
+
+	return uuid.Nil, false
+}
@@ -63,12 +63,18 @@ type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
 }
 
+// Cursor defines model for cursor.
+type Cursor = string
+
 // EntityUUID defines model for entityUUID.
 type EntityUUID = openapi_types.UUID
 
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// WithTotal defines model for withTotal.
+type WithTotal = bool
+
 // GetCatalogUUIDDataParams defines parameters for GetCatalogUUIDData.
 type GetCatalogUUIDDataParams struct {
 	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
@@ -76,6 +82,12 @@ type GetCatalogUUIDDataParams struct {
 	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 	Order  *string `form:"order,omitempty" json:"order,omitempty"`
 	By     *string `form:"by,omitempty" json:"by,omitempty"`
+
+	// Cursor next_cursor from the previous page, offset is ignored
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WithTotal count total with cursor, otherwise total is -1
+	WithTotal *WithTotal `form:"with_total,omitempty" json:"with_total,omitempty"`
 }
 
 // PostCatalogUUIDDataJSONBody defines parameters for PostCatalogUUIDData.
@@ -257,6 +269,20 @@ func (w *ServerInterfaceWrapper) GetCatalogUUIDData(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter by: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "with_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with_total", ctx.QueryParams(), &params.WithTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter with_total: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetCatalogUUIDData(ctx, uUID, params)
 	return err
@@ -536,7 +562,10 @@ type GetCatalogUUIDData200JSONResponse struct {
 	Body struct {
 		Count int                      `json:"count"`
 		Items []map[string]interface{} `json:"items"`
-		Total int64                    `json:"total"`
+
+		// NextCursor empty on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+		Total      int64   `json:"total"`
 	}
 	Headers GetCatalogUUIDData200ResponseHeaders
 }
@@ -22,6 +22,37 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for ApprovalPolicyRequestRule.
+const (
+	All    ApprovalPolicyRequestRule = "all"
+	Any    ApprovalPolicyRequestRule = "any"
+	Quorum ApprovalPolicyRequestRule = "quorum"
+)
+
+// Defines values for WebhookCreateRequestEvents.
+const (
+	CommentCreated    WebhookCreateRequestEvents = "comment.created"
+	ReminderCreated   WebhookCreateRequestEvents = "reminder.created"
+	TaskCreated       WebhookCreateRequestEvents = "task.created"
+	TaskDeleted       WebhookCreateRequestEvents = "task.deleted"
+	TaskStatusChanged WebhookCreateRequestEvents = "task.status_changed"
+	TaskUpdated       WebhookCreateRequestEvents = "task.updated"
+)
+
+// Defines values for GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus.
+const (
+	Failed  GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus = "failed"
+	Pending GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus = "pending"
+	Success GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus = "success"
+)
+
+// Defines values for GetFederationUUIDWorkloadParamsRole.
+const (
+	CoWorkersBy   GetFederationUUIDWorkloadParamsRole = "co_workers_by"
+	ImplementBy   GetFederationUUIDWorkloadParamsRole = "implement_by"
+	ResponsibleBy GetFederationUUIDWorkloadParamsRole = "responsible_by"
+)
+
 // AddGroupRequest defines model for AddGroupRequest.
 type AddGroupRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
@@ -49,6 +80,23 @@ type AgentPatchRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
 }
 
+// AnalyticsDTO defines model for AnalyticsDTO.
+type AnalyticsDTO = dto.AnalyticsDTO
+
+// ApprovalPolicyDTO defines model for ApprovalPolicyDTO.
+type ApprovalPolicyDTO = dto.ApprovalPolicyDTO
+
+// ApprovalPolicyRequest defines model for ApprovalPolicyRequest.
+type ApprovalPolicyRequest struct {
+	Approvers *[]string                 `json:"approvers,omitempty"`
+	Groups    *[]openapi_types.UUID     `json:"groups,omitempty"`
+	Quorum    *int                      `json:"quorum,omitempty"`
+	Rule      ApprovalPolicyRequestRule `json:"rule"`
+}
+
+// ApprovalPolicyRequestRule defines model for ApprovalPolicyRequest.Rule.
+type ApprovalPolicyRequestRule string
+
 // CompanyAddUserRequest defines model for CompanyAddUserRequest.
 type CompanyAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -89,9 +137,32 @@ type FederationCreateRequest struct {
 // FederationDTO defines model for FederationDTO.
 type FederationDTO = dto.FederationDTO
 
+// FileSearchDTO defines model for FileSearchDTO.
+type FileSearchDTO = dto.FileSearchDTO
+
 // GroupDTO defines model for GroupDTO.
 type GroupDTO = dto.GroupDTO
 
+// InboundMappingDTO defines model for InboundMappingDTO.
+type InboundMappingDTO = dto.InboundMappingDTO
+
+// InboundWebhookCreateRequest defines model for InboundWebhookCreateRequest.
+type InboundWebhookCreateRequest struct {
+	Mapping InboundMappingDTO `json:"mapping"`
+	Name    string            `json:"name" validate:"trim,min=3,max=100"`
+}
+
+// InboundWebhookDTO defines model for InboundWebhookDTO.
+type InboundWebhookDTO = dto.InboundWebhookDTO
+
+// InboundWebhookPatchRequest defines model for InboundWebhookPatchRequest.
+type InboundWebhookPatchRequest struct {
+	IsActive    *bool              `json:"is_active,omitempty"`
+	Mapping     *InboundMappingDTO `json:"mapping,omitempty"`
+	Name        *string            `json:"name,omitempty" validate:"omitempty,trim,min=3,max=100"`
+	RotateToken *bool              `json:"rotate_token,omitempty"`
+}
+
 // InviteCreateRequest defines model for InviteCreateRequest.
 type InviteCreateRequest struct {
 	CompanyUuid *openapi_types.UUID `json:"company_uuid,omitempty" validate:"omitempty,uuid"`
@@ -101,6 +172,25 @@ type InviteCreateRequest struct {
 // InviteDTO defines model for InviteDTO.
 type InviteDTO = dto.InviteDTO
 
+// MailboxCreateRequest defines model for MailboxCreateRequest.
+type MailboxCreateRequest struct {
+	// Domain IMAP server host:port
+	Domain   string `json:"domain" validate:"trim,max=250"`
+	Email    string `json:"email" validate:"trim,max=100"`
+	Password string `json:"password"`
+}
+
+// MailboxDTO defines model for MailboxDTO.
+type MailboxDTO = dto.MailboxDTO
+
+// MailboxPatchRequest defines model for MailboxPatchRequest.
+type MailboxPatchRequest struct {
+	Domain   *string `json:"domain,omitempty" validate:"omitempty,trim,max=250"`
+	Email    *string `json:"email,omitempty" validate:"omitempty,trim,max=100"`
+	IsActive *bool   `json:"is_active,omitempty"`
+	Password *string `json:"password,omitempty"`
+}
+
 // NameRequest defines model for NameRequest.
 type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
@@ -186,6 +276,9 @@ type ProjectRequestParams struct {
 	StatusSort    *[]int    `json:"status_sort,omitempty" validate:"omitempty,dive,gte=0,lte=30"`
 }
 
+// ProjectStatisticSnapshotDTO defines model for ProjectStatisticSnapshotDTO.
+type ProjectStatisticSnapshotDTO = dto.ProjectStatisticSnapshotDTO
+
 // ProjectStatusCreateRequest defines model for ProjectStatusCreateRequest.
 type ProjectStatusCreateRequest struct {
 	Color       string `json:"color" validate:"color"`
@@ -207,6 +300,9 @@ type SearchUserRequest struct {
 // SmsDTO defines model for SmsDTO.
 type SmsDTO = dto.SmsDTO
 
+// StorageUsageDTO defines model for StorageUsageDTO.
+type StorageUsageDTO = dto.StorageUsageDTO
+
 // SurveyCreateRequest defines model for SurveyCreateRequest.
 type SurveyCreateRequest struct {
 	Body map[string]interface{} `json:"body"`
@@ -226,14 +322,48 @@ type TagCreateRequest struct {
 // TagDTO defines model for TagDTO.
 type TagDTO = dto.TagDTO
 
+// TimelineItemDTO defines model for TimelineItemDTO.
+type TimelineItemDTO = dto.TimelineItemDTO
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Uuid openapi_types.UUID `json:"uuid"`
 }
 
+// UploadDTO defines model for UploadDTO.
+type UploadDTO = dto.UploadDTO
+
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// WebhookCreateRequest defines model for WebhookCreateRequest.
+type WebhookCreateRequest struct {
+	Events []WebhookCreateRequestEvents `json:"events" validate:"min=1"`
+	Url    string                       `json:"url" validate:"trim,url,max=500"`
+}
+
+// WebhookCreateRequestEvents defines model for WebhookCreateRequest.Events.
+type WebhookCreateRequestEvents string
+
+// WebhookDTO defines model for WebhookDTO.
+type WebhookDTO = dto.WebhookDTO
+
+// WebhookDeliveryDTO defines model for WebhookDeliveryDTO.
+type WebhookDeliveryDTO = dto.WebhookDeliveryDTO
+
+// WebhookPatchRequest defines model for WebhookPatchRequest.
+type WebhookPatchRequest struct {
+	Events   *[]string `json:"events,omitempty"`
+	IsActive *bool     `json:"is_active,omitempty"`
+	Url      *string   `json:"url,omitempty" validate:"omitempty,trim,url,max=500"`
+}
+
+// WorkloadDTO defines model for WorkloadDTO.
+type WorkloadDTO = dto.WorkloadDTO
+
+// DeliveryUUID defines model for deliveryUUID.
+type DeliveryUUID = openapi_types.UUID
+
 // EntityName defines model for entityName.
 type EntityName = string
 
@@ -288,6 +418,13 @@ type GetFederationUUIDAgentParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetFederationUUIDFileSearchParams defines parameters for GetFederationUUIDFileSearch.
+type GetFederationUUIDFileSearchParams struct {
+	Q      string `form:"q" json:"q"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int   `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // GetFederationUUIDProjectParams defines parameters for GetFederationUUIDProject.
 type GetFederationUUIDProjectParams struct {
 	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty"`
@@ -295,6 +432,33 @@ type GetFederationUUIDProjectParams struct {
 	CompanyUuid *openapi_types.UUID `form:"company_uuid,omitempty" json:"company_uuid,omitempty"`
 }
 
+// GetFederationUUIDWebhookParams defines parameters for GetFederationUUIDWebhook.
+type GetFederationUUIDWebhookParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetFederationUUIDWebhookEntityUUIDDeliveryParams defines parameters for GetFederationUUIDWebhookEntityUUIDDelivery.
+type GetFederationUUIDWebhookEntityUUIDDeliveryParams struct {
+	Status *GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Offset *int                                                    `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int                                                    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus defines parameters for GetFederationUUIDWebhookEntityUUIDDelivery.
+type GetFederationUUIDWebhookEntityUUIDDeliveryParamsStatus string
+
+// GetFederationUUIDWorkloadParams defines parameters for GetFederationUUIDWorkload.
+type GetFederationUUIDWorkloadParams struct {
+	ProjectUuid   *openapi_types.UUID                    `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+	Role          *[]GetFederationUUIDWorkloadParamsRole `form:"role,omitempty" json:"role,omitempty"`
+	Weeks         *int                                   `form:"weeks,omitempty" json:"weeks,omitempty"`
+	OverAllocated *bool                                  `form:"over_allocated,omitempty" json:"over_allocated,omitempty"`
+}
+
+// GetFederationUUIDWorkloadParamsRole defines parameters for GetFederationUUIDWorkload.
+type GetFederationUUIDWorkloadParamsRole string
+
 // DeleteGroupUUIDUserJSONBody defines parameters for DeleteGroupUUIDUser.
 type DeleteGroupUUIDUserJSONBody struct {
 	Uuid openapi_types.UUID `json:"uuid" validate:"uuid"`
@@ -305,6 +469,18 @@ type PostGroupUUIDUserJSONBody struct {
 	Uuid openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// GetProjectUUIDAnalyticsParams defines parameters for GetProjectUUIDAnalytics.
+type GetProjectUUIDAnalyticsParams struct {
+	// From Default is 90 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Default is now
+	To          *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Tag         *string    `form:"tag,omitempty" json:"tag,omitempty"`
+	Priority    *int       `form:"priority,omitempty" json:"priority,omitempty"`
+	ImplementBy *string    `form:"implement_by,omitempty" json:"implement_by,omitempty"`
+}
+
 // PostProjectUUIDCatalogJSONBody defines parameters for PostProjectUUIDCatalog.
 type PostProjectUUIDCatalogJSONBody struct {
 	CatalogName domain.ProjectCatalogType `json:"catalog_name" validate:"trim,required,eq=reasons|eq=reasons"`
@@ -327,6 +503,15 @@ type PatchProjectUUIDGraphJSONBody struct {
 	Graph map[string]interface{} `json:"graph"`
 }
 
+// GetProjectUUIDStatisticHistoryParams defines parameters for GetProjectUUIDStatisticHistory.
+type GetProjectUUIDStatisticHistoryParams struct {
+	// From Default is 90 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Default is now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // PatchProjectUUIDStatusEntityUUIDJSONBody defines parameters for PatchProjectUUIDStatusEntityUUID.
 type PatchProjectUUIDStatusEntityUUIDJSONBody struct {
 	Color       string `json:"color" validate:"color"`
@@ -334,6 +519,11 @@ type PatchProjectUUIDStatusEntityUUIDJSONBody struct {
 	Name        string `json:"name" validate:"trim,min=1,max=50"`
 }
 
+// GetProjectUUIDTimelineParams defines parameters for GetProjectUUIDTimeline.
+type GetProjectUUIDTimelineParams struct {
+	Root *openapi_types.UUID `form:"root,omitempty" json:"root,omitempty"`
+}
+
 // GetTagParams defines parameters for GetTag.
 type GetTagParams struct {
 	CompanyUuid openapi_types.UUID `form:"company_uuid" json:"company_uuid"`
@@ -399,6 +589,12 @@ type PatchFederationUUIDNameJSONRequestBody = NameRequiredRequest
 // PostFederationUUIDUserJSONRequestBody defines body for PostFederationUUIDUser for application/json ContentType.
 type PostFederationUUIDUserJSONRequestBody = FederationAddUserRequest
 
+// PostFederationUUIDWebhookJSONRequestBody defines body for PostFederationUUIDWebhook for application/json ContentType.
+type PostFederationUUIDWebhookJSONRequestBody = WebhookCreateRequest
+
+// PatchFederationUUIDWebhookEntityUUIDJSONRequestBody defines body for PatchFederationUUIDWebhookEntityUUID for application/json ContentType.
+type PatchFederationUUIDWebhookEntityUUIDJSONRequestBody = WebhookPatchRequest
+
 // DeleteGroupUUIDUserJSONRequestBody defines body for DeleteGroupUUIDUser for application/json ContentType.
 type DeleteGroupUUIDUserJSONRequestBody DeleteGroupUUIDUserJSONBody
 
@@ -417,6 +613,9 @@ type PostProjectJSONRequestBody = ProjectCreateRequest
 // PatchProjectUUIDJSONRequestBody defines body for PatchProjectUUID for application/json ContentType.
 type PatchProjectUUIDJSONRequestBody = ProjectRequestParams
 
+// PutProjectUUIDApprovalPolicyJSONRequestBody defines body for PutProjectUUIDApprovalPolicy for application/json ContentType.
+type PutProjectUUIDApprovalPolicyJSONRequestBody = ApprovalPolicyRequest
+
 // PostProjectUUIDCatalogJSONRequestBody defines body for PostProjectUUIDCatalog for application/json ContentType.
 type PostProjectUUIDCatalogJSONRequestBody PostProjectUUIDCatalogJSONBody
 
@@ -429,6 +628,18 @@ type PostProjectUUIDFieldEntityUUIDJSONRequestBody PostProjectUUIDFieldEntityUUI
 // PatchProjectUUIDGraphJSONRequestBody defines body for PatchProjectUUIDGraph for application/json ContentType.
 type PatchProjectUUIDGraphJSONRequestBody PatchProjectUUIDGraphJSONBody
 
+// PostProjectUUIDInboundJSONRequestBody defines body for PostProjectUUIDInbound for application/json ContentType.
+type PostProjectUUIDInboundJSONRequestBody = InboundWebhookCreateRequest
+
+// PatchProjectUUIDInboundEntityUUIDJSONRequestBody defines body for PatchProjectUUIDInboundEntityUUID for application/json ContentType.
+type PatchProjectUUIDInboundEntityUUIDJSONRequestBody = InboundWebhookPatchRequest
+
+// PostProjectUUIDMailboxJSONRequestBody defines body for PostProjectUUIDMailbox for application/json ContentType.
+type PostProjectUUIDMailboxJSONRequestBody = MailboxCreateRequest
+
+// PatchProjectUUIDMailboxEntityUUIDJSONRequestBody defines body for PatchProjectUUIDMailboxEntityUUID for application/json ContentType.
+type PatchProjectUUIDMailboxEntityUUIDJSONRequestBody = MailboxPatchRequest
+
 // PatchProjectUUIDNameJSONRequestBody defines body for PatchProjectUUIDName for application/json ContentType.
 type PatchProjectUUIDNameJSONRequestBody = NameRequest
 
@@ -546,6 +757,9 @@ type ServerInterface interface {
 	// (PATCH /federation/{UUID}/agent/{entityUUID})
 	PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /federation/{UUID}/file/search)
+	GetFederationUUIDFileSearch(ctx echo.Context, uUID Uuid, params GetFederationUUIDFileSearchParams) error
+
 	// (GET /federation/{UUID}/invite)
 	GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error
 
@@ -561,12 +775,36 @@ type ServerInterface interface {
 	// (GET /federation/{UUID}/project)
 	GetFederationUUIDProject(ctx echo.Context, uUID Uuid, params GetFederationUUIDProjectParams) error
 
+	// (GET /federation/{UUID}/storage)
+	GetFederationUUIDStorage(ctx echo.Context, uUID Uuid) error
+
 	// (POST /federation/{UUID}/user)
 	PostFederationUUIDUser(ctx echo.Context, uUID Uuid) error
 
 	// (DELETE /federation/{UUID}/user/{userUUID})
 	DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error
 
+	// (GET /federation/{UUID}/webhook)
+	GetFederationUUIDWebhook(ctx echo.Context, uUID Uuid, params GetFederationUUIDWebhookParams) error
+
+	// (POST /federation/{UUID}/webhook)
+	PostFederationUUIDWebhook(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /federation/{UUID}/webhook/{entityUUID})
+	DeleteFederationUUIDWebhookEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /federation/{UUID}/webhook/{entityUUID})
+	PatchFederationUUIDWebhookEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /federation/{UUID}/webhook/{entityUUID}/delivery)
+	GetFederationUUIDWebhookEntityUUIDDelivery(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDWebhookEntityUUIDDeliveryParams) error
+
+	// (POST /federation/{UUID}/webhook/{entityUUID}/delivery/{deliveryUUID}/redeliver)
+	PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, deliveryUUID DeliveryUUID) error
+
+	// (GET /federation/{UUID}/workload)
+	GetFederationUUIDWorkload(ctx echo.Context, uUID Uuid, params GetFederationUUIDWorkloadParams) error
+
 	// (DELETE /group/{UUID}/user)
 	DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error
 
@@ -606,6 +844,18 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID})
 	PatchProjectUUID(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/analytics)
+	GetProjectUUIDAnalytics(ctx echo.Context, uUID Uuid, params GetProjectUUIDAnalyticsParams) error
+
+	// (DELETE /project/{UUID}/approval-policy)
+	DeleteProjectUUIDApprovalPolicy(ctx echo.Context, uUID Uuid) error
+
+	// (GET /project/{UUID}/approval-policy)
+	GetProjectUUIDApprovalPolicy(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /project/{UUID}/approval-policy)
+	PutProjectUUIDApprovalPolicy(ctx echo.Context, uUID Uuid) error
+
 	// (GET /project/{UUID}/catalog)
 	GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error
 
@@ -630,12 +880,39 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/graph)
 	PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/inbound)
+	GetProjectUUIDInbound(ctx echo.Context, uUID Uuid) error
+
+	// (POST /project/{UUID}/inbound)
+	PostProjectUUIDInbound(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /project/{UUID}/inbound/{entityUUID})
+	DeleteProjectUUIDInboundEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /project/{UUID}/inbound/{entityUUID})
+	PatchProjectUUIDInboundEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /project/{UUID}/mailbox)
+	GetProjectUUIDMailbox(ctx echo.Context, uUID Uuid) error
+
+	// (POST /project/{UUID}/mailbox)
+	PostProjectUUIDMailbox(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /project/{UUID}/mailbox/{entityUUID})
+	DeleteProjectUUIDMailboxEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /project/{UUID}/mailbox/{entityUUID})
+	PatchProjectUUIDMailboxEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /project/{UUID}/name)
 	PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error
 
 	// (PATCH /project/{UUID}/options)
 	PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/statistic/history)
+	GetProjectUUIDStatisticHistory(ctx echo.Context, uUID Uuid, params GetProjectUUIDStatisticHistoryParams) error
+
 	// (GET /project/{UUID}/status)
 	GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -648,6 +925,9 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/status/{entityUUID})
 	PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /project/{UUID}/timeline)
+	GetProjectUUIDTimeline(ctx echo.Context, uUID Uuid, params GetProjectUUIDTimelineParams) error
+
 	// (POST /project/{UUID}/user)
 	PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error
 
@@ -1340,6 +1620,47 @@ func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Con
 	return err
 }
 
+// GetFederationUUIDFileSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDFileSearch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDFileSearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDFileSearch(ctx, uUID, params)
+	return err
+}
+
 // GetFederationUUIDInvite converts echo context to params.
 func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
 	var err error
@@ -1461,6 +1782,24 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDProject(ctx echo.Context) erro
 	return err
 }
 
+// GetFederationUUIDStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDStorage(ctx, uUID)
+	return err
+}
+
 // PostFederationUUIDUser converts echo context to params.
 func (w *ServerInterfaceWrapper) PostFederationUUIDUser(ctx echo.Context) error {
 	var err error
@@ -1505,8 +1844,8 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Conte
 	return err
 }
 
-// DeleteGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
+// GetFederationUUIDWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1518,13 +1857,29 @@ func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDWebhookParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteGroupUUIDUser(ctx, uUID)
+	err = w.Handler.GetFederationUUIDWebhook(ctx, uUID, params)
 	return err
 }
 
-// GetGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
+// PostFederationUUIDWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1537,12 +1892,12 @@ func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupUUIDUser(ctx, uUID)
+	err = w.Handler.PostFederationUUIDWebhook(ctx, uUID)
 	return err
 }
 
-// PostGroupUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
+// DeleteFederationUUIDWebhookEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDWebhookEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1552,26 +1907,23 @@ func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostGroupUUIDUser(ctx, uUID)
-	return err
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-// PostPermissions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPermissions(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPermissions(ctx)
+	err = w.Handler.DeleteFederationUUIDWebhookEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeletePermissionsUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
+// PatchFederationUUIDWebhookEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFederationUUIDWebhookEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1581,15 +1933,23 @@ func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeletePermissionsUUID(ctx, uUID)
+	err = w.Handler.PatchFederationUUIDWebhookEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetPermissionsUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
+// GetFederationUUIDWebhookEntityUUIDDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDWebhookEntityUUIDDelivery(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1599,44 +1959,46 @@ func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPermissionsUUID(ctx, uUID)
-	return err
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-// PostProfileSurvey converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileSurvey(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileSurvey(ctx)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDWebhookEntityUUIDDeliveryParams
+	// ------------- Optional query parameter "status" -------------
 
-// DeleteProfileSurveyUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProfileSurveyUUID(ctx, uUID)
+	err = w.Handler.GetFederationUUIDWebhookEntityUUIDDelivery(ctx, uUID, entityUUID, params)
 	return err
 }
 
-// GetProfileSurveyUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
+// PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1646,26 +2008,31 @@ func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileSurveyUUID(ctx, uUID)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-// PostProject converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProject(ctx echo.Context) error {
-	var err error
+	// ------------- Path parameter "deliveryUUID" -------------
+	var deliveryUUID DeliveryUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "deliveryUUID", runtime.ParamLocationPath, ctx.Param("deliveryUUID"), &deliveryUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProject(ctx)
+	err = w.Handler.PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx, uUID, entityUUID, deliveryUUID)
 	return err
 }
 
-// DeleteProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
+// GetFederationUUIDWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDWorkload(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1677,13 +2044,43 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDWorkloadParams
+	// ------------- Optional query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", ctx.QueryParams(), &params.Role)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role: %s", err))
+	}
+
+	// ------------- Optional query parameter "weeks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weeks", ctx.QueryParams(), &params.Weeks)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter weeks: %s", err))
+	}
+
+	// ------------- Optional query parameter "over_allocated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "over_allocated", ctx.QueryParams(), &params.OverAllocated)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter over_allocated: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUID(ctx, uUID)
+	err = w.Handler.GetFederationUUIDWorkload(ctx, uUID, params)
 	return err
 }
 
-// GetProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
+// DeleteGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1696,12 +2093,12 @@ func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUID(ctx, uUID)
+	err = w.Handler.DeleteGroupUUIDUser(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
+// GetGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1714,12 +2111,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUID(ctx, uUID)
+	err = w.Handler.GetGroupUUIDUser(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
+// PostGroupUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGroupUUIDUser(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1732,12 +2129,23 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.PostGroupUUIDUser(ctx, uUID)
 	return err
 }
 
-// PostProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
+// PostPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPermissions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPermissions(ctx)
+	return err
+}
+
+// DeletePermissionsUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePermissionsUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1750,12 +2158,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.DeletePermissionsUUID(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDCatalogEntityName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
+// GetPermissionsUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPermissionsUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1765,23 +2173,26 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityName" -------------
-	var entityName EntityName
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPermissionsUUID(ctx, uUID)
+	return err
+}
+
+// PostProfileSurvey converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileSurvey(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
+	err = w.Handler.PostProfileSurvey(ctx)
 	return err
 }
 
-// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
+// DeleteProfileSurveyUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileSurveyUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1791,23 +2202,44 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Con
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileSurveyUUID(ctx, uUID)
+	return err
+}
+
+// GetProfileSurveyUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileSurveyUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetProfileSurveyUUID(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDDescription converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
+// PostProject converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProject(ctx)
+	return err
+}
+
+// DeleteProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1820,12 +2252,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) e
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
+	err = w.Handler.DeleteProjectUUID(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// GetProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1835,23 +2267,33 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUID(ctx, uUID)
+	return err
+}
+
+// PatchProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PatchProjectUUID(ctx, uUID)
 	return err
 }
 
-// PostProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// GetProjectUUIDAnalytics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDAnalytics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1861,23 +2303,52 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDAnalyticsParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+	}
+
+	// ------------- Optional query parameter "implement_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "implement_by", ctx.QueryParams(), &params.ImplementBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter implement_by: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetProjectUUIDAnalytics(ctx, uUID, params)
 	return err
 }
 
-// PatchProjectUUIDGraph converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
+// DeleteProjectUUIDApprovalPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDApprovalPolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1890,12 +2361,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
+	err = w.Handler.DeleteProjectUUIDApprovalPolicy(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+// GetProjectUUIDApprovalPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDApprovalPolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1908,12 +2379,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
+	err = w.Handler.GetProjectUUIDApprovalPolicy(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDOptions converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
+// PutProjectUUIDApprovalPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProjectUUIDApprovalPolicy(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1926,12 +2397,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	err = w.Handler.PutProjectUUIDApprovalPolicy(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+// GetProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1944,12 +2415,12 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
 	return err
 }
 
-// PostProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+// PostProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1962,12 +2433,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// GetProjectUUIDCatalogEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1977,23 +2448,23 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Cont
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
 	return err
 }
 
-// PatchProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2014,12 +2485,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Conte
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostProjectUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+// PatchProjectUUIDDescription converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2032,12 +2503,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2047,54 +2518,67 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetTag converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+// PostProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTagParams
-	// ------------- Required query parameter "company_uuid" -------------
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTag(ctx, params)
+	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostTag converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+// PatchProjectUUIDGraph converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTag(ctx)
+	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
 	return err
 }
 
-// DeleteTagUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
+// GetProjectUUIDInbound converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDInbound(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2107,12 +2591,12 @@ func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTagUUID(ctx, uUID)
+	err = w.Handler.GetProjectUUIDInbound(ctx, uUID)
 	return err
 }
 
-// PatchTagUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
+// PostProjectUUIDInbound converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDInbound(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2125,3270 +2609,4818 @@ func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTagUUID(ctx, uUID)
+	err = w.Handler.PostProjectUUIDInbound(ctx, uUID)
 	return err
 }
 
-// GetUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+// DeleteProjectUUIDInboundEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDInboundEntityUUID(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUser(ctx)
+	err = w.Handler.DeleteProjectUUIDInboundEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
-
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
-
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// PatchProjectUUIDInboundEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDInboundEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	router.POST(baseURL+"/company", wrapper.PostCompany)
-	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
-	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
-	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
-	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
-	router.DELETE(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.DeleteCompanyUUIDFieldsEntityUUID)
-	router.PUT(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.PutCompanyUUIDFieldsEntityUUID)
-	router.GET(baseURL+"/company/:UUID/group", wrapper.GetCompanyUUIDGroup)
-	router.POST(baseURL+"/company/:UUID/group", wrapper.PostCompanyUUIDGroup)
-	router.DELETE(baseURL+"/company/:UUID/group/:entityUUID", wrapper.DeleteCompanyUUIDGroupEntityUUID)
-	router.PATCH(baseURL+"/company/:UUID/group/:entityUUID", wrapper.PatchCompanyUUIDGroupEntityUUID)
-	router.PATCH(baseURL+"/company/:UUID/name", wrapper.PatchCompanyUUIDName)
-	router.GET(baseURL+"/company/:UUID/priorities", wrapper.GetCompanyUUIDPriorities)
-	router.POST(baseURL+"/company/:UUID/priorities", wrapper.PostCompanyUUIDPriorities)
-	router.DELETE(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.DeleteCompanyUUIDPrioritiesEntityUUID)
-	router.PATCH(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.PatchCompanyUUIDPrioritiesEntityUUID)
-	router.GET(baseURL+"/company/:UUID/project/catalog/:entityName", wrapper.GetCompanyUUIDProjectCatalogEntityName)
-	router.GET(baseURL+"/company/:UUID/sms", wrapper.GetCompanyUUIDSms)
-	router.POST(baseURL+"/company/:UUID/sms/cost", wrapper.PostCompanyUUIDSmsCost)
-	router.POST(baseURL+"/company/:UUID/sms/options", wrapper.PostCompanyUUIDSmsOptions)
-	router.POST(baseURL+"/company/:UUID/sms/send", wrapper.PostCompanyUUIDSmsSend)
-	router.POST(baseURL+"/company/:UUID/user", wrapper.PostCompanyUUIDUser)
-	router.DELETE(baseURL+"/company/:UUID/user/:userUUID", wrapper.DeleteCompanyUUIDUserUserUUID)
-	router.POST(baseURL+"/federation", wrapper.PostFederation)
-	router.DELETE(baseURL+"/federation/:UUID", wrapper.DeleteFederationUUID)
-	router.GET(baseURL+"/federation/:UUID", wrapper.GetFederationUUID)
-	router.GET(baseURL+"/federation/:UUID/agent", wrapper.GetFederationUUIDAgent)
-	router.POST(baseURL+"/federation/:UUID/agent", wrapper.PostFederationUUIDAgent)
-	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.DeleteFederationUUIDAgentEntityUUID)
-	router.PATCH(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.PatchFederationUUIDAgentEntityUUID)
-	router.GET(baseURL+"/federation/:UUID/invite", wrapper.GetFederationUUIDInvite)
-	router.POST(baseURL+"/federation/:UUID/invite", wrapper.PostFederationUUIDInvite)
-	router.DELETE(baseURL+"/federation/:UUID/invite/:entityUUID", wrapper.DeleteFederationUUIDInviteEntityUUID)
-	router.PATCH(baseURL+"/federation/:UUID/name", wrapper.PatchFederationUUIDName)
-	router.GET(baseURL+"/federation/:UUID/project", wrapper.GetFederationUUIDProject)
-	router.POST(baseURL+"/federation/:UUID/user", wrapper.PostFederationUUIDUser)
-	router.DELETE(baseURL+"/federation/:UUID/user/:userUUID", wrapper.DeleteFederationUUIDUserUserUUID)
-	router.DELETE(baseURL+"/group/:UUID/user", wrapper.DeleteGroupUUIDUser)
-	router.GET(baseURL+"/group/:UUID/user", wrapper.GetGroupUUIDUser)
-	router.POST(baseURL+"/group/:UUID/user", wrapper.PostGroupUUIDUser)
-	router.POST(baseURL+"/permissions", wrapper.PostPermissions)
-	router.DELETE(baseURL+"/permissions/:UUID", wrapper.DeletePermissionsUUID)
-	router.GET(baseURL+"/permissions/:UUID", wrapper.GetPermissionsUUID)
-	router.POST(baseURL+"/profile/survey", wrapper.PostProfileSurvey)
-	router.DELETE(baseURL+"/profile/survey/:UUID", wrapper.DeleteProfileSurveyUUID)
-	router.GET(baseURL+"/profile/survey/:UUID", wrapper.GetProfileSurveyUUID)
-	router.POST(baseURL+"/project", wrapper.PostProject)
-	router.DELETE(baseURL+"/project/:UUID", wrapper.DeleteProjectUUID)
-	router.GET(baseURL+"/project/:UUID", wrapper.GetProjectUUID)
-	router.PATCH(baseURL+"/project/:UUID", wrapper.PatchProjectUUID)
-	router.GET(baseURL+"/project/:UUID/catalog", wrapper.GetProjectUUIDCatalog)
-	router.POST(baseURL+"/project/:UUID/catalog", wrapper.PostProjectUUIDCatalog)
-	router.GET(baseURL+"/project/:UUID/catalog/:entityName", wrapper.GetProjectUUIDCatalogEntityName)
-	router.DELETE(baseURL+"/project/:UUID/catalog/:entityUUID", wrapper.DeleteProjectUUIDCatalogEntityUUID)
-	router.PATCH(baseURL+"/project/:UUID/description", wrapper.PatchProjectUUIDDescription)
-	router.DELETE(baseURL+"/project/:UUID/field/:entityUUID", wrapper.DeleteProjectUUIDFieldEntityUUID)
-	router.POST(baseURL+"/project/:UUID/field/:entityUUID", wrapper.PostProjectUUIDFieldEntityUUID)
-	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
-	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
-	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
-	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
-	router.POST(baseURL+"/project/:UUID/status", wrapper.PostProjectUUIDStatus)
-	router.DELETE(baseURL+"/project/:UUID/status/:entityUUID", wrapper.DeleteProjectUUIDStatusEntityUUID)
-	router.PATCH(baseURL+"/project/:UUID/status/:entityUUID", wrapper.PatchProjectUUIDStatusEntityUUID)
-	router.POST(baseURL+"/project/:UUID/user", wrapper.PostProjectUUIDUser)
-	router.DELETE(baseURL+"/project/:UUID/user/:userUUID", wrapper.DeleteProjectUUIDUserUserUUID)
-	router.GET(baseURL+"/tag", wrapper.GetTag)
-	router.POST(baseURL+"/tag", wrapper.PostTag)
-	router.DELETE(baseURL+"/tag/:UUID", wrapper.DeleteTagUUID)
-	router.PATCH(baseURL+"/tag/:UUID", wrapper.PatchTagUUID)
-	router.GET(baseURL+"/user", wrapper.GetUser)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type PostCompanyRequestObject struct {
-	Body *PostCompanyJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostCompanyResponseObject interface {
-	VisitPostCompanyResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDInboundEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type PostCompany200JSONResponse UUIDResponse
+// GetProjectUUIDMailbox converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDMailbox(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PostCompany200JSONResponse) VisitPostCompanyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteCompanyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDMailbox(ctx, uUID)
+	return err
 }
 
-type DeleteCompanyUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error
-}
+// PostProjectUUIDMailbox converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDMailbox(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteCompanyUUID200Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response DeleteCompanyUUID200Response) VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDMailbox(ctx, uUID)
+	return err
 }
 
-type GetCompanyUUIDResponseObject interface {
-	VisitGetCompanyUUIDResponse(w http.ResponseWriter) error
-}
+// DeleteProjectUUIDMailboxEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDMailboxEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetCompanyUUID200JSONResponse CompanyDTO
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type GetCompanyUUIDFieldsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDFieldsResponseObject interface {
-	VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDMailboxEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type GetCompanyUUIDFields200JSONResponse struct {
-	Count int               `json:"count"`
-	Items []CompanyFieldDTO `json:"items"`
-}
+// PatchProjectUUIDMailboxEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDMailboxEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response GetCompanyUUIDFields200JSONResponse) VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type PostCompanyUUIDFieldsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDFieldsJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type PostCompanyUUIDFieldsResponseObject interface {
-	VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostCompanyUUIDFields200JSONResponse struct {
-	Hash            string               `json:"hash"`
-	Icon            string               `json:"icon"`
-	Type            domain.FieldDataType `json:"type"`
-	TypeDescription string               `json:"type_description"`
-	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
-	Uuid            string               `json:"uuid"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDMailboxEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PatchProjectUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteCompanyUUIDFieldsEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteCompanyUUIDFieldsEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteCompanyUUIDFieldsEntityUUID200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
+	return err
 }
 
-func (response DeleteCompanyUUIDFieldsEntityUUID200Response) VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// PatchProjectUUIDOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PutCompanyUUIDFieldsEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PutCompanyUUIDFieldsEntityUUIDResponseObject interface {
-	VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PutCompanyUUIDFieldsEntityUUID200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	return err
 }
 
-func (response PutCompanyUUIDFieldsEntityUUID200Response) VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// GetProjectUUIDStatisticHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDStatisticHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetCompanyUUIDGroupRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetCompanyUUIDGroupResponseObject interface {
-	VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetCompanyUUIDGroup200JSONResponse struct {
-	Count int        `json:"count"`
-	Items []GroupDTO `json:"items"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDStatisticHistoryParams
+	// ------------- Optional query parameter "from" -------------
 
-func (response GetCompanyUUIDGroup200JSONResponse) VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "to" -------------
 
-type PostCompanyUUIDGroupRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDGroupJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
 
-type PostCompanyUUIDGroupResponseObject interface {
-	VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDStatisticHistory(ctx, uUID, params)
+	return err
 }
 
-type PostCompanyUUIDGroup200JSONResponse UUIDResponse
-
-func (response PostCompanyUUIDGroup200JSONResponse) VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteCompanyUUIDGroupEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteCompanyUUIDGroupEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	return err
 }
 
-type DeleteCompanyUUIDGroupEntityUUID200Response struct {
-}
+// PostProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response DeleteCompanyUUIDGroupEntityUUID200Response) VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchCompanyUUIDGroupEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchCompanyUUIDGroupEntityUUIDResponseObject interface {
-	VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	return err
 }
 
-type PatchCompanyUUIDGroupEntityUUID200Response struct {
-}
+// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PatchCompanyUUIDGroupEntityUUID200Response) VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchCompanyUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchCompanyUUIDNameJSONRequestBody
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-type PatchCompanyUUIDNameResponseObject interface {
-	VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-type PatchCompanyUUIDName200Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type GetCompanyUUIDPrioritiesRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// PatchProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetCompanyUUIDPrioritiesResponseObject interface {
-	VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetCompanyUUIDPriorities200JSONResponse struct {
-	Count int                  `json:"count"`
-	Items []CompanyPriorityDTO `json:"items"`
-}
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-func (response GetCompanyUUIDPriorities200JSONResponse) VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostCompanyUUIDPrioritiesRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDPrioritiesJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
 }
 
-type PostCompanyUUIDPrioritiesResponseObject interface {
-	VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
-}
+// GetProjectUUIDTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDTimeline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostCompanyUUIDPriorities200JSONResponse UUIDResponse
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response PostCompanyUUIDPriorities200JSONResponse) VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDTimelineParams
+	// ------------- Optional query parameter "root" -------------
 
-type DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "root", ctx.QueryParams(), &params.Root)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter root: %s", err))
+	}
 
-type DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDTimeline(ctx, uUID, params)
+	return err
 }
 
-type DeleteCompanyUUIDPrioritiesEntityUUID200Response struct {
-}
+// PostProjectUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response DeleteCompanyUUIDPrioritiesEntityUUID200Response) VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PatchCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
-	VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	return err
 }
 
-type PatchCompanyUUIDPrioritiesEntityUUID200Response struct {
-}
+// DeleteProjectUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-func (response PatchCompanyUUIDPrioritiesEntityUUID200Response) VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	return err
 }
 
-type GetCompanyUUIDProjectCatalogEntityNameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityName EntityName `json:"entityName"`
+// GetTag converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagParams
+	// ------------- Required query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTag(ctx, params)
+	return err
 }
 
-type GetCompanyUUIDProjectCatalogEntityNameResponseObject interface {
-	VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error
+// PostTag converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTag(ctx)
+	return err
 }
 
-type GetCompanyUUIDProjectCatalogEntityName200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
+// DeleteTagUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTagUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTagUUID(ctx, uUID)
+	return err
 }
 
-func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PatchTagUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTagUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTagUUID(ctx, uUID)
+	return err
 }
 
-type GetCompanyUUIDSmsRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetCompanyUUIDSmsParams
+// GetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUser(ctx)
+	return err
 }
 
-type GetCompanyUUIDSmsResponseObject interface {
-	VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
-type GetCompanyUUIDSms200JSONResponse struct {
-	Count int      `json:"count"`
-	Items []SmsDTO `json:"items"`
-	Total int64    `json:"total"`
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
 }
 
-func (response GetCompanyUUIDSms200JSONResponse) VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/company", wrapper.PostCompany)
+	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
+	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
+	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
+	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
+	router.DELETE(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.DeleteCompanyUUIDFieldsEntityUUID)
+	router.PUT(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.PutCompanyUUIDFieldsEntityUUID)
+	router.GET(baseURL+"/company/:UUID/group", wrapper.GetCompanyUUIDGroup)
+	router.POST(baseURL+"/company/:UUID/group", wrapper.PostCompanyUUIDGroup)
+	router.DELETE(baseURL+"/company/:UUID/group/:entityUUID", wrapper.DeleteCompanyUUIDGroupEntityUUID)
+	router.PATCH(baseURL+"/company/:UUID/group/:entityUUID", wrapper.PatchCompanyUUIDGroupEntityUUID)
+	router.PATCH(baseURL+"/company/:UUID/name", wrapper.PatchCompanyUUIDName)
+	router.GET(baseURL+"/company/:UUID/priorities", wrapper.GetCompanyUUIDPriorities)
+	router.POST(baseURL+"/company/:UUID/priorities", wrapper.PostCompanyUUIDPriorities)
+	router.DELETE(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.DeleteCompanyUUIDPrioritiesEntityUUID)
+	router.PATCH(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.PatchCompanyUUIDPrioritiesEntityUUID)
+	router.GET(baseURL+"/company/:UUID/project/catalog/:entityName", wrapper.GetCompanyUUIDProjectCatalogEntityName)
+	router.GET(baseURL+"/company/:UUID/sms", wrapper.GetCompanyUUIDSms)
+	router.POST(baseURL+"/company/:UUID/sms/cost", wrapper.PostCompanyUUIDSmsCost)
+	router.POST(baseURL+"/company/:UUID/sms/options", wrapper.PostCompanyUUIDSmsOptions)
+	router.POST(baseURL+"/company/:UUID/sms/send", wrapper.PostCompanyUUIDSmsSend)
+	router.POST(baseURL+"/company/:UUID/user", wrapper.PostCompanyUUIDUser)
+	router.DELETE(baseURL+"/company/:UUID/user/:userUUID", wrapper.DeleteCompanyUUIDUserUserUUID)
+	router.POST(baseURL+"/federation", wrapper.PostFederation)
+	router.DELETE(baseURL+"/federation/:UUID", wrapper.DeleteFederationUUID)
+	router.GET(baseURL+"/federation/:UUID", wrapper.GetFederationUUID)
+	router.GET(baseURL+"/federation/:UUID/agent", wrapper.GetFederationUUIDAgent)
+	router.POST(baseURL+"/federation/:UUID/agent", wrapper.PostFederationUUIDAgent)
+	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.DeleteFederationUUIDAgentEntityUUID)
+	router.PATCH(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.PatchFederationUUIDAgentEntityUUID)
+	router.GET(baseURL+"/federation/:UUID/file/search", wrapper.GetFederationUUIDFileSearch)
+	router.GET(baseURL+"/federation/:UUID/invite", wrapper.GetFederationUUIDInvite)
+	router.POST(baseURL+"/federation/:UUID/invite", wrapper.PostFederationUUIDInvite)
+	router.DELETE(baseURL+"/federation/:UUID/invite/:entityUUID", wrapper.DeleteFederationUUIDInviteEntityUUID)
+	router.PATCH(baseURL+"/federation/:UUID/name", wrapper.PatchFederationUUIDName)
+	router.GET(baseURL+"/federation/:UUID/project", wrapper.GetFederationUUIDProject)
+	router.GET(baseURL+"/federation/:UUID/storage", wrapper.GetFederationUUIDStorage)
+	router.POST(baseURL+"/federation/:UUID/user", wrapper.PostFederationUUIDUser)
+	router.DELETE(baseURL+"/federation/:UUID/user/:userUUID", wrapper.DeleteFederationUUIDUserUserUUID)
+	router.GET(baseURL+"/federation/:UUID/webhook", wrapper.GetFederationUUIDWebhook)
+	router.POST(baseURL+"/federation/:UUID/webhook", wrapper.PostFederationUUIDWebhook)
+	router.DELETE(baseURL+"/federation/:UUID/webhook/:entityUUID", wrapper.DeleteFederationUUIDWebhookEntityUUID)
+	router.PATCH(baseURL+"/federation/:UUID/webhook/:entityUUID", wrapper.PatchFederationUUIDWebhookEntityUUID)
+	router.GET(baseURL+"/federation/:UUID/webhook/:entityUUID/delivery", wrapper.GetFederationUUIDWebhookEntityUUIDDelivery)
+	router.POST(baseURL+"/federation/:UUID/webhook/:entityUUID/delivery/:deliveryUUID/redeliver", wrapper.PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver)
+	router.GET(baseURL+"/federation/:UUID/workload", wrapper.GetFederationUUIDWorkload)
+	router.DELETE(baseURL+"/group/:UUID/user", wrapper.DeleteGroupUUIDUser)
+	router.GET(baseURL+"/group/:UUID/user", wrapper.GetGroupUUIDUser)
+	router.POST(baseURL+"/group/:UUID/user", wrapper.PostGroupUUIDUser)
+	router.POST(baseURL+"/permissions", wrapper.PostPermissions)
+	router.DELETE(baseURL+"/permissions/:UUID", wrapper.DeletePermissionsUUID)
+	router.GET(baseURL+"/permissions/:UUID", wrapper.GetPermissionsUUID)
+	router.POST(baseURL+"/profile/survey", wrapper.PostProfileSurvey)
+	router.DELETE(baseURL+"/profile/survey/:UUID", wrapper.DeleteProfileSurveyUUID)
+	router.GET(baseURL+"/profile/survey/:UUID", wrapper.GetProfileSurveyUUID)
+	router.POST(baseURL+"/project", wrapper.PostProject)
+	router.DELETE(baseURL+"/project/:UUID", wrapper.DeleteProjectUUID)
+	router.GET(baseURL+"/project/:UUID", wrapper.GetProjectUUID)
+	router.PATCH(baseURL+"/project/:UUID", wrapper.PatchProjectUUID)
+	router.GET(baseURL+"/project/:UUID/analytics", wrapper.GetProjectUUIDAnalytics)
+	router.DELETE(baseURL+"/project/:UUID/approval-policy", wrapper.DeleteProjectUUIDApprovalPolicy)
+	router.GET(baseURL+"/project/:UUID/approval-policy", wrapper.GetProjectUUIDApprovalPolicy)
+	router.PUT(baseURL+"/project/:UUID/approval-policy", wrapper.PutProjectUUIDApprovalPolicy)
+	router.GET(baseURL+"/project/:UUID/catalog", wrapper.GetProjectUUIDCatalog)
+	router.POST(baseURL+"/project/:UUID/catalog", wrapper.PostProjectUUIDCatalog)
+	router.GET(baseURL+"/project/:UUID/catalog/:entityName", wrapper.GetProjectUUIDCatalogEntityName)
+	router.DELETE(baseURL+"/project/:UUID/catalog/:entityUUID", wrapper.DeleteProjectUUIDCatalogEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/description", wrapper.PatchProjectUUIDDescription)
+	router.DELETE(baseURL+"/project/:UUID/field/:entityUUID", wrapper.DeleteProjectUUIDFieldEntityUUID)
+	router.POST(baseURL+"/project/:UUID/field/:entityUUID", wrapper.PostProjectUUIDFieldEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
+	router.GET(baseURL+"/project/:UUID/inbound", wrapper.GetProjectUUIDInbound)
+	router.POST(baseURL+"/project/:UUID/inbound", wrapper.PostProjectUUIDInbound)
+	router.DELETE(baseURL+"/project/:UUID/inbound/:entityUUID", wrapper.DeleteProjectUUIDInboundEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/inbound/:entityUUID", wrapper.PatchProjectUUIDInboundEntityUUID)
+	router.GET(baseURL+"/project/:UUID/mailbox", wrapper.GetProjectUUIDMailbox)
+	router.POST(baseURL+"/project/:UUID/mailbox", wrapper.PostProjectUUIDMailbox)
+	router.DELETE(baseURL+"/project/:UUID/mailbox/:entityUUID", wrapper.DeleteProjectUUIDMailboxEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/mailbox/:entityUUID", wrapper.PatchProjectUUIDMailboxEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
+	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
+	router.GET(baseURL+"/project/:UUID/statistic/history", wrapper.GetProjectUUIDStatisticHistory)
+	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
+	router.POST(baseURL+"/project/:UUID/status", wrapper.PostProjectUUIDStatus)
+	router.DELETE(baseURL+"/project/:UUID/status/:entityUUID", wrapper.DeleteProjectUUIDStatusEntityUUID)
+	router.PATCH(baseURL+"/project/:UUID/status/:entityUUID", wrapper.PatchProjectUUIDStatusEntityUUID)
+	router.GET(baseURL+"/project/:UUID/timeline", wrapper.GetProjectUUIDTimeline)
+	router.POST(baseURL+"/project/:UUID/user", wrapper.PostProjectUUIDUser)
+	router.DELETE(baseURL+"/project/:UUID/user/:userUUID", wrapper.DeleteProjectUUIDUserUserUUID)
+	router.GET(baseURL+"/tag", wrapper.GetTag)
+	router.POST(baseURL+"/tag", wrapper.PostTag)
+	router.DELETE(baseURL+"/tag/:UUID", wrapper.DeleteTagUUID)
+	router.PATCH(baseURL+"/tag/:UUID", wrapper.PatchTagUUID)
+	router.GET(baseURL+"/user", wrapper.GetUser)
 
-	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDSmsCostRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDSmsCostJSONRequestBody
+type PostCompanyRequestObject struct {
+	Body *PostCompanyJSONRequestBody
 }
 
-type PostCompanyUUIDSmsCostResponseObject interface {
-	VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error
+type PostCompanyResponseObject interface {
+	VisitPostCompanyResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDSmsCost200JSONResponse map[string]interface{}
+type PostCompany200JSONResponse UUIDResponse
 
-func (response PostCompanyUUIDSmsCost200JSONResponse) VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error {
+func (response PostCompany200JSONResponse) VisitPostCompanyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDSmsOptionsRequestObject struct {
+type DeleteCompanyUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDSmsOptionsJSONRequestBody
 }
 
-type PostCompanyUUIDSmsOptionsResponseObject interface {
-	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
+type DeleteCompanyUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDSmsOptions200Response struct {
+type DeleteCompanyUUID200Response struct {
 }
 
-func (response PostCompanyUUIDSmsOptions200Response) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
+func (response DeleteCompanyUUID200Response) VisitDeleteCompanyUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PostCompanyUUIDSmsSendRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params PostCompanyUUIDSmsSendParams
-	Body   *PostCompanyUUIDSmsSendJSONRequestBody
+type GetCompanyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type PostCompanyUUIDSmsSendResponseObject interface {
-	VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error
+type GetCompanyUUIDResponseObject interface {
+	VisitGetCompanyUUIDResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDSmsSend200JSONResponse map[string]interface{}
+type GetCompanyUUID200JSONResponse CompanyDTO
 
-func (response PostCompanyUUIDSmsSend200JSONResponse) VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error {
+func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCompanyUUIDUserRequestObject struct {
+type GetCompanyUUIDFieldsRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostCompanyUUIDUserJSONRequestBody
 }
 
-type PostCompanyUUIDUserResponseObject interface {
-	VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error
+type GetCompanyUUIDFieldsResponseObject interface {
+	VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error
 }
 
-type PostCompanyUUIDUser200JSONResponse UUIDResponse
+type GetCompanyUUIDFields200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []CompanyFieldDTO `json:"items"`
+}
 
-func (response PostCompanyUUIDUser200JSONResponse) VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error {
+func (response GetCompanyUUIDFields200JSONResponse) VisitGetCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCompanyUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
-}
-
-type DeleteCompanyUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error
-}
-
-type DeleteCompanyUUIDUserUserUUID200Response struct {
-}
-
-func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type PostCompanyUUIDFieldsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDFieldsJSONRequestBody
 }
 
-type PostFederationRequestObject struct {
-	Body *PostFederationJSONRequestBody
+type PostCompanyUUIDFieldsResponseObject interface {
+	VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error
 }
 
-type PostFederationResponseObject interface {
-	VisitPostFederationResponse(w http.ResponseWriter) error
+type PostCompanyUUIDFields200JSONResponse struct {
+	Hash            string               `json:"hash"`
+	Icon            string               `json:"icon"`
+	Type            domain.FieldDataType `json:"type"`
+	TypeDescription string               `json:"type_description"`
+	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
+	Uuid            string               `json:"uuid"`
 }
 
-type PostFederation200JSONResponse UUIDResponse
-
-func (response PostFederation200JSONResponse) VisitPostFederationResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type DeleteCompanyUUIDFieldsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteFederationUUIDResponseObject interface {
-	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+type DeleteCompanyUUIDFieldsEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUID200Response struct {
+type DeleteCompanyUUIDFieldsEntityUUID200Response struct {
 }
 
-func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteCompanyUUIDFieldsEntityUUID200Response) VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type DeleteFederationUUID401Response struct {
-}
-
-func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type PutCompanyUUIDFieldsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
 }
 
-type GetFederationUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type PutCompanyUUIDFieldsEntityUUIDResponseObject interface {
+	VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDResponseObject interface {
-	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+type PutCompanyUUIDFieldsEntityUUID200Response struct {
 }
 
-type GetFederationUUID200JSONResponse FederationDTO
-
-func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PutCompanyUUIDFieldsEntityUUID200Response) VisitPutCompanyUUIDFieldsEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type GetFederationUUIDAgentRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDAgentParams
+type GetCompanyUUIDGroupRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type GetFederationUUIDAgentResponseObject interface {
-	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
+type GetCompanyUUIDGroupResponseObject interface {
+	VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDAgent200JSONResponse struct {
+type GetCompanyUUIDGroup200JSONResponse struct {
 	Count int        `json:"count"`
-	Items []AgentDTO `json:"items"`
-	Total int64      `json:"total"`
+	Items []GroupDTO `json:"items"`
 }
 
-func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
+func (response GetCompanyUUIDGroup200JSONResponse) VisitGetCompanyUUIDGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostFederationUUIDAgentRequestObject struct {
+type PostCompanyUUIDGroupRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDAgentJSONRequestBody
+	Body *PostCompanyUUIDGroupJSONRequestBody
 }
 
-type PostFederationUUIDAgentResponseObject interface {
-	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+type PostCompanyUUIDGroupResponseObject interface {
+	VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error
 }
 
-type PostFederationUUIDAgent200JSONResponse UUIDResponse
+type PostCompanyUUIDGroup200JSONResponse UUIDResponse
 
-func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDGroup200JSONResponse) VisitPostCompanyUUIDGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
+type DeleteCompanyUUIDGroupEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteCompanyUUIDGroupEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUIDAgentEntityUUID200Response struct {
+type DeleteCompanyUUIDGroupEntityUUID200Response struct {
 }
 
-func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteCompanyUUIDGroupEntityUUID200Response) VisitDeleteCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
+type PatchCompanyUUIDGroupEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+	Body       *PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
 }
 
-type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+type PatchCompanyUUIDGroupEntityUUIDResponseObject interface {
+	VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchFederationUUIDAgentEntityUUID200Response struct {
+type PatchCompanyUUIDGroupEntityUUID200Response struct {
 }
 
-func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PatchCompanyUUIDGroupEntityUUID200Response) VisitPatchCompanyUUIDGroupEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetFederationUUIDInviteRequestObject struct {
+type PatchCompanyUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
+	Body *PatchCompanyUUIDNameJSONRequestBody
 }
 
-type GetFederationUUIDInviteResponseObject interface {
-	VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error
+type PatchCompanyUUIDNameResponseObject interface {
+	VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDInvite200JSONResponse struct {
-	Count int         `json:"count"`
-	Items []InviteDTO `json:"items"`
+type PatchCompanyUUIDName200Response struct {
 }
 
-func (response GetFederationUUIDInvite200JSONResponse) VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error {
+func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetCompanyUUIDPrioritiesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDPrioritiesResponseObject interface {
+	VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDPriorities200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []CompanyPriorityDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDPriorities200JSONResponse) VisitGetCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostFederationUUIDInviteRequestObject struct {
+type PostCompanyUUIDPrioritiesRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDInviteJSONRequestBody
+	Body *PostCompanyUUIDPrioritiesJSONRequestBody
 }
 
-type PostFederationUUIDInviteResponseObject interface {
-	VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error
+type PostCompanyUUIDPrioritiesResponseObject interface {
+	VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error
 }
 
-type PostFederationUUIDInvite200JSONResponse UUIDResponse
+type PostCompanyUUIDPriorities200JSONResponse UUIDResponse
 
-func (response PostFederationUUIDInvite200JSONResponse) VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDPriorities200JSONResponse) VisitPostCompanyUUIDPrioritiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDInviteEntityUUIDRequestObject struct {
+type DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteFederationUUIDInviteEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUIDInviteEntityUUID200Response struct {
+type DeleteCompanyUUIDPrioritiesEntityUUID200Response struct {
 }
 
-func (response DeleteFederationUUIDInviteEntityUUID200Response) VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteCompanyUUIDPrioritiesEntityUUID200Response) VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchFederationUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchFederationUUIDNameJSONRequestBody
+type PatchCompanyUUIDPrioritiesEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
 }
 
-type PatchFederationUUIDNameResponseObject interface {
-	VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error
+type PatchCompanyUUIDPrioritiesEntityUUIDResponseObject interface {
+	VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchFederationUUIDName200Response struct {
+type PatchCompanyUUIDPrioritiesEntityUUID200Response struct {
 }
 
-func (response PatchFederationUUIDName200Response) VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error {
+func (response PatchCompanyUUIDPrioritiesEntityUUID200Response) VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetFederationUUIDProjectRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDProjectParams
+type GetCompanyUUIDProjectCatalogEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
 }
 
-type GetFederationUUIDProjectResponseObject interface {
-	VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error
+type GetCompanyUUIDProjectCatalogEntityNameResponseObject interface {
+	VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDProject200JSONResponse struct {
-	Count int          `json:"count"`
-	Items []ProjectDTO `json:"items"`
+type GetCompanyUUIDProjectCatalogEntityName200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
 }
 
-func (response GetFederationUUIDProject200JSONResponse) VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error {
+func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCompanyUUIDProjectCatalogEntityNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostFederationUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDUserJSONRequestBody
+type GetCompanyUUIDSmsRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDSmsParams
 }
 
-type PostFederationUUIDUserResponseObject interface {
-	VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error
+type GetCompanyUUIDSmsResponseObject interface {
+	VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error
 }
 
-type PostFederationUUIDUser200JSONResponse UUIDResponse
+type GetCompanyUUIDSms200JSONResponse struct {
+	Count int      `json:"count"`
+	Items []SmsDTO `json:"items"`
+	Total int64    `json:"total"`
+}
 
-func (response PostFederationUUIDUser200JSONResponse) VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error {
+func (response GetCompanyUUIDSms200JSONResponse) VisitGetCompanyUUIDSmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
+type PostCompanyUUIDSmsCostRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDSmsCostJSONRequestBody
 }
 
-type DeleteFederationUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+type PostCompanyUUIDSmsCostResponseObject interface {
+	VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUIDUserUserUUID200Response struct {
-}
+type PostCompanyUUIDSmsCost200JSONResponse map[string]interface{}
 
-func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDSmsCost200JSONResponse) VisitPostCompanyUUIDSmsCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroupUUIDUserRequestObject struct {
+type PostCompanyUUIDSmsOptionsRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *DeleteGroupUUIDUserJSONRequestBody
+	Body *PostCompanyUUIDSmsOptionsJSONRequestBody
 }
 
-type DeleteGroupUUIDUserResponseObject interface {
-	VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error
+type PostCompanyUUIDSmsOptionsResponseObject interface {
+	VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error
 }
 
-type DeleteGroupUUIDUser200Response struct {
+type PostCompanyUUIDSmsOptions200Response struct {
 }
 
-func (response DeleteGroupUUIDUser200Response) VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDSmsOptions200Response) VisitPostCompanyUUIDSmsOptionsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetGroupUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type PostCompanyUUIDSmsSendRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params PostCompanyUUIDSmsSendParams
+	Body   *PostCompanyUUIDSmsSendJSONRequestBody
 }
 
-type GetGroupUUIDUserResponseObject interface {
-	VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error
+type PostCompanyUUIDSmsSendResponseObject interface {
+	VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error
 }
 
-type GetGroupUUIDUser200JSONResponse struct {
-	Count int       `json:"count"`
-	Items []UserDTO `json:"items"`
-}
+type PostCompanyUUIDSmsSend200JSONResponse map[string]interface{}
 
-func (response GetGroupUUIDUser200JSONResponse) VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDSmsSend200JSONResponse) VisitPostCompanyUUIDSmsSendResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostGroupUUIDUserRequestObject struct {
+type PostCompanyUUIDUserRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostGroupUUIDUserJSONRequestBody
-}
-
-type PostGroupUUIDUserResponseObject interface {
-	VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error
-}
-
-type PostGroupUUIDUser200Response struct {
-}
-
-func (response PostGroupUUIDUser200Response) VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type PostPermissionsRequestObject struct {
-	Body *PostPermissionsJSONRequestBody
+	Body *PostCompanyUUIDUserJSONRequestBody
 }
 
-type PostPermissionsResponseObject interface {
-	VisitPostPermissionsResponse(w http.ResponseWriter) error
+type PostCompanyUUIDUserResponseObject interface {
+	VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error
 }
 
-type PostPermissions200JSONResponse struct {
-	UpdatedAt time.Time          `json:"updated_at"`
-	UserUuid  openapi_types.UUID `json:"user_uuid"`
-}
+type PostCompanyUUIDUser200JSONResponse UUIDResponse
 
-func (response PostPermissions200JSONResponse) VisitPostPermissionsResponse(w http.ResponseWriter) error {
+func (response PostCompanyUUIDUser200JSONResponse) VisitPostCompanyUUIDUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePermissionsUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type DeleteCompanyUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
 }
 
-type DeletePermissionsUUIDResponseObject interface {
-	VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error
+type DeleteCompanyUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeletePermissionsUUID200Response struct {
+type DeleteCompanyUUIDUserUserUUID200Response struct {
 }
 
-func (response DeletePermissionsUUID200Response) VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetPermissionsUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type PostFederationRequestObject struct {
+	Body *PostFederationJSONRequestBody
 }
 
-type GetPermissionsUUIDResponseObject interface {
-	VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error
+type PostFederationResponseObject interface {
+	VisitPostFederationResponse(w http.ResponseWriter) error
 }
 
-type GetPermissionsUUID200JSONResponse struct {
-	CreatedAt      time.Time          `json:"created_at"`
-	FederationUuid openapi_types.UUID `json:"federation_uuid"`
-	Rules          PermissionRulesDTO `json:"rules"`
-	UpdatedAt      time.Time          `json:"updated_at"`
-	UserUuid       openapi_types.UUID `json:"user_uuid"`
-}
+type PostFederation200JSONResponse UUIDResponse
 
-func (response GetPermissionsUUID200JSONResponse) VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error {
+func (response PostFederation200JSONResponse) VisitPostFederationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProfileSurveyRequestObject struct {
-	Body *PostProfileSurveyJSONRequestBody
-}
-
-type PostProfileSurveyResponseObject interface {
-	VisitPostProfileSurveyResponse(w http.ResponseWriter) error
+type DeleteFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type PostProfileSurvey200JSONResponse UUIDResponse
-
-func (response PostProfileSurvey200JSONResponse) VisitPostProfileSurveyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type DeleteFederationUUIDResponseObject interface {
+	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteProfileSurveyUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type DeleteFederationUUID200Response struct {
 }
 
-type DeleteProfileSurveyUUIDResponseObject interface {
-	VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error
+func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-type DeleteProfileSurveyUUID200Response struct {
+type DeleteFederationUUID401Response struct {
 }
 
-func (response DeleteProfileSurveyUUID200Response) VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type GetProfileSurveyUUIDRequestObject struct {
+type GetFederationUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
 
-type GetProfileSurveyUUIDResponseObject interface {
-	VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error
+type GetFederationUUIDResponseObject interface {
+	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
 }
 
-type GetProfileSurveyUUID200JSONResponse SurveyDTO
+type GetFederationUUID200JSONResponse FederationDTO
 
-func (response GetProfileSurveyUUID200JSONResponse) VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjectRequestObject struct {
-	Body *PostProjectJSONRequestBody
+type GetFederationUUIDAgentRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDAgentParams
 }
 
-type PostProjectResponseObject interface {
-	VisitPostProjectResponse(w http.ResponseWriter) error
+type GetFederationUUIDAgentResponseObject interface {
+	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
 }
 
-type PostProject200JSONResponse UUIDResponse
+type GetFederationUUIDAgent200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []AgentDTO `json:"items"`
+	Total int64      `json:"total"`
+}
 
-func (response PostProject200JSONResponse) VisitPostProjectResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectUUIDRequestObject struct {
+type PostFederationUUIDAgentRequestObject struct {
 	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDAgentJSONRequestBody
 }
 
-type DeleteProjectUUIDResponseObject interface {
-	VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error
+type PostFederationUUIDAgentResponseObject interface {
+	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectUUID200Response struct {
-}
+type PostFederationUUIDAgent200JSONResponse UUIDResponse
 
-func (response DeleteProjectUUID200Response) VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error {
+func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type GetProjectUUIDResponseObject interface {
-	VisitGetProjectUUIDResponse(w http.ResponseWriter) error
+type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type GetProjectUUID200JSONResponse ProjectDTO
+type DeleteFederationUUIDAgentEntityUUID200Response struct {
+}
 
-func (response GetProjectUUID200JSONResponse) VisitGetProjectUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type PatchProjectUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDJSONRequestBody
+type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
 }
 
-type PatchProjectUUIDResponseObject interface {
-	VisitPatchProjectUUIDResponse(w http.ResponseWriter) error
+type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchProjectUUID200Response struct {
+type PatchFederationUUIDAgentEntityUUID200Response struct {
 }
 
-func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http.ResponseWriter) error {
+func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetProjectUUIDCatalogRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type GetFederationUUIDFileSearchRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDFileSearchParams
 }
 
-type GetProjectUUIDCatalogResponseObject interface {
-	VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error
+type GetFederationUUIDFileSearchResponseObject interface {
+	VisitGetFederationUUIDFileSearchResponse(w http.ResponseWriter) error
 }
 
-type GetProjectUUIDCatalog200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
+type GetFederationUUIDFileSearch200JSONResponse struct {
+	Count int             `json:"count"`
+	Items []FileSearchDTO `json:"items"`
 }
 
-func (response GetProjectUUIDCatalog200JSONResponse) VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDFileSearch200JSONResponse) VisitGetFederationUUIDFileSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjectUUIDCatalogRequestObject struct {
+type GetFederationUUIDInviteRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDCatalogJSONRequestBody
 }
 
-type PostProjectUUIDCatalogResponseObject interface {
-	VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error
+type GetFederationUUIDInviteResponseObject interface {
+	VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error
 }
 
-type PostProjectUUIDCatalog200JSONResponse struct {
-	Uuid openapi_types.UUID `json:"uuid"`
+type GetFederationUUIDInvite200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []InviteDTO `json:"items"`
 }
 
-func (response PostProjectUUIDCatalog200JSONResponse) VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDInvite200JSONResponse) VisitGetFederationUUIDInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectUUIDCatalogEntityNameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityName EntityName `json:"entityName"`
+type PostFederationUUIDInviteRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDInviteJSONRequestBody
 }
 
-type GetProjectUUIDCatalogEntityNameResponseObject interface {
-	VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error
+type PostFederationUUIDInviteResponseObject interface {
+	VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error
 }
 
-type GetProjectUUIDCatalogEntityName200JSONResponse struct {
-	Count int                     `json:"count"`
-	Items []ProjectCatalogDataDTO `json:"items"`
-}
+type PostFederationUUIDInvite200JSONResponse UUIDResponse
 
-func (response GetProjectUUIDCatalogEntityName200JSONResponse) VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error {
+func (response PostFederationUUIDInvite200JSONResponse) VisitPostFederationUUIDInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectUUIDCatalogEntityUUIDRequestObject struct {
+type DeleteFederationUUIDInviteEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteProjectUUIDCatalogEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteFederationUUIDInviteEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectUUIDCatalogEntityUUID200Response struct {
+type DeleteFederationUUIDInviteEntityUUID200Response struct {
 }
 
-func (response DeleteProjectUUIDCatalogEntityUUID200Response) VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteFederationUUIDInviteEntityUUID200Response) VisitDeleteFederationUUIDInviteEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchProjectUUIDDescriptionRequestObject struct {
+type PatchFederationUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDDescriptionJSONRequestBody
+	Body *PatchFederationUUIDNameJSONRequestBody
 }
 
-type PatchProjectUUIDDescriptionResponseObject interface {
-	VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error
+type PatchFederationUUIDNameResponseObject interface {
+	VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error
 }
 
-type PatchProjectUUIDDescription200Response struct {
+type PatchFederationUUIDName200Response struct {
 }
 
-func (response PatchProjectUUIDDescription200Response) VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error {
+func (response PatchFederationUUIDName200Response) VisitPatchFederationUUIDNameResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type DeleteProjectUUIDFieldEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+type GetFederationUUIDProjectRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDProjectParams
 }
 
-type DeleteProjectUUIDFieldEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+type GetFederationUUIDProjectResponseObject interface {
+	VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectUUIDFieldEntityUUID200Response struct {
+type GetFederationUUIDProject200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []ProjectDTO `json:"items"`
 }
 
-func (response DeleteProjectUUIDFieldEntityUUID200Response) VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDProject200JSONResponse) VisitGetFederationUUIDProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
-
-type PostProjectUUIDFieldEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PostProjectUUIDFieldEntityUUIDJSONRequestBody
-}
-
-type PostProjectUUIDFieldEntityUUIDResponseObject interface {
-	VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
-}
-
-type PostProjectUUIDFieldEntityUUID200Response struct {
-}
 
-func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchProjectUUIDGraphRequestObject struct {
+type GetFederationUUIDStorageRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDGraphJSONRequestBody
 }
 
-type PatchProjectUUIDGraphResponseObject interface {
-	VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error
+type GetFederationUUIDStorageResponseObject interface {
+	VisitGetFederationUUIDStorageResponse(w http.ResponseWriter) error
 }
 
-type PatchProjectUUIDGraph200JSONResponse map[string]interface{}
+type GetFederationUUIDStorage200JSONResponse StorageUsageDTO
 
-func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDStorage200JSONResponse) VisitGetFederationUUIDStorageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchProjectUUIDNameRequestObject struct {
+type PostFederationUUIDUserRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDNameJSONRequestBody
+	Body *PostFederationUUIDUserJSONRequestBody
 }
 
-type PatchProjectUUIDNameResponseObject interface {
-	VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error
+type PostFederationUUIDUserResponseObject interface {
+	VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error
 }
 
-type PatchProjectUUIDName200Response struct {
-}
+type PostFederationUUIDUser200JSONResponse UUIDResponse
 
-func (response PatchProjectUUIDName200Response) VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error {
+func (response PostFederationUUIDUser200JSONResponse) VisitPostFederationUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchProjectUUIDOptionsRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchProjectUUIDOptionsJSONRequestBody
+type DeleteFederationUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
 }
 
-type PatchProjectUUIDOptionsResponseObject interface {
-	VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error
+type DeleteFederationUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchProjectUUIDOptions200Response struct {
+type DeleteFederationUUIDUserUserUUID200Response struct {
 }
 
-func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error {
+func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederationUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetProjectUUIDStatusRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type GetFederationUUIDWebhookRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDWebhookParams
 }
 
-type GetProjectUUIDStatusResponseObject interface {
-	VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error
+type GetFederationUUIDWebhookResponseObject interface {
+	VisitGetFederationUUIDWebhookResponse(w http.ResponseWriter) error
 }
 
-type GetProjectUUIDStatus200JSONResponse struct {
-	Count int                `json:"count"`
-	Items []ProjectStatusDTO `json:"items"`
+type GetFederationUUIDWebhook200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []WebhookDTO `json:"items"`
+	Total int64        `json:"total"`
 }
 
-func (response GetProjectUUIDStatus200JSONResponse) VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDWebhook200JSONResponse) VisitGetFederationUUIDWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjectUUIDStatusRequestObject struct {
+type PostFederationUUIDWebhookRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDStatusJSONRequestBody
+	Body *PostFederationUUIDWebhookJSONRequestBody
 }
 
-type PostProjectUUIDStatusResponseObject interface {
-	VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error
+type PostFederationUUIDWebhookResponseObject interface {
+	VisitPostFederationUUIDWebhookResponse(w http.ResponseWriter) error
 }
 
-type PostProjectUUIDStatus200JSONResponse UUIDResponse
+type PostFederationUUIDWebhook200JSONResponse struct {
+	Secret string             `json:"secret"`
+	Uuid   openapi_types.UUID `json:"uuid"`
+}
 
-func (response PostProjectUUIDStatus200JSONResponse) VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error {
+func (response PostFederationUUIDWebhook200JSONResponse) VisitPostFederationUUIDWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectUUIDStatusEntityUUIDRequestObject struct {
+type DeleteFederationUUIDWebhookEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
 }
 
-type DeleteProjectUUIDStatusEntityUUIDResponseObject interface {
-	VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+type DeleteFederationUUIDWebhookEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDWebhookEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectUUIDStatusEntityUUID200Response struct {
+type DeleteFederationUUIDWebhookEntityUUID200Response struct {
 }
 
-func (response DeleteProjectUUIDStatusEntityUUID200Response) VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+func (response DeleteFederationUUIDWebhookEntityUUID200Response) VisitDeleteFederationUUIDWebhookEntityUUIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchProjectUUIDStatusEntityUUIDRequestObject struct {
+type PatchFederationUUIDWebhookEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchProjectUUIDStatusEntityUUIDJSONRequestBody
+	Body       *PatchFederationUUIDWebhookEntityUUIDJSONRequestBody
 }
 
-type PatchProjectUUIDStatusEntityUUIDResponseObject interface {
-	VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+type PatchFederationUUIDWebhookEntityUUIDResponseObject interface {
+	VisitPatchFederationUUIDWebhookEntityUUIDResponse(w http.ResponseWriter) error
 }
 
-type PatchProjectUUIDStatusEntityUUID200Response struct {
-}
+type PatchFederationUUIDWebhookEntityUUID200JSONResponse WebhookDTO
 
-func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PatchFederationUUIDWebhookEntityUUID200JSONResponse) VisitPatchFederationUUIDWebhookEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjectUUIDUserRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PostProjectUUIDUserJSONRequestBody
+type GetFederationUUIDWebhookEntityUUIDDeliveryRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetFederationUUIDWebhookEntityUUIDDeliveryParams
 }
 
-type PostProjectUUIDUserResponseObject interface {
-	VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error
+type GetFederationUUIDWebhookEntityUUIDDeliveryResponseObject interface {
+	VisitGetFederationUUIDWebhookEntityUUIDDeliveryResponse(w http.ResponseWriter) error
 }
 
-type PostProjectUUIDUser200JSONResponse UUIDResponse
+type GetFederationUUIDWebhookEntityUUIDDelivery200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []WebhookDeliveryDTO `json:"items"`
+	Total int64                `json:"total"`
+}
 
-func (response PostProjectUUIDUser200JSONResponse) VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDWebhookEntityUUIDDelivery200JSONResponse) VisitGetFederationUUIDWebhookEntityUUIDDeliveryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectUUIDUserUserUUIDRequestObject struct {
-	UUID     Uuid     `json:"UUID"`
-	UserUUID UserUUID `json:"userUUID"`
+type PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverRequestObject struct {
+	UUID         Uuid         `json:"UUID"`
+	EntityUUID   EntityUUID   `json:"entityUUID"`
+	DeliveryUUID DeliveryUUID `json:"deliveryUUID"`
 }
 
-type DeleteProjectUUIDUserUserUUIDResponseObject interface {
-	VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+type PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponseObject interface {
+	VisitPostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectUUIDUserUserUUID200Response struct {
-}
+type PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver200JSONResponse UUIDResponse
 
-func (response DeleteProjectUUIDUserUserUUID200Response) VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+func (response PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver200JSONResponse) VisitPostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTagRequestObject struct {
-	Params GetTagParams
+type GetFederationUUIDWorkloadRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDWorkloadParams
 }
 
-type GetTagResponseObject interface {
-	VisitGetTagResponse(w http.ResponseWriter) error
+type GetFederationUUIDWorkloadResponseObject interface {
+	VisitGetFederationUUIDWorkloadResponse(w http.ResponseWriter) error
 }
 
-type GetTag200JSONResponse struct {
-	Count int      `json:"count"`
-	Items []TagDTO `json:"items"`
+type GetFederationUUIDWorkload200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []WorkloadDTO `json:"items"`
 }
 
-func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDWorkload200JSONResponse) VisitGetFederationUUIDWorkloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostTagRequestObject struct {
-	Body *PostTagJSONRequestBody
+type DeleteGroupUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *DeleteGroupUUIDUserJSONRequestBody
 }
 
-type PostTagResponseObject interface {
-	VisitPostTagResponse(w http.ResponseWriter) error
+type DeleteGroupUUIDUserResponseObject interface {
+	VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error
 }
 
-type PostTag200JSONResponse UUIDResponse
+type DeleteGroupUUIDUser200Response struct {
+}
 
-func (response PostTag200JSONResponse) VisitPostTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response DeleteGroupUUIDUser200Response) VisitDeleteGroupUUIDUserResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type DeleteTagUUIDRequestObject struct {
+type GetGroupUUIDUserRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
 
-type DeleteTagUUIDResponseObject interface {
-	VisitDeleteTagUUIDResponse(w http.ResponseWriter) error
+type GetGroupUUIDUserResponseObject interface {
+	VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error
 }
 
-type DeleteTagUUID200Response struct {
+type GetGroupUUIDUser200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []UserDTO `json:"items"`
 }
 
-func (response DeleteTagUUID200Response) VisitDeleteTagUUIDResponse(w http.ResponseWriter) error {
+func (response GetGroupUUIDUser200JSONResponse) VisitGetGroupUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchTagUUIDRequestObject struct {
+type PostGroupUUIDUserRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PatchTagUUIDJSONRequestBody
+	Body *PostGroupUUIDUserJSONRequestBody
 }
 
-type PatchTagUUIDResponseObject interface {
-	VisitPatchTagUUIDResponse(w http.ResponseWriter) error
+type PostGroupUUIDUserResponseObject interface {
+	VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error
 }
 
-type PatchTagUUID200Response struct {
+type PostGroupUUIDUser200Response struct {
 }
 
-func (response PatchTagUUID200Response) VisitPatchTagUUIDResponse(w http.ResponseWriter) error {
+func (response PostGroupUUIDUser200Response) VisitPostGroupUUIDUserResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type GetUserRequestObject struct {
-	Body *GetUserJSONRequestBody
+type PostPermissionsRequestObject struct {
+	Body *PostPermissionsJSONRequestBody
 }
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
+type PostPermissionsResponseObject interface {
+	VisitPostPermissionsResponse(w http.ResponseWriter) error
 }
 
-type GetUser200JSONResponse struct {
-	Count int       `json:"count"`
-	Items []UserDTO `json:"items"`
+type PostPermissions200JSONResponse struct {
+	UpdatedAt time.Time          `json:"updated_at"`
+	UserUuid  openapi_types.UUID `json:"user_uuid"`
 }
 
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+func (response PostPermissions200JSONResponse) VisitPostPermissionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-
-	// (POST /company)
-	PostCompany(ctx context.Context, request PostCompanyRequestObject) (PostCompanyResponseObject, error)
+type DeletePermissionsUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (DELETE /company/{UUID})
-	DeleteCompanyUUID(ctx context.Context, request DeleteCompanyUUIDRequestObject) (DeleteCompanyUUIDResponseObject, error)
+type DeletePermissionsUUIDResponseObject interface {
+	VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (GET /company/{UUID})
-	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
+type DeletePermissionsUUID200Response struct {
+}
 
-	// (GET /company/{UUID}/fields)
-	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
+func (response DeletePermissionsUUID200Response) VisitDeletePermissionsUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (POST /company/{UUID}/fields)
-	PostCompanyUUIDFields(ctx context.Context, request PostCompanyUUIDFieldsRequestObject) (PostCompanyUUIDFieldsResponseObject, error)
+type GetPermissionsUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (DELETE /company/{UUID}/fields/{entityUUID})
-	DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error)
+type GetPermissionsUUIDResponseObject interface {
+	VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (PUT /company/{UUID}/fields/{entityUUID})
-	PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request PutCompanyUUIDFieldsEntityUUIDRequestObject) (PutCompanyUUIDFieldsEntityUUIDResponseObject, error)
+type GetPermissionsUUID200JSONResponse struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	FederationUuid openapi_types.UUID `json:"federation_uuid"`
+	Rules          PermissionRulesDTO `json:"rules"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	UserUuid       openapi_types.UUID `json:"user_uuid"`
+}
 
-	// (GET /company/{UUID}/group)
-	GetCompanyUUIDGroup(ctx context.Context, request GetCompanyUUIDGroupRequestObject) (GetCompanyUUIDGroupResponseObject, error)
+func (response GetPermissionsUUID200JSONResponse) VisitGetPermissionsUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (POST /company/{UUID}/group)
-	PostCompanyUUIDGroup(ctx context.Context, request PostCompanyUUIDGroupRequestObject) (PostCompanyUUIDGroupResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (DELETE /company/{UUID}/group/{entityUUID})
-	DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request DeleteCompanyUUIDGroupEntityUUIDRequestObject) (DeleteCompanyUUIDGroupEntityUUIDResponseObject, error)
+type PostProfileSurveyRequestObject struct {
+	Body *PostProfileSurveyJSONRequestBody
+}
 
-	// (PATCH /company/{UUID}/group/{entityUUID})
-	PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request PatchCompanyUUIDGroupEntityUUIDRequestObject) (PatchCompanyUUIDGroupEntityUUIDResponseObject, error)
+type PostProfileSurveyResponseObject interface {
+	VisitPostProfileSurveyResponse(w http.ResponseWriter) error
+}
 
-	// (PATCH /company/{UUID}/name)
-	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
+type PostProfileSurvey200JSONResponse UUIDResponse
 
-	// (GET /company/{UUID}/priorities)
-	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
+func (response PostProfileSurvey200JSONResponse) VisitPostProfileSurveyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (POST /company/{UUID}/priorities)
-	PostCompanyUUIDPriorities(ctx context.Context, request PostCompanyUUIDPrioritiesRequestObject) (PostCompanyUUIDPrioritiesResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (DELETE /company/{UUID}/priorities/{entityUUID})
-	DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+type DeleteProfileSurveyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (PATCH /company/{UUID}/priorities/{entityUUID})
-	PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+type DeleteProfileSurveyUUIDResponseObject interface {
+	VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (GET /company/{UUID}/project/catalog/{entityName})
-	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
+type DeleteProfileSurveyUUID200Response struct {
+}
 
-	// (GET /company/{UUID}/sms)
-	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
+func (response DeleteProfileSurveyUUID200Response) VisitDeleteProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (POST /company/{UUID}/sms/cost)
-	PostCompanyUUIDSmsCost(ctx context.Context, request PostCompanyUUIDSmsCostRequestObject) (PostCompanyUUIDSmsCostResponseObject, error)
+type GetProfileSurveyUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (POST /company/{UUID}/sms/options)
-	PostCompanyUUIDSmsOptions(ctx context.Context, request PostCompanyUUIDSmsOptionsRequestObject) (PostCompanyUUIDSmsOptionsResponseObject, error)
+type GetProfileSurveyUUIDResponseObject interface {
+	VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (POST /company/{UUID}/sms/send)
-	PostCompanyUUIDSmsSend(ctx context.Context, request PostCompanyUUIDSmsSendRequestObject) (PostCompanyUUIDSmsSendResponseObject, error)
+type GetProfileSurveyUUID200JSONResponse SurveyDTO
 
-	// (POST /company/{UUID}/user)
-	PostCompanyUUIDUser(ctx context.Context, request PostCompanyUUIDUserRequestObject) (PostCompanyUUIDUserResponseObject, error)
+func (response GetProfileSurveyUUID200JSONResponse) VisitGetProfileSurveyUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (DELETE /company/{UUID}/user/{userUUID})
-	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (POST /federation)
-	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
+type PostProjectRequestObject struct {
+	Body *PostProjectJSONRequestBody
+}
 
-	// (DELETE /federation/{UUID})
-	DeleteFederationUUID(ctx context.Context, request DeleteFederationUUIDRequestObject) (DeleteFederationUUIDResponseObject, error)
+type PostProjectResponseObject interface {
+	VisitPostProjectResponse(w http.ResponseWriter) error
+}
 
-	// (GET /federation/{UUID})
-	GetFederationUUID(ctx context.Context, request GetFederationUUIDRequestObject) (GetFederationUUIDResponseObject, error)
+type PostProject200JSONResponse UUIDResponse
 
-	// (GET /federation/{UUID}/agent)
-	GetFederationUUIDAgent(ctx context.Context, request GetFederationUUIDAgentRequestObject) (GetFederationUUIDAgentResponseObject, error)
+func (response PostProject200JSONResponse) VisitPostProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (POST /federation/{UUID}/agent)
-	PostFederationUUIDAgent(ctx context.Context, request PostFederationUUIDAgentRequestObject) (PostFederationUUIDAgentResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (DELETE /federation/{UUID}/agent/{entityUUID})
-	DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDResponseObject, error)
+type DeleteProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (PATCH /federation/{UUID}/agent/{entityUUID})
-	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
+type DeleteProjectUUIDResponseObject interface {
+	VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (GET /federation/{UUID}/invite)
-	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
+type DeleteProjectUUID200Response struct {
+}
 
-	// (POST /federation/{UUID}/invite)
-	PostFederationUUIDInvite(ctx context.Context, request PostFederationUUIDInviteRequestObject) (PostFederationUUIDInviteResponseObject, error)
+func (response DeleteProjectUUID200Response) VisitDeleteProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (DELETE /federation/{UUID}/invite/{entityUUID})
-	DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request DeleteFederationUUIDInviteEntityUUIDRequestObject) (DeleteFederationUUIDInviteEntityUUIDResponseObject, error)
+type GetProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (PATCH /federation/{UUID}/name)
-	PatchFederationUUIDName(ctx context.Context, request PatchFederationUUIDNameRequestObject) (PatchFederationUUIDNameResponseObject, error)
+type GetProjectUUIDResponseObject interface {
+	VisitGetProjectUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (GET /federation/{UUID}/project)
-	GetFederationUUIDProject(ctx context.Context, request GetFederationUUIDProjectRequestObject) (GetFederationUUIDProjectResponseObject, error)
+type GetProjectUUID200JSONResponse ProjectDTO
 
-	// (POST /federation/{UUID}/user)
-	PostFederationUUIDUser(ctx context.Context, request PostFederationUUIDUserRequestObject) (PostFederationUUIDUserResponseObject, error)
+func (response GetProjectUUID200JSONResponse) VisitGetProjectUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (DELETE /federation/{UUID}/user/{userUUID})
-	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (DELETE /group/{UUID}/user)
-	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
+type PatchProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDJSONRequestBody
+}
 
-	// (GET /group/{UUID}/user)
-	GetGroupUUIDUser(ctx context.Context, request GetGroupUUIDUserRequestObject) (GetGroupUUIDUserResponseObject, error)
+type PatchProjectUUIDResponseObject interface {
+	VisitPatchProjectUUIDResponse(w http.ResponseWriter) error
+}
 
-	// (POST /group/{UUID}/user)
-	PostGroupUUIDUser(ctx context.Context, request PostGroupUUIDUserRequestObject) (PostGroupUUIDUserResponseObject, error)
+type PatchProjectUUID200Response struct {
+}
 
-	// (POST /permissions)
-	PostPermissions(ctx context.Context, request PostPermissionsRequestObject) (PostPermissionsResponseObject, error)
+func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (DELETE /permissions/{UUID})
-	DeletePermissionsUUID(ctx context.Context, request DeletePermissionsUUIDRequestObject) (DeletePermissionsUUIDResponseObject, error)
+type GetProjectUUIDAnalyticsRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDAnalyticsParams
+}
 
-	// (GET /permissions/{UUID})
-	GetPermissionsUUID(ctx context.Context, request GetPermissionsUUIDRequestObject) (GetPermissionsUUIDResponseObject, error)
+type GetProjectUUIDAnalyticsResponseObject interface {
+	VisitGetProjectUUIDAnalyticsResponse(w http.ResponseWriter) error
+}
 
-	// (POST /profile/survey)
-	PostProfileSurvey(ctx context.Context, request PostProfileSurveyRequestObject) (PostProfileSurveyResponseObject, error)
+type GetProjectUUIDAnalytics200JSONResponse AnalyticsDTO
 
-	// (DELETE /profile/survey/{UUID})
-	DeleteProfileSurveyUUID(ctx context.Context, request DeleteProfileSurveyUUIDRequestObject) (DeleteProfileSurveyUUIDResponseObject, error)
+func (response GetProjectUUIDAnalytics200JSONResponse) VisitGetProjectUUIDAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (GET /profile/survey/{UUID})
-	GetProfileSurveyUUID(ctx context.Context, request GetProfileSurveyUUIDRequestObject) (GetProfileSurveyUUIDResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (POST /project)
-	PostProject(ctx context.Context, request PostProjectRequestObject) (PostProjectResponseObject, error)
+type DeleteProjectUUIDApprovalPolicyRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (DELETE /project/{UUID})
-	DeleteProjectUUID(ctx context.Context, request DeleteProjectUUIDRequestObject) (DeleteProjectUUIDResponseObject, error)
+type DeleteProjectUUIDApprovalPolicyResponseObject interface {
+	VisitDeleteProjectUUIDApprovalPolicyResponse(w http.ResponseWriter) error
+}
 
-	// (GET /project/{UUID})
-	GetProjectUUID(ctx context.Context, request GetProjectUUIDRequestObject) (GetProjectUUIDResponseObject, error)
+type DeleteProjectUUIDApprovalPolicy200Response struct {
+}
 
-	// (PATCH /project/{UUID})
-	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
+func (response DeleteProjectUUIDApprovalPolicy200Response) VisitDeleteProjectUUIDApprovalPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (GET /project/{UUID}/catalog)
-	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
+type GetProjectUUIDApprovalPolicyRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
 
-	// (POST /project/{UUID}/catalog)
-	PostProjectUUIDCatalog(ctx context.Context, request PostProjectUUIDCatalogRequestObject) (PostProjectUUIDCatalogResponseObject, error)
+type GetProjectUUIDApprovalPolicyResponseObject interface {
+	VisitGetProjectUUIDApprovalPolicyResponse(w http.ResponseWriter) error
+}
 
-	// (GET /project/{UUID}/catalog/{entityName})
-	GetProjectUUIDCatalogEntityName(ctx context.Context, request GetProjectUUIDCatalogEntityNameRequestObject) (GetProjectUUIDCatalogEntityNameResponseObject, error)
+type GetProjectUUIDApprovalPolicy200JSONResponse ApprovalPolicyDTO
 
-	// (DELETE /project/{UUID}/catalog/{entityUUID})
-	DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request DeleteProjectUUIDCatalogEntityUUIDRequestObject) (DeleteProjectUUIDCatalogEntityUUIDResponseObject, error)
+func (response GetProjectUUIDApprovalPolicy200JSONResponse) VisitGetProjectUUIDApprovalPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (PATCH /project/{UUID}/description)
-	PatchProjectUUIDDescription(ctx context.Context, request PatchProjectUUIDDescriptionRequestObject) (PatchProjectUUIDDescriptionResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProjectUUIDApprovalPolicyRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutProjectUUIDApprovalPolicyJSONRequestBody
+}
+
+type PutProjectUUIDApprovalPolicyResponseObject interface {
+	VisitPutProjectUUIDApprovalPolicyResponse(w http.ResponseWriter) error
+}
+
+type PutProjectUUIDApprovalPolicy200JSONResponse ApprovalPolicyDTO
+
+func (response PutProjectUUIDApprovalPolicy200JSONResponse) VisitPutProjectUUIDApprovalPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDCatalogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDCatalogResponseObject interface {
+	VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDCatalog200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetProjectUUIDCatalog200JSONResponse) VisitGetProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDCatalogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDCatalogJSONRequestBody
+}
+
+type PostProjectUUIDCatalogResponseObject interface {
+	VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDCatalog200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostProjectUUIDCatalog200JSONResponse) VisitPostProjectUUIDCatalogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDCatalogEntityNameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityName EntityName `json:"entityName"`
+}
+
+type GetProjectUUIDCatalogEntityNameResponseObject interface {
+	VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDCatalogEntityName200JSONResponse struct {
+	Count int                     `json:"count"`
+	Items []ProjectCatalogDataDTO `json:"items"`
+}
+
+func (response GetProjectUUIDCatalogEntityName200JSONResponse) VisitGetProjectUUIDCatalogEntityNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDCatalogEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDCatalogEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDCatalogEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDCatalogEntityUUID200Response) VisitDeleteProjectUUIDCatalogEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDDescriptionRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDDescriptionJSONRequestBody
+}
+
+type PatchProjectUUIDDescriptionResponseObject interface {
+	VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDDescription200Response struct {
+}
+
+func (response PatchProjectUUIDDescription200Response) VisitPatchProjectUUIDDescriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProjectUUIDFieldEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDFieldEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDFieldEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDFieldEntityUUID200Response) VisitDeleteProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProjectUUIDFieldEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostProjectUUIDFieldEntityUUIDJSONRequestBody
+}
+
+type PostProjectUUIDFieldEntityUUIDResponseObject interface {
+	VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDFieldEntityUUID200Response struct {
+}
+
+func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFieldEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDGraphRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDGraphJSONRequestBody
+}
+
+type PatchProjectUUIDGraphResponseObject interface {
+	VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDGraph200JSONResponse map[string]interface{}
+
+func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDInboundRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDInboundResponseObject interface {
+	VisitGetProjectUUIDInboundResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDInbound200JSONResponse struct {
+	Count int                 `json:"count"`
+	Items []InboundWebhookDTO `json:"items"`
+}
+
+func (response GetProjectUUIDInbound200JSONResponse) VisitGetProjectUUIDInboundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDInboundRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDInboundJSONRequestBody
+}
+
+type PostProjectUUIDInboundResponseObject interface {
+	VisitPostProjectUUIDInboundResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDInbound200JSONResponse InboundWebhookDTO
+
+func (response PostProjectUUIDInbound200JSONResponse) VisitPostProjectUUIDInboundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDInboundEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDInboundEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDInboundEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDInboundEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDInboundEntityUUID200Response) VisitDeleteProjectUUIDInboundEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDInboundEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchProjectUUIDInboundEntityUUIDJSONRequestBody
+}
+
+type PatchProjectUUIDInboundEntityUUIDResponseObject interface {
+	VisitPatchProjectUUIDInboundEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDInboundEntityUUID200JSONResponse InboundWebhookDTO
+
+func (response PatchProjectUUIDInboundEntityUUID200JSONResponse) VisitPatchProjectUUIDInboundEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDMailboxRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDMailboxResponseObject interface {
+	VisitGetProjectUUIDMailboxResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDMailbox200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []MailboxDTO `json:"items"`
+}
+
+func (response GetProjectUUIDMailbox200JSONResponse) VisitGetProjectUUIDMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDMailboxRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDMailboxJSONRequestBody
+}
+
+type PostProjectUUIDMailboxResponseObject interface {
+	VisitPostProjectUUIDMailboxResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDMailbox200JSONResponse MailboxDTO
+
+func (response PostProjectUUIDMailbox200JSONResponse) VisitPostProjectUUIDMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDMailboxEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDMailboxEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDMailboxEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDMailboxEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDMailboxEntityUUID200Response) VisitDeleteProjectUUIDMailboxEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDMailboxEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchProjectUUIDMailboxEntityUUIDJSONRequestBody
+}
+
+type PatchProjectUUIDMailboxEntityUUIDResponseObject interface {
+	VisitPatchProjectUUIDMailboxEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDMailboxEntityUUID200JSONResponse MailboxDTO
+
+func (response PatchProjectUUIDMailboxEntityUUID200JSONResponse) VisitPatchProjectUUIDMailboxEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProjectUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDNameJSONRequestBody
+}
+
+type PatchProjectUUIDNameResponseObject interface {
+	VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDName200Response struct {
+}
+
+func (response PatchProjectUUIDName200Response) VisitPatchProjectUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDOptionsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProjectUUIDOptionsJSONRequestBody
+}
+
+type PatchProjectUUIDOptionsResponseObject interface {
+	VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDOptions200Response struct {
+}
+
+func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDStatisticHistoryRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDStatisticHistoryParams
+}
+
+type GetProjectUUIDStatisticHistoryResponseObject interface {
+	VisitGetProjectUUIDStatisticHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDStatisticHistory200JSONResponse struct {
+	Count int                           `json:"count"`
+	Items []ProjectStatisticSnapshotDTO `json:"items"`
+}
+
+func (response GetProjectUUIDStatisticHistory200JSONResponse) VisitGetProjectUUIDStatisticHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDStatusResponseObject interface {
+	VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDStatus200JSONResponse struct {
+	Count int                `json:"count"`
+	Items []ProjectStatusDTO `json:"items"`
+}
+
+func (response GetProjectUUIDStatus200JSONResponse) VisitGetProjectUUIDStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDStatusJSONRequestBody
+}
+
+type PostProjectUUIDStatusResponseObject interface {
+	VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDStatus200JSONResponse UUIDResponse
+
+func (response PostProjectUUIDStatus200JSONResponse) VisitPostProjectUUIDStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDStatusEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDStatusEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDStatusEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDStatusEntityUUID200Response) VisitDeleteProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProjectUUIDStatusEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchProjectUUIDStatusEntityUUIDJSONRequestBody
+}
+
+type PatchProjectUUIDStatusEntityUUIDResponseObject interface {
+	VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchProjectUUIDStatusEntityUUID200Response struct {
+}
+
+func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUIDStatusEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDTimelineRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDTimelineParams
+}
+
+type GetProjectUUIDTimelineResponseObject interface {
+	VisitGetProjectUUIDTimelineResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDTimeline200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []TimelineItemDTO `json:"items"`
+}
+
+func (response GetProjectUUIDTimeline200JSONResponse) VisitGetProjectUUIDTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDUserRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDUserJSONRequestBody
+}
+
+type PostProjectUUIDUserResponseObject interface {
+	VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDUser200JSONResponse UUIDResponse
+
+func (response PostProjectUUIDUser200JSONResponse) VisitPostProjectUUIDUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDUserUserUUIDRequestObject struct {
+	UUID     Uuid     `json:"UUID"`
+	UserUUID UserUUID `json:"userUUID"`
+}
+
+type DeleteProjectUUIDUserUserUUIDResponseObject interface {
+	VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDUserUserUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDUserUserUUID200Response) VisitDeleteProjectUUIDUserUserUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTagRequestObject struct {
+	Params GetTagParams
+}
+
+type GetTagResponseObject interface {
+	VisitGetTagResponse(w http.ResponseWriter) error
+}
+
+type GetTag200JSONResponse struct {
+	Count int      `json:"count"`
+	Items []TagDTO `json:"items"`
+}
+
+func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTagRequestObject struct {
+	Body *PostTagJSONRequestBody
+}
+
+type PostTagResponseObject interface {
+	VisitPostTagResponse(w http.ResponseWriter) error
+}
+
+type PostTag200JSONResponse UUIDResponse
+
+func (response PostTag200JSONResponse) VisitPostTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteTagUUIDResponseObject interface {
+	VisitDeleteTagUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTagUUID200Response struct {
+}
+
+func (response DeleteTagUUID200Response) VisitDeleteTagUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTagUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTagUUIDJSONRequestBody
+}
+
+type PatchTagUUIDResponseObject interface {
+	VisitPatchTagUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchTagUUID200Response struct {
+}
+
+func (response PatchTagUUID200Response) VisitPatchTagUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetUserRequestObject struct {
+	Body *GetUserJSONRequestBody
+}
+
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []UserDTO `json:"items"`
+}
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /company)
+	PostCompany(ctx context.Context, request PostCompanyRequestObject) (PostCompanyResponseObject, error)
+
+	// (DELETE /company/{UUID})
+	DeleteCompanyUUID(ctx context.Context, request DeleteCompanyUUIDRequestObject) (DeleteCompanyUUIDResponseObject, error)
+
+	// (GET /company/{UUID})
+	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/fields)
+	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
+
+	// (POST /company/{UUID}/fields)
+	PostCompanyUUIDFields(ctx context.Context, request PostCompanyUUIDFieldsRequestObject) (PostCompanyUUIDFieldsResponseObject, error)
+
+	// (DELETE /company/{UUID}/fields/{entityUUID})
+	DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request DeleteCompanyUUIDFieldsEntityUUIDRequestObject) (DeleteCompanyUUIDFieldsEntityUUIDResponseObject, error)
+
+	// (PUT /company/{UUID}/fields/{entityUUID})
+	PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request PutCompanyUUIDFieldsEntityUUIDRequestObject) (PutCompanyUUIDFieldsEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/group)
+	GetCompanyUUIDGroup(ctx context.Context, request GetCompanyUUIDGroupRequestObject) (GetCompanyUUIDGroupResponseObject, error)
+
+	// (POST /company/{UUID}/group)
+	PostCompanyUUIDGroup(ctx context.Context, request PostCompanyUUIDGroupRequestObject) (PostCompanyUUIDGroupResponseObject, error)
+
+	// (DELETE /company/{UUID}/group/{entityUUID})
+	DeleteCompanyUUIDGroupEntityUUID(ctx context.Context, request DeleteCompanyUUIDGroupEntityUUIDRequestObject) (DeleteCompanyUUIDGroupEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/group/{entityUUID})
+	PatchCompanyUUIDGroupEntityUUID(ctx context.Context, request PatchCompanyUUIDGroupEntityUUIDRequestObject) (PatchCompanyUUIDGroupEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/name)
+	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
+
+	// (GET /company/{UUID}/priorities)
+	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
+
+	// (POST /company/{UUID}/priorities)
+	PostCompanyUUIDPriorities(ctx context.Context, request PostCompanyUUIDPrioritiesRequestObject) (PostCompanyUUIDPrioritiesResponseObject, error)
+
+	// (DELETE /company/{UUID}/priorities/{entityUUID})
+	DeleteCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject) (DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+
+	// (PATCH /company/{UUID}/priorities/{entityUUID})
+	PatchCompanyUUIDPrioritiesEntityUUID(ctx context.Context, request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject) (PatchCompanyUUIDPrioritiesEntityUUIDResponseObject, error)
+
+	// (GET /company/{UUID}/project/catalog/{entityName})
+	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
+
+	// (GET /company/{UUID}/sms)
+	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/cost)
+	PostCompanyUUIDSmsCost(ctx context.Context, request PostCompanyUUIDSmsCostRequestObject) (PostCompanyUUIDSmsCostResponseObject, error)
+
+	// (POST /company/{UUID}/sms/options)
+	PostCompanyUUIDSmsOptions(ctx context.Context, request PostCompanyUUIDSmsOptionsRequestObject) (PostCompanyUUIDSmsOptionsResponseObject, error)
+
+	// (POST /company/{UUID}/sms/send)
+	PostCompanyUUIDSmsSend(ctx context.Context, request PostCompanyUUIDSmsSendRequestObject) (PostCompanyUUIDSmsSendResponseObject, error)
+
+	// (POST /company/{UUID}/user)
+	PostCompanyUUIDUser(ctx context.Context, request PostCompanyUUIDUserRequestObject) (PostCompanyUUIDUserResponseObject, error)
+
+	// (DELETE /company/{UUID}/user/{userUUID})
+	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
+
+	// (POST /federation)
+	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
+
+	// (DELETE /federation/{UUID})
+	DeleteFederationUUID(ctx context.Context, request DeleteFederationUUIDRequestObject) (DeleteFederationUUIDResponseObject, error)
+
+	// (GET /federation/{UUID})
+	GetFederationUUID(ctx context.Context, request GetFederationUUIDRequestObject) (GetFederationUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/agent)
+	GetFederationUUIDAgent(ctx context.Context, request GetFederationUUIDAgentRequestObject) (GetFederationUUIDAgentResponseObject, error)
+
+	// (POST /federation/{UUID}/agent)
+	PostFederationUUIDAgent(ctx context.Context, request PostFederationUUIDAgentRequestObject) (PostFederationUUIDAgentResponseObject, error)
+
+	// (DELETE /federation/{UUID}/agent/{entityUUID})
+	DeleteFederationUUIDAgentEntityUUID(ctx context.Context, request DeleteFederationUUIDAgentEntityUUIDRequestObject) (DeleteFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/agent/{entityUUID})
+	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/file/search)
+	GetFederationUUIDFileSearch(ctx context.Context, request GetFederationUUIDFileSearchRequestObject) (GetFederationUUIDFileSearchResponseObject, error)
+
+	// (GET /federation/{UUID}/invite)
+	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
+
+	// (POST /federation/{UUID}/invite)
+	PostFederationUUIDInvite(ctx context.Context, request PostFederationUUIDInviteRequestObject) (PostFederationUUIDInviteResponseObject, error)
+
+	// (DELETE /federation/{UUID}/invite/{entityUUID})
+	DeleteFederationUUIDInviteEntityUUID(ctx context.Context, request DeleteFederationUUIDInviteEntityUUIDRequestObject) (DeleteFederationUUIDInviteEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/name)
+	PatchFederationUUIDName(ctx context.Context, request PatchFederationUUIDNameRequestObject) (PatchFederationUUIDNameResponseObject, error)
+
+	// (GET /federation/{UUID}/project)
+	GetFederationUUIDProject(ctx context.Context, request GetFederationUUIDProjectRequestObject) (GetFederationUUIDProjectResponseObject, error)
+
+	// (GET /federation/{UUID}/storage)
+	GetFederationUUIDStorage(ctx context.Context, request GetFederationUUIDStorageRequestObject) (GetFederationUUIDStorageResponseObject, error)
+
+	// (POST /federation/{UUID}/user)
+	PostFederationUUIDUser(ctx context.Context, request PostFederationUUIDUserRequestObject) (PostFederationUUIDUserResponseObject, error)
+
+	// (DELETE /federation/{UUID}/user/{userUUID})
+	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/webhook)
+	GetFederationUUIDWebhook(ctx context.Context, request GetFederationUUIDWebhookRequestObject) (GetFederationUUIDWebhookResponseObject, error)
+
+	// (POST /federation/{UUID}/webhook)
+	PostFederationUUIDWebhook(ctx context.Context, request PostFederationUUIDWebhookRequestObject) (PostFederationUUIDWebhookResponseObject, error)
+
+	// (DELETE /federation/{UUID}/webhook/{entityUUID})
+	DeleteFederationUUIDWebhookEntityUUID(ctx context.Context, request DeleteFederationUUIDWebhookEntityUUIDRequestObject) (DeleteFederationUUIDWebhookEntityUUIDResponseObject, error)
+
+	// (PATCH /federation/{UUID}/webhook/{entityUUID})
+	PatchFederationUUIDWebhookEntityUUID(ctx context.Context, request PatchFederationUUIDWebhookEntityUUIDRequestObject) (PatchFederationUUIDWebhookEntityUUIDResponseObject, error)
+
+	// (GET /federation/{UUID}/webhook/{entityUUID}/delivery)
+	GetFederationUUIDWebhookEntityUUIDDelivery(ctx context.Context, request GetFederationUUIDWebhookEntityUUIDDeliveryRequestObject) (GetFederationUUIDWebhookEntityUUIDDeliveryResponseObject, error)
+
+	// (POST /federation/{UUID}/webhook/{entityUUID}/delivery/{deliveryUUID}/redeliver)
+	PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx context.Context, request PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverRequestObject) (PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponseObject, error)
+
+	// (GET /federation/{UUID}/workload)
+	GetFederationUUIDWorkload(ctx context.Context, request GetFederationUUIDWorkloadRequestObject) (GetFederationUUIDWorkloadResponseObject, error)
+
+	// (DELETE /group/{UUID}/user)
+	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
+
+	// (GET /group/{UUID}/user)
+	GetGroupUUIDUser(ctx context.Context, request GetGroupUUIDUserRequestObject) (GetGroupUUIDUserResponseObject, error)
+
+	// (POST /group/{UUID}/user)
+	PostGroupUUIDUser(ctx context.Context, request PostGroupUUIDUserRequestObject) (PostGroupUUIDUserResponseObject, error)
+
+	// (POST /permissions)
+	PostPermissions(ctx context.Context, request PostPermissionsRequestObject) (PostPermissionsResponseObject, error)
+
+	// (DELETE /permissions/{UUID})
+	DeletePermissionsUUID(ctx context.Context, request DeletePermissionsUUIDRequestObject) (DeletePermissionsUUIDResponseObject, error)
+
+	// (GET /permissions/{UUID})
+	GetPermissionsUUID(ctx context.Context, request GetPermissionsUUIDRequestObject) (GetPermissionsUUIDResponseObject, error)
+
+	// (POST /profile/survey)
+	PostProfileSurvey(ctx context.Context, request PostProfileSurveyRequestObject) (PostProfileSurveyResponseObject, error)
+
+	// (DELETE /profile/survey/{UUID})
+	DeleteProfileSurveyUUID(ctx context.Context, request DeleteProfileSurveyUUIDRequestObject) (DeleteProfileSurveyUUIDResponseObject, error)
+
+	// (GET /profile/survey/{UUID})
+	GetProfileSurveyUUID(ctx context.Context, request GetProfileSurveyUUIDRequestObject) (GetProfileSurveyUUIDResponseObject, error)
+
+	// (POST /project)
+	PostProject(ctx context.Context, request PostProjectRequestObject) (PostProjectResponseObject, error)
+
+	// (DELETE /project/{UUID})
+	DeleteProjectUUID(ctx context.Context, request DeleteProjectUUIDRequestObject) (DeleteProjectUUIDResponseObject, error)
+
+	// (GET /project/{UUID})
+	GetProjectUUID(ctx context.Context, request GetProjectUUIDRequestObject) (GetProjectUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID})
+	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
+
+	// (GET /project/{UUID}/analytics)
+	GetProjectUUIDAnalytics(ctx context.Context, request GetProjectUUIDAnalyticsRequestObject) (GetProjectUUIDAnalyticsResponseObject, error)
+
+	// (DELETE /project/{UUID}/approval-policy)
+	DeleteProjectUUIDApprovalPolicy(ctx context.Context, request DeleteProjectUUIDApprovalPolicyRequestObject) (DeleteProjectUUIDApprovalPolicyResponseObject, error)
+
+	// (GET /project/{UUID}/approval-policy)
+	GetProjectUUIDApprovalPolicy(ctx context.Context, request GetProjectUUIDApprovalPolicyRequestObject) (GetProjectUUIDApprovalPolicyResponseObject, error)
+
+	// (PUT /project/{UUID}/approval-policy)
+	PutProjectUUIDApprovalPolicy(ctx context.Context, request PutProjectUUIDApprovalPolicyRequestObject) (PutProjectUUIDApprovalPolicyResponseObject, error)
+
+	// (GET /project/{UUID}/catalog)
+	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
+
+	// (POST /project/{UUID}/catalog)
+	PostProjectUUIDCatalog(ctx context.Context, request PostProjectUUIDCatalogRequestObject) (PostProjectUUIDCatalogResponseObject, error)
+
+	// (GET /project/{UUID}/catalog/{entityName})
+	GetProjectUUIDCatalogEntityName(ctx context.Context, request GetProjectUUIDCatalogEntityNameRequestObject) (GetProjectUUIDCatalogEntityNameResponseObject, error)
+
+	// (DELETE /project/{UUID}/catalog/{entityUUID})
+	DeleteProjectUUIDCatalogEntityUUID(ctx context.Context, request DeleteProjectUUIDCatalogEntityUUIDRequestObject) (DeleteProjectUUIDCatalogEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/description)
+	PatchProjectUUIDDescription(ctx context.Context, request PatchProjectUUIDDescriptionRequestObject) (PatchProjectUUIDDescriptionResponseObject, error)
+
+	// (DELETE /project/{UUID}/field/{entityUUID})
+	DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request DeleteProjectUUIDFieldEntityUUIDRequestObject) (DeleteProjectUUIDFieldEntityUUIDResponseObject, error)
+
+	// (POST /project/{UUID}/field/{entityUUID})
+	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/graph)
+	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
+
+	// (GET /project/{UUID}/inbound)
+	GetProjectUUIDInbound(ctx context.Context, request GetProjectUUIDInboundRequestObject) (GetProjectUUIDInboundResponseObject, error)
+
+	// (POST /project/{UUID}/inbound)
+	PostProjectUUIDInbound(ctx context.Context, request PostProjectUUIDInboundRequestObject) (PostProjectUUIDInboundResponseObject, error)
+
+	// (DELETE /project/{UUID}/inbound/{entityUUID})
+	DeleteProjectUUIDInboundEntityUUID(ctx context.Context, request DeleteProjectUUIDInboundEntityUUIDRequestObject) (DeleteProjectUUIDInboundEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/inbound/{entityUUID})
+	PatchProjectUUIDInboundEntityUUID(ctx context.Context, request PatchProjectUUIDInboundEntityUUIDRequestObject) (PatchProjectUUIDInboundEntityUUIDResponseObject, error)
+
+	// (GET /project/{UUID}/mailbox)
+	GetProjectUUIDMailbox(ctx context.Context, request GetProjectUUIDMailboxRequestObject) (GetProjectUUIDMailboxResponseObject, error)
+
+	// (POST /project/{UUID}/mailbox)
+	PostProjectUUIDMailbox(ctx context.Context, request PostProjectUUIDMailboxRequestObject) (PostProjectUUIDMailboxResponseObject, error)
+
+	// (DELETE /project/{UUID}/mailbox/{entityUUID})
+	DeleteProjectUUIDMailboxEntityUUID(ctx context.Context, request DeleteProjectUUIDMailboxEntityUUIDRequestObject) (DeleteProjectUUIDMailboxEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/mailbox/{entityUUID})
+	PatchProjectUUIDMailboxEntityUUID(ctx context.Context, request PatchProjectUUIDMailboxEntityUUIDRequestObject) (PatchProjectUUIDMailboxEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/name)
+	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
+
+	// (PATCH /project/{UUID}/options)
+	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
+
+	// (GET /project/{UUID}/statistic/history)
+	GetProjectUUIDStatisticHistory(ctx context.Context, request GetProjectUUIDStatisticHistoryRequestObject) (GetProjectUUIDStatisticHistoryResponseObject, error)
+
+	// (GET /project/{UUID}/status)
+	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
+
+	// (POST /project/{UUID}/status)
+	PostProjectUUIDStatus(ctx context.Context, request PostProjectUUIDStatusRequestObject) (PostProjectUUIDStatusResponseObject, error)
+
+	// (DELETE /project/{UUID}/status/{entityUUID})
+	DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request DeleteProjectUUIDStatusEntityUUIDRequestObject) (DeleteProjectUUIDStatusEntityUUIDResponseObject, error)
+
+	// (PATCH /project/{UUID}/status/{entityUUID})
+	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
+
+	// (GET /project/{UUID}/timeline)
+	GetProjectUUIDTimeline(ctx context.Context, request GetProjectUUIDTimelineRequestObject) (GetProjectUUIDTimelineResponseObject, error)
+
+	// (POST /project/{UUID}/user)
+	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
+
+	// (DELETE /project/{UUID}/user/{userUUID})
+	DeleteProjectUUIDUserUserUUID(ctx context.Context, request DeleteProjectUUIDUserUserUUIDRequestObject) (DeleteProjectUUIDUserUserUUIDResponseObject, error)
+
+	// (GET /tag)
+	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+
+	// (POST /tag)
+	PostTag(ctx context.Context, request PostTagRequestObject) (PostTagResponseObject, error)
+
+	// (DELETE /tag/{UUID})
+	DeleteTagUUID(ctx context.Context, request DeleteTagUUIDRequestObject) (DeleteTagUUIDResponseObject, error)
+
+	// (PATCH /tag/{UUID})
+	PatchTagUUID(ctx context.Context, request PatchTagUUIDRequestObject) (PatchTagUUIDResponseObject, error)
+
+	// (GET /user)
+	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostCompany operation middleware
+func (sh *strictHandler) PostCompany(ctx echo.Context) error {
+	var request PostCompanyRequestObject
+
+	var body PostCompanyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompany(ctx.Request().Context(), request.(PostCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyResponseObject); ok {
+		return validResponse.VisitPostCompanyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompanyUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteCompanyUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUID operation middleware
+func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUID(ctx.Request().Context(), request.(GetCompanyUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDFields operation middleware
+func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDFieldsRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDFields(ctx.Request().Context(), request.(GetCompanyUUIDFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDFields")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDFieldsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDFieldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDFields operation middleware
+func (sh *strictHandler) PostCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDFieldsRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDFieldsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDFields(ctx.Request().Context(), request.(PostCompanyUUIDFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDFields")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDFieldsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDFieldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompanyUUIDFieldsEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDFieldsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDFieldsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDFieldsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDFieldsEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutCompanyUUIDFieldsEntityUUID operation middleware
+func (sh *strictHandler) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutCompanyUUIDFieldsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDFieldsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDFieldsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDFieldsEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDGroup operation middleware
+func (sh *strictHandler) GetCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDGroupRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDGroup(ctx.Request().Context(), request.(GetCompanyUUIDGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDGroupResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDGroup operation middleware
+func (sh *strictHandler) PostCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDGroupRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDGroup(ctx.Request().Context(), request.(PostCompanyUUIDGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDGroupResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompanyUUIDGroupEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDGroupEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDGroupEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDGroupEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDGroupEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCompanyUUIDGroupEntityUUID operation middleware
+func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCompanyUUIDGroupEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDGroupEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDGroupEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDGroupEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCompanyUUIDName operation middleware
+func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchCompanyUUIDNameRequestObject
+
+	request.UUID = uUID
+
+	var body PatchCompanyUUIDNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDPriorities operation middleware
+func (sh *strictHandler) GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDPrioritiesRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDPriorities(ctx.Request().Context(), request.(GetCompanyUUIDPrioritiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDPriorities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDPrioritiesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDPriorities operation middleware
+func (sh *strictHandler) PostCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDPrioritiesRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDPrioritiesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDPriorities(ctx.Request().Context(), request.(PostCompanyUUIDPrioritiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDPriorities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDPrioritiesResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompanyUUIDPrioritiesEntityUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompanyUUIDPrioritiesEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCompanyUUIDPrioritiesEntityUUID operation middleware
+func (sh *strictHandler) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDPrioritiesEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDPrioritiesEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDProjectCatalogEntityName operation middleware
+func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request GetCompanyUUIDProjectCatalogEntityNameRequestObject
+
+	request.UUID = uUID
+	request.EntityName = entityName
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDProjectCatalogEntityName(ctx.Request().Context(), request.(GetCompanyUUIDProjectCatalogEntityNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDProjectCatalogEntityName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDProjectCatalogEntityNameResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDProjectCatalogEntityNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDSms operation middleware
+func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
+	var request GetCompanyUUIDSmsRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDSms(ctx.Request().Context(), request.(GetCompanyUUIDSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDSms")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDSmsResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDSmsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /project/{UUID}/field/{entityUUID})
-	DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request DeleteProjectUUIDFieldEntityUUIDRequestObject) (DeleteProjectUUIDFieldEntityUUIDResponseObject, error)
+// PostCompanyUUIDSmsCost operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsCostRequestObject
 
-	// (POST /project/{UUID}/field/{entityUUID})
-	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (PATCH /project/{UUID}/graph)
-	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
+	var body PostCompanyUUIDSmsCostJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (PATCH /project/{UUID}/name)
-	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsCost(ctx.Request().Context(), request.(PostCompanyUUIDSmsCostRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsCost")
+	}
 
-	// (PATCH /project/{UUID}/options)
-	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /project/{UUID}/status)
-	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsCostResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsCostResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /project/{UUID}/status)
-	PostProjectUUIDStatus(ctx context.Context, request PostProjectUUIDStatusRequestObject) (PostProjectUUIDStatusResponseObject, error)
+// PostCompanyUUIDSmsOptions operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsOptions(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDSmsOptionsRequestObject
 
-	// (DELETE /project/{UUID}/status/{entityUUID})
-	DeleteProjectUUIDStatusEntityUUID(ctx context.Context, request DeleteProjectUUIDStatusEntityUUIDRequestObject) (DeleteProjectUUIDStatusEntityUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (PATCH /project/{UUID}/status/{entityUUID})
-	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
+	var body PostCompanyUUIDSmsOptionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (POST /project/{UUID}/user)
-	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsOptions(ctx.Request().Context(), request.(PostCompanyUUIDSmsOptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsOptions")
+	}
 
-	// (DELETE /project/{UUID}/user/{userUUID})
-	DeleteProjectUUIDUserUserUUID(ctx context.Context, request DeleteProjectUUIDUserUserUUIDRequestObject) (DeleteProjectUUIDUserUserUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /tag)
-	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsOptionsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsOptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (POST /tag)
-	PostTag(ctx context.Context, request PostTagRequestObject) (PostTagResponseObject, error)
+// PostCompanyUUIDSmsSend operation middleware
+func (sh *strictHandler) PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error {
+	var request PostCompanyUUIDSmsSendRequestObject
 
-	// (DELETE /tag/{UUID})
-	DeleteTagUUID(ctx context.Context, request DeleteTagUUIDRequestObject) (DeleteTagUUIDResponseObject, error)
+	request.UUID = uUID
+	request.Params = params
 
-	// (PATCH /tag/{UUID})
-	PatchTagUUID(ctx context.Context, request PatchTagUUIDRequestObject) (PatchTagUUIDResponseObject, error)
+	var body PostCompanyUUIDSmsSendJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (GET /user)
-	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDSmsSend(ctx.Request().Context(), request.(PostCompanyUUIDSmsSendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDSmsSend")
+	}
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDSmsSendResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDSmsSendResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// PostCompanyUUIDUser operation middleware
+func (sh *strictHandler) PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDUserRequestObject
 
-// PostCompany operation middleware
-func (sh *strictHandler) PostCompany(ctx echo.Context) error {
-	var request PostCompanyRequestObject
+	request.UUID = uUID
 
-	var body PostCompanyJSONRequestBody
+	var body PostCompanyUUIDUserJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompany(ctx.Request().Context(), request.(PostCompanyRequestObject))
+		return sh.ssi.PostCompanyUUIDUser(ctx.Request().Context(), request.(PostCompanyUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompany")
+		handler = middleware(handler, "PostCompanyUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyResponseObject); ok {
-		return validResponse.VisitPostCompanyResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostCompanyUUIDUserResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteCompanyUUIDRequestObject
+// DeleteCompanyUUIDUserUserUUID operation middleware
+func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
+	var request DeleteCompanyUUIDUserUserUUIDRequestObject
 
 	request.UUID = uUID
+	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDRequestObject))
+		return sh.ssi.DeleteCompanyUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDUserUserUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUID")
+		handler = middleware(handler, "DeleteCompanyUUIDUserUserUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteCompanyUUIDUserUserUUIDResponseObject); ok {
+		return validResponse.VisitDeleteCompanyUUIDUserUserUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUID operation middleware
-func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDRequestObject
+// PostFederation operation middleware
+func (sh *strictHandler) PostFederation(ctx echo.Context) error {
+	var request PostFederationRequestObject
 
-	request.UUID = uUID
+	var body PostFederationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUID(ctx.Request().Context(), request.(GetCompanyUUIDRequestObject))
+		return sh.ssi.PostFederation(ctx.Request().Context(), request.(PostFederationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUID")
+		handler = middleware(handler, "PostFederation")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationResponseObject); ok {
+		return validResponse.VisitPostFederationResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDFields operation middleware
-func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDFieldsRequestObject
+// DeleteFederationUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteFederationUUIDRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDFields(ctx.Request().Context(), request.(GetCompanyUUIDFieldsRequestObject))
+		return sh.ssi.DeleteFederationUUID(ctx.Request().Context(), request.(DeleteFederationUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDFields")
+		handler = middleware(handler, "DeleteFederationUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDFieldsResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDFieldsResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDFields operation middleware
-func (sh *strictHandler) PostCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDFieldsRequestObject
+// GetFederationUUID operation middleware
+func (sh *strictHandler) GetFederationUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDFieldsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDFields(ctx.Request().Context(), request.(PostCompanyUUIDFieldsRequestObject))
+		return sh.ssi.GetFederationUUID(ctx.Request().Context(), request.(GetFederationUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDFields")
+		handler = middleware(handler, "GetFederationUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDFieldsResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDFieldsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDFieldsEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDFieldsEntityUUIDRequestObject
+// GetFederationUUIDAgent operation middleware
+func (sh *strictHandler) GetFederationUUIDAgent(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentParams) error {
+	var request GetFederationUUIDAgentRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDFieldsEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDAgent(ctx.Request().Context(), request.(GetFederationUUIDAgentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDFieldsEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDAgent")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDFieldsEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDAgentResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDAgentResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutCompanyUUIDFieldsEntityUUID operation middleware
-func (sh *strictHandler) PutCompanyUUIDFieldsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PutCompanyUUIDFieldsEntityUUIDRequestObject
+// PostFederationUUIDAgent operation middleware
+func (sh *strictHandler) PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDAgentRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
-	var body PutCompanyUUIDFieldsEntityUUIDJSONRequestBody
+	var body PostFederationUUIDAgentJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutCompanyUUIDFieldsEntityUUID(ctx.Request().Context(), request.(PutCompanyUUIDFieldsEntityUUIDRequestObject))
+		return sh.ssi.PostFederationUUIDAgent(ctx.Request().Context(), request.(PostFederationUUIDAgentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutCompanyUUIDFieldsEntityUUID")
+		handler = middleware(handler, "PostFederationUUIDAgent")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutCompanyUUIDFieldsEntityUUIDResponseObject); ok {
-		return validResponse.VisitPutCompanyUUIDFieldsEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDAgentResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDAgentResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDGroup operation middleware
-func (sh *strictHandler) GetCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDGroupRequestObject
+// DeleteFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteFederationUUIDAgentEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDGroup(ctx.Request().Context(), request.(GetCompanyUUIDGroupRequestObject))
+		return sh.ssi.DeleteFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDGroup")
+		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDGroupResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDGroup operation middleware
-func (sh *strictHandler) PostCompanyUUIDGroup(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDGroupRequestObject
+// PatchFederationUUIDAgentEntityUUID operation middleware
+func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchFederationUUIDAgentEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyUUIDGroupJSONRequestBody
+	var body PatchFederationUUIDAgentEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDGroup(ctx.Request().Context(), request.(PostCompanyUUIDGroupRequestObject))
+		return sh.ssi.PatchFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDAgentEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDGroup")
+		handler = middleware(handler, "PatchFederationUUIDAgentEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDGroupResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchFederationUUIDAgentEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchFederationUUIDAgentEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDGroupEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDGroupEntityUUIDRequestObject
+// GetFederationUUIDFileSearch operation middleware
+func (sh *strictHandler) GetFederationUUIDFileSearch(ctx echo.Context, uUID Uuid, params GetFederationUUIDFileSearchParams) error {
+	var request GetFederationUUIDFileSearchRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDGroupEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDFileSearch(ctx.Request().Context(), request.(GetFederationUUIDFileSearchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDGroupEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDFileSearch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDGroupEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDFileSearchResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDFileSearchResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDGroupEntityUUID operation middleware
-func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchCompanyUUIDGroupEntityUUIDRequestObject
+// GetFederationUUIDInvite operation middleware
+func (sh *strictHandler) GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDInviteRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
-
-	var body PatchCompanyUUIDGroupEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDGroupEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDGroupEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDInvite(ctx.Request().Context(), request.(GetFederationUUIDInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDGroupEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDInvite")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDGroupEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDGroupEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDInviteResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDInviteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDName operation middleware
-func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchCompanyUUIDNameRequestObject
+// PostFederationUUIDInvite operation middleware
+func (sh *strictHandler) PostFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDInviteRequestObject
 
 	request.UUID = uUID
 
-	var body PatchCompanyUUIDNameJSONRequestBody
+	var body PostFederationUUIDInviteJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+		return sh.ssi.PostFederationUUIDInvite(ctx.Request().Context(), request.(PostFederationUUIDInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDName")
+		handler = middleware(handler, "PostFederationUUIDInvite")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDInviteResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDInviteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDPriorities operation middleware
-func (sh *strictHandler) GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
-	var request GetCompanyUUIDPrioritiesRequestObject
+// DeleteFederationUUIDInviteEntityUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteFederationUUIDInviteEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDPriorities(ctx.Request().Context(), request.(GetCompanyUUIDPrioritiesRequestObject))
+		return sh.ssi.DeleteFederationUUIDInviteEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDInviteEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDPriorities")
+		handler = middleware(handler, "DeleteFederationUUIDInviteEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDPrioritiesResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDInviteEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDInviteEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDPriorities operation middleware
-func (sh *strictHandler) PostCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDPrioritiesRequestObject
+// PatchFederationUUIDName operation middleware
+func (sh *strictHandler) PatchFederationUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchFederationUUIDNameRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDPrioritiesJSONRequestBody
+	var body PatchFederationUUIDNameJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDPriorities(ctx.Request().Context(), request.(PostCompanyUUIDPrioritiesRequestObject))
+		return sh.ssi.PatchFederationUUIDName(ctx.Request().Context(), request.(PatchFederationUUIDNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDPriorities")
+		handler = middleware(handler, "PatchFederationUUIDName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDPrioritiesResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDPrioritiesResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchFederationUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchFederationUUIDNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDPrioritiesEntityUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject
+// GetFederationUUIDProject operation middleware
+func (sh *strictHandler) GetFederationUUIDProject(ctx echo.Context, uUID Uuid, params GetFederationUUIDProjectParams) error {
+	var request GetFederationUUIDProjectRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDPrioritiesEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDProject(ctx.Request().Context(), request.(GetFederationUUIDProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDPrioritiesEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDProject")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDProjectResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDProjectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCompanyUUIDPrioritiesEntityUUID operation middleware
-func (sh *strictHandler) PatchCompanyUUIDPrioritiesEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchCompanyUUIDPrioritiesEntityUUIDRequestObject
+// GetFederationUUIDStorage operation middleware
+func (sh *strictHandler) GetFederationUUIDStorage(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDStorageRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
-
-	var body PatchCompanyUUIDPrioritiesEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDPrioritiesEntityUUID(ctx.Request().Context(), request.(PatchCompanyUUIDPrioritiesEntityUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDStorage(ctx.Request().Context(), request.(GetFederationUUIDStorageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDPrioritiesEntityUUID")
+		handler = middleware(handler, "GetFederationUUIDStorage")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDPrioritiesEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDPrioritiesEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDStorageResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDStorageResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDProjectCatalogEntityName operation middleware
-func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
-	var request GetCompanyUUIDProjectCatalogEntityNameRequestObject
+// PostFederationUUIDUser operation middleware
+func (sh *strictHandler) PostFederationUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDUserRequestObject
 
 	request.UUID = uUID
-	request.EntityName = entityName
+
+	var body PostFederationUUIDUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDProjectCatalogEntityName(ctx.Request().Context(), request.(GetCompanyUUIDProjectCatalogEntityNameRequestObject))
+		return sh.ssi.PostFederationUUIDUser(ctx.Request().Context(), request.(PostFederationUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDProjectCatalogEntityName")
+		handler = middleware(handler, "PostFederationUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDProjectCatalogEntityNameResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDProjectCatalogEntityNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDUserResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCompanyUUIDSms operation middleware
-func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
-	var request GetCompanyUUIDSmsRequestObject
+// DeleteFederationUUIDUserUserUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
+	var request DeleteFederationUUIDUserUserUUIDRequestObject
 
 	request.UUID = uUID
-	request.Params = params
+	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompanyUUIDSms(ctx.Request().Context(), request.(GetCompanyUUIDSmsRequestObject))
+		return sh.ssi.DeleteFederationUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteFederationUUIDUserUserUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompanyUUIDSms")
+		handler = middleware(handler, "DeleteFederationUUIDUserUserUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCompanyUUIDSmsResponseObject); ok {
-		return validResponse.VisitGetCompanyUUIDSmsResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDUserUserUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDUserUserUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsCost operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsCost(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDSmsCostRequestObject
+// GetFederationUUIDWebhook operation middleware
+func (sh *strictHandler) GetFederationUUIDWebhook(ctx echo.Context, uUID Uuid, params GetFederationUUIDWebhookParams) error {
+	var request GetFederationUUIDWebhookRequestObject
 
 	request.UUID = uUID
-
-	var body PostCompanyUUIDSmsCostJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsCost(ctx.Request().Context(), request.(PostCompanyUUIDSmsCostRequestObject))
+		return sh.ssi.GetFederationUUIDWebhook(ctx.Request().Context(), request.(GetFederationUUIDWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsCost")
+		handler = middleware(handler, "GetFederationUUIDWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsCostResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsCostResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDWebhookResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDWebhookResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsOptions operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsOptions(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDSmsOptionsRequestObject
+// PostFederationUUIDWebhook operation middleware
+func (sh *strictHandler) PostFederationUUIDWebhook(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDWebhookRequestObject
 
 	request.UUID = uUID
 
-	var body PostCompanyUUIDSmsOptionsJSONRequestBody
+	var body PostFederationUUIDWebhookJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsOptions(ctx.Request().Context(), request.(PostCompanyUUIDSmsOptionsRequestObject))
+		return sh.ssi.PostFederationUUIDWebhook(ctx.Request().Context(), request.(PostFederationUUIDWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsOptions")
+		handler = middleware(handler, "PostFederationUUIDWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsOptionsResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsOptionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDWebhookResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDWebhookResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDSmsSend operation middleware
-func (sh *strictHandler) PostCompanyUUIDSmsSend(ctx echo.Context, uUID Uuid, params PostCompanyUUIDSmsSendParams) error {
-	var request PostCompanyUUIDSmsSendRequestObject
+// DeleteFederationUUIDWebhookEntityUUID operation middleware
+func (sh *strictHandler) DeleteFederationUUIDWebhookEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteFederationUUIDWebhookEntityUUIDRequestObject
 
 	request.UUID = uUID
-	request.Params = params
-
-	var body PostCompanyUUIDSmsSendJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDSmsSend(ctx.Request().Context(), request.(PostCompanyUUIDSmsSendRequestObject))
+		return sh.ssi.DeleteFederationUUIDWebhookEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDWebhookEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDSmsSend")
+		handler = middleware(handler, "DeleteFederationUUIDWebhookEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDSmsSendResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDSmsSendResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteFederationUUIDWebhookEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDWebhookEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCompanyUUIDUser operation middleware
-func (sh *strictHandler) PostCompanyUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostCompanyUUIDUserRequestObject
+// PatchFederationUUIDWebhookEntityUUID operation middleware
+func (sh *strictHandler) PatchFederationUUIDWebhookEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchFederationUUIDWebhookEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PostCompanyUUIDUserJSONRequestBody
+	var body PatchFederationUUIDWebhookEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCompanyUUIDUser(ctx.Request().Context(), request.(PostCompanyUUIDUserRequestObject))
+		return sh.ssi.PatchFederationUUIDWebhookEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDWebhookEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCompanyUUIDUser")
+		handler = middleware(handler, "PatchFederationUUIDWebhookEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCompanyUUIDUserResponseObject); ok {
-		return validResponse.VisitPostCompanyUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchFederationUUIDWebhookEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchFederationUUIDWebhookEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCompanyUUIDUserUserUUID operation middleware
-func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
-	var request DeleteCompanyUUIDUserUserUUIDRequestObject
+// GetFederationUUIDWebhookEntityUUIDDelivery operation middleware
+func (sh *strictHandler) GetFederationUUIDWebhookEntityUUIDDelivery(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetFederationUUIDWebhookEntityUUIDDeliveryParams) error {
+	var request GetFederationUUIDWebhookEntityUUIDDeliveryRequestObject
 
 	request.UUID = uUID
-	request.UserUUID = userUUID
+	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompanyUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteCompanyUUIDUserUserUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDWebhookEntityUUIDDelivery(ctx.Request().Context(), request.(GetFederationUUIDWebhookEntityUUIDDeliveryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompanyUUIDUserUserUUID")
+		handler = middleware(handler, "GetFederationUUIDWebhookEntityUUIDDelivery")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCompanyUUIDUserUserUUIDResponseObject); ok {
-		return validResponse.VisitDeleteCompanyUUIDUserUserUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDWebhookEntityUUIDDeliveryResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDWebhookEntityUUIDDeliveryResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederation operation middleware
-func (sh *strictHandler) PostFederation(ctx echo.Context) error {
-	var request PostFederationRequestObject
+// PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver operation middleware
+func (sh *strictHandler) PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, deliveryUUID DeliveryUUID) error {
+	var request PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverRequestObject
 
-	var body PostFederationJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.DeliveryUUID = deliveryUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederation(ctx.Request().Context(), request.(PostFederationRequestObject))
+		return sh.ssi.PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx.Request().Context(), request.(PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederation")
+		handler = middleware(handler, "PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationResponseObject); ok {
-		return validResponse.VisitPostFederationResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteFederationUUIDRequestObject
+// GetFederationUUIDWorkload operation middleware
+func (sh *strictHandler) GetFederationUUIDWorkload(ctx echo.Context, uUID Uuid, params GetFederationUUIDWorkloadParams) error {
+	var request GetFederationUUIDWorkloadRequestObject
 
 	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUID(ctx.Request().Context(), request.(DeleteFederationUUIDRequestObject))
+		return sh.ssi.GetFederationUUIDWorkload(ctx.Request().Context(), request.(GetFederationUUIDWorkloadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUID")
+		handler = middleware(handler, "GetFederationUUIDWorkload")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetFederationUUIDWorkloadResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDWorkloadResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUID operation middleware
-func (sh *strictHandler) GetFederationUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetFederationUUIDRequestObject
+// DeleteGroupUUIDUser operation middleware
+func (sh *strictHandler) DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request DeleteGroupUUIDUserRequestObject
 
 	request.UUID = uUID
 
+	var body DeleteGroupUUIDUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUID(ctx.Request().Context(), request.(GetFederationUUIDRequestObject))
+		return sh.ssi.DeleteGroupUUIDUser(ctx.Request().Context(), request.(DeleteGroupUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUID")
+		handler = middleware(handler, "DeleteGroupUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteGroupUUIDUserResponseObject); ok {
+		return validResponse.VisitDeleteGroupUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDAgent operation middleware
-func (sh *strictHandler) GetFederationUUIDAgent(ctx echo.Context, uUID Uuid, params GetFederationUUIDAgentParams) error {
-	var request GetFederationUUIDAgentRequestObject
+// GetGroupUUIDUser operation middleware
+func (sh *strictHandler) GetGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request GetGroupUUIDUserRequestObject
 
 	request.UUID = uUID
-	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDAgent(ctx.Request().Context(), request.(GetFederationUUIDAgentRequestObject))
+		return sh.ssi.GetGroupUUIDUser(ctx.Request().Context(), request.(GetGroupUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDAgent")
+		handler = middleware(handler, "GetGroupUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDAgentResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDAgentResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetGroupUUIDUserResponseObject); ok {
+		return validResponse.VisitGetGroupUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDAgent operation middleware
-func (sh *strictHandler) PostFederationUUIDAgent(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDAgentRequestObject
+// PostGroupUUIDUser operation middleware
+func (sh *strictHandler) PostGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
+	var request PostGroupUUIDUserRequestObject
 
 	request.UUID = uUID
 
-	var body PostFederationUUIDAgentJSONRequestBody
+	var body PostGroupUUIDUserJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDAgent(ctx.Request().Context(), request.(PostFederationUUIDAgentRequestObject))
+		return sh.ssi.PostGroupUUIDUser(ctx.Request().Context(), request.(PostGroupUUIDUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDAgent")
+		handler = middleware(handler, "PostGroupUUIDUser")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDAgentResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDAgentResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostGroupUUIDUserResponseObject); ok {
+		return validResponse.VisitPostGroupUUIDUserResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDAgentEntityUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteFederationUUIDAgentEntityUUIDRequestObject
+// PostPermissions operation middleware
+func (sh *strictHandler) PostPermissions(ctx echo.Context) error {
+	var request PostPermissionsRequestObject
 
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	var body PostPermissionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDAgentEntityUUIDRequestObject))
+		return sh.ssi.PostPermissions(ctx.Request().Context(), request.(PostPermissionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDAgentEntityUUID")
+		handler = middleware(handler, "PostPermissions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDAgentEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostPermissionsResponseObject); ok {
+		return validResponse.VisitPostPermissionsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchFederationUUIDAgentEntityUUID operation middleware
-func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchFederationUUIDAgentEntityUUIDRequestObject
+// DeletePermissionsUUID operation middleware
+func (sh *strictHandler) DeletePermissionsUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeletePermissionsUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
-
-	var body PatchFederationUUIDAgentEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchFederationUUIDAgentEntityUUID(ctx.Request().Context(), request.(PatchFederationUUIDAgentEntityUUIDRequestObject))
+		return sh.ssi.DeletePermissionsUUID(ctx.Request().Context(), request.(DeletePermissionsUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchFederationUUIDAgentEntityUUID")
+		handler = middleware(handler, "DeletePermissionsUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchFederationUUIDAgentEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchFederationUUIDAgentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeletePermissionsUUIDResponseObject); ok {
+		return validResponse.VisitDeletePermissionsUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDInvite operation middleware
-func (sh *strictHandler) GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
-	var request GetFederationUUIDInviteRequestObject
+// GetPermissionsUUID operation middleware
+func (sh *strictHandler) GetPermissionsUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetPermissionsUUIDRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDInvite(ctx.Request().Context(), request.(GetFederationUUIDInviteRequestObject))
+		return sh.ssi.GetPermissionsUUID(ctx.Request().Context(), request.(GetPermissionsUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDInvite")
+		handler = middleware(handler, "GetPermissionsUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDInviteResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPermissionsUUIDResponseObject); ok {
+		return validResponse.VisitGetPermissionsUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDInvite operation middleware
-func (sh *strictHandler) PostFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDInviteRequestObject
-
-	request.UUID = uUID
+// PostProfileSurvey operation middleware
+func (sh *strictHandler) PostProfileSurvey(ctx echo.Context) error {
+	var request PostProfileSurveyRequestObject
 
-	var body PostFederationUUIDInviteJSONRequestBody
+	var body PostProfileSurveyJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDInvite(ctx.Request().Context(), request.(PostFederationUUIDInviteRequestObject))
+		return sh.ssi.PostProfileSurvey(ctx.Request().Context(), request.(PostProfileSurveyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDInvite")
+		handler = middleware(handler, "PostProfileSurvey")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDInviteResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfileSurveyResponseObject); ok {
+		return validResponse.VisitPostProfileSurveyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDInviteEntityUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteFederationUUIDInviteEntityUUIDRequestObject
+// DeleteProfileSurveyUUID operation middleware
+func (sh *strictHandler) DeleteProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProfileSurveyUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDInviteEntityUUID(ctx.Request().Context(), request.(DeleteFederationUUIDInviteEntityUUIDRequestObject))
+		return sh.ssi.DeleteProfileSurveyUUID(ctx.Request().Context(), request.(DeleteProfileSurveyUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDInviteEntityUUID")
+		handler = middleware(handler, "DeleteProfileSurveyUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDInviteEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDInviteEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfileSurveyUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProfileSurveyUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchFederationUUIDName operation middleware
-func (sh *strictHandler) PatchFederationUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchFederationUUIDNameRequestObject
+// GetProfileSurveyUUID operation middleware
+func (sh *strictHandler) GetProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetProfileSurveyUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PatchFederationUUIDNameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchFederationUUIDName(ctx.Request().Context(), request.(PatchFederationUUIDNameRequestObject))
+		return sh.ssi.GetProfileSurveyUUID(ctx.Request().Context(), request.(GetProfileSurveyUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchFederationUUIDName")
+		handler = middleware(handler, "GetProfileSurveyUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchFederationUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchFederationUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileSurveyUUIDResponseObject); ok {
+		return validResponse.VisitGetProfileSurveyUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetFederationUUIDProject operation middleware
-func (sh *strictHandler) GetFederationUUIDProject(ctx echo.Context, uUID Uuid, params GetFederationUUIDProjectParams) error {
-	var request GetFederationUUIDProjectRequestObject
+// PostProject operation middleware
+func (sh *strictHandler) PostProject(ctx echo.Context) error {
+	var request PostProjectRequestObject
 
-	request.UUID = uUID
-	request.Params = params
+	var body PostProjectJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFederationUUIDProject(ctx.Request().Context(), request.(GetFederationUUIDProjectRequestObject))
+		return sh.ssi.PostProject(ctx.Request().Context(), request.(PostProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFederationUUIDProject")
+		handler = middleware(handler, "PostProject")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetFederationUUIDProjectResponseObject); ok {
-		return validResponse.VisitGetFederationUUIDProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectResponseObject); ok {
+		return validResponse.VisitPostProjectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFederationUUIDUser operation middleware
-func (sh *strictHandler) PostFederationUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostFederationUUIDUserRequestObject
+// DeleteProjectUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProjectUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body PostFederationUUIDUserJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFederationUUIDUser(ctx.Request().Context(), request.(PostFederationUUIDUserRequestObject))
+		return sh.ssi.DeleteProjectUUID(ctx.Request().Context(), request.(DeleteProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFederationUUIDUser")
+		handler = middleware(handler, "DeleteProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFederationUUIDUserResponseObject); ok {
-		return validResponse.VisitPostFederationUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteFederationUUIDUserUserUUID operation middleware
-func (sh *strictHandler) DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error {
-	var request DeleteFederationUUIDUserUserUUIDRequestObject
+// GetProjectUUID operation middleware
+func (sh *strictHandler) GetProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDRequestObject
 
 	request.UUID = uUID
-	request.UserUUID = userUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFederationUUIDUserUserUUID(ctx.Request().Context(), request.(DeleteFederationUUIDUserUserUUIDRequestObject))
+		return sh.ssi.GetProjectUUID(ctx.Request().Context(), request.(GetProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFederationUUIDUserUserUUID")
+		handler = middleware(handler, "GetProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteFederationUUIDUserUserUUIDResponseObject); ok {
-		return validResponse.VisitDeleteFederationUUIDUserUserUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteGroupUUIDUser operation middleware
-func (sh *strictHandler) DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request DeleteGroupUUIDUserRequestObject
+// PatchProjectUUID operation middleware
+func (sh *strictHandler) PatchProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDRequestObject
 
 	request.UUID = uUID
 
-	var body DeleteGroupUUIDUserJSONRequestBody
+	var body PatchProjectUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGroupUUIDUser(ctx.Request().Context(), request.(DeleteGroupUUIDUserRequestObject))
+		return sh.ssi.PatchProjectUUID(ctx.Request().Context(), request.(PatchProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGroupUUIDUser")
+		handler = middleware(handler, "PatchProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteGroupUUIDUserResponseObject); ok {
-		return validResponse.VisitDeleteGroupUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetGroupUUIDUser operation middleware
-func (sh *strictHandler) GetGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request GetGroupUUIDUserRequestObject
+// GetProjectUUIDAnalytics operation middleware
+func (sh *strictHandler) GetProjectUUIDAnalytics(ctx echo.Context, uUID Uuid, params GetProjectUUIDAnalyticsParams) error {
+	var request GetProjectUUIDAnalyticsRequestObject
 
 	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroupUUIDUser(ctx.Request().Context(), request.(GetGroupUUIDUserRequestObject))
+		return sh.ssi.GetProjectUUIDAnalytics(ctx.Request().Context(), request.(GetProjectUUIDAnalyticsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroupUUIDUser")
+		handler = middleware(handler, "GetProjectUUIDAnalytics")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetGroupUUIDUserResponseObject); ok {
-		return validResponse.VisitGetGroupUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDAnalyticsResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDAnalyticsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostGroupUUIDUser operation middleware
-func (sh *strictHandler) PostGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
-	var request PostGroupUUIDUserRequestObject
+// DeleteProjectUUIDApprovalPolicy operation middleware
+func (sh *strictHandler) DeleteProjectUUIDApprovalPolicy(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProjectUUIDApprovalPolicyRequestObject
 
 	request.UUID = uUID
 
-	var body PostGroupUUIDUserJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostGroupUUIDUser(ctx.Request().Context(), request.(PostGroupUUIDUserRequestObject))
+		return sh.ssi.DeleteProjectUUIDApprovalPolicy(ctx.Request().Context(), request.(DeleteProjectUUIDApprovalPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostGroupUUIDUser")
+		handler = middleware(handler, "DeleteProjectUUIDApprovalPolicy")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostGroupUUIDUserResponseObject); ok {
-		return validResponse.VisitPostGroupUUIDUserResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDApprovalPolicyResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDApprovalPolicyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPermissions operation middleware
-func (sh *strictHandler) PostPermissions(ctx echo.Context) error {
-	var request PostPermissionsRequestObject
+// GetProjectUUIDApprovalPolicy operation middleware
+func (sh *strictHandler) GetProjectUUIDApprovalPolicy(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDApprovalPolicyRequestObject
 
-	var body PostPermissionsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPermissions(ctx.Request().Context(), request.(PostPermissionsRequestObject))
+		return sh.ssi.GetProjectUUIDApprovalPolicy(ctx.Request().Context(), request.(GetProjectUUIDApprovalPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPermissions")
+		handler = middleware(handler, "GetProjectUUIDApprovalPolicy")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPermissionsResponseObject); ok {
-		return validResponse.VisitPostPermissionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDApprovalPolicyResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDApprovalPolicyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeletePermissionsUUID operation middleware
-func (sh *strictHandler) DeletePermissionsUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeletePermissionsUUIDRequestObject
+// PutProjectUUIDApprovalPolicy operation middleware
+func (sh *strictHandler) PutProjectUUIDApprovalPolicy(ctx echo.Context, uUID Uuid) error {
+	var request PutProjectUUIDApprovalPolicyRequestObject
 
 	request.UUID = uUID
 
+	var body PutProjectUUIDApprovalPolicyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePermissionsUUID(ctx.Request().Context(), request.(DeletePermissionsUUIDRequestObject))
+		return sh.ssi.PutProjectUUIDApprovalPolicy(ctx.Request().Context(), request.(PutProjectUUIDApprovalPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePermissionsUUID")
+		handler = middleware(handler, "PutProjectUUIDApprovalPolicy")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeletePermissionsUUIDResponseObject); ok {
-		return validResponse.VisitDeletePermissionsUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutProjectUUIDApprovalPolicyResponseObject); ok {
+		return validResponse.VisitPutProjectUUIDApprovalPolicyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPermissionsUUID operation middleware
-func (sh *strictHandler) GetPermissionsUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetPermissionsUUIDRequestObject
+// GetProjectUUIDCatalog operation middleware
+func (sh *strictHandler) GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDCatalogRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPermissionsUUID(ctx.Request().Context(), request.(GetPermissionsUUIDRequestObject))
+		return sh.ssi.GetProjectUUIDCatalog(ctx.Request().Context(), request.(GetProjectUUIDCatalogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPermissionsUUID")
+		handler = middleware(handler, "GetProjectUUIDCatalog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPermissionsUUIDResponseObject); ok {
-		return validResponse.VisitGetPermissionsUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDCatalogResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDCatalogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfileSurvey operation middleware
-func (sh *strictHandler) PostProfileSurvey(ctx echo.Context) error {
-	var request PostProfileSurveyRequestObject
+// PostProjectUUIDCatalog operation middleware
+func (sh *strictHandler) PostProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDCatalogRequestObject
 
-	var body PostProfileSurveyJSONRequestBody
+	request.UUID = uUID
+
+	var body PostProjectUUIDCatalogJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfileSurvey(ctx.Request().Context(), request.(PostProfileSurveyRequestObject))
+		return sh.ssi.PostProjectUUIDCatalog(ctx.Request().Context(), request.(PostProjectUUIDCatalogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfileSurvey")
+		handler = middleware(handler, "PostProjectUUIDCatalog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileSurveyResponseObject); ok {
-		return validResponse.VisitPostProfileSurveyResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDCatalogResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDCatalogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProfileSurveyUUID operation middleware
-func (sh *strictHandler) DeleteProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteProfileSurveyUUIDRequestObject
+// GetProjectUUIDCatalogEntityName operation middleware
+func (sh *strictHandler) GetProjectUUIDCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
+	var request GetProjectUUIDCatalogEntityNameRequestObject
 
 	request.UUID = uUID
+	request.EntityName = entityName
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProfileSurveyUUID(ctx.Request().Context(), request.(DeleteProfileSurveyUUIDRequestObject))
+		return sh.ssi.GetProjectUUIDCatalogEntityName(ctx.Request().Context(), request.(GetProjectUUIDCatalogEntityNameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProfileSurveyUUID")
+		handler = middleware(handler, "GetProjectUUIDCatalogEntityName")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProfileSurveyUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProfileSurveyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDCatalogEntityNameResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDCatalogEntityNameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfileSurveyUUID operation middleware
-func (sh *strictHandler) GetProfileSurveyUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetProfileSurveyUUIDRequestObject
+// DeleteProjectUUIDCatalogEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDCatalogEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileSurveyUUID(ctx.Request().Context(), request.(GetProfileSurveyUUIDRequestObject))
+		return sh.ssi.DeleteProjectUUIDCatalogEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDCatalogEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileSurveyUUID")
+		handler = middleware(handler, "DeleteProjectUUIDCatalogEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileSurveyUUIDResponseObject); ok {
-		return validResponse.VisitGetProfileSurveyUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDCatalogEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDCatalogEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProject operation middleware
-func (sh *strictHandler) PostProject(ctx echo.Context) error {
-	var request PostProjectRequestObject
+// PatchProjectUUIDDescription operation middleware
+func (sh *strictHandler) PatchProjectUUIDDescription(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDDescriptionRequestObject
 
-	var body PostProjectJSONRequestBody
+	request.UUID = uUID
+
+	var body PatchProjectUUIDDescriptionJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProject(ctx.Request().Context(), request.(PostProjectRequestObject))
+		return sh.ssi.PatchProjectUUIDDescription(ctx.Request().Context(), request.(PatchProjectUUIDDescriptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProject")
+		handler = middleware(handler, "PatchProjectUUIDDescription")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectResponseObject); ok {
-		return validResponse.VisitPostProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDDescriptionResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDDescriptionResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteProjectUUIDRequestObject
+// DeleteProjectUUIDFieldEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDFieldEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUID(ctx.Request().Context(), request.(DeleteProjectUUIDRequestObject))
+		return sh.ssi.DeleteProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDFieldEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUID")
+		handler = middleware(handler, "DeleteProjectUUIDFieldEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDFieldEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDFieldEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUID operation middleware
-func (sh *strictHandler) GetProjectUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetProjectUUIDRequestObject
+// PostProjectUUIDFieldEntityUUID operation middleware
+func (sh *strictHandler) PostProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostProjectUUIDFieldEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PostProjectUUIDFieldEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUID(ctx.Request().Context(), request.(GetProjectUUIDRequestObject))
+		return sh.ssi.PostProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(PostProjectUUIDFieldEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUID")
+		handler = middleware(handler, "PostProjectUUIDFieldEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDFieldEntityUUIDResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDFieldEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUID operation middleware
-func (sh *strictHandler) PatchProjectUUID(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDRequestObject
+// PatchProjectUUIDGraph operation middleware
+func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error {
+	var request PatchProjectUUIDGraphRequestObject
 
 	request.UUID = uUID
 
-	var body PatchProjectUUIDJSONRequestBody
+	var body PatchProjectUUIDGraphJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUID(ctx.Request().Context(), request.(PatchProjectUUIDRequestObject))
+		return sh.ssi.PatchProjectUUIDGraph(ctx.Request().Context(), request.(PatchProjectUUIDGraphRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUID")
+		handler = middleware(handler, "PatchProjectUUIDGraph")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDGraphResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDGraphResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUIDCatalog operation middleware
-func (sh *strictHandler) GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
-	var request GetProjectUUIDCatalogRequestObject
+// GetProjectUUIDInbound operation middleware
+func (sh *strictHandler) GetProjectUUIDInbound(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDInboundRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUIDCatalog(ctx.Request().Context(), request.(GetProjectUUIDCatalogRequestObject))
+		return sh.ssi.GetProjectUUIDInbound(ctx.Request().Context(), request.(GetProjectUUIDInboundRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUIDCatalog")
+		handler = middleware(handler, "GetProjectUUIDInbound")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDCatalogResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDCatalogResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDInboundResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDInboundResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProjectUUIDCatalog operation middleware
-func (sh *strictHandler) PostProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
-	var request PostProjectUUIDCatalogRequestObject
+// PostProjectUUIDInbound operation middleware
+func (sh *strictHandler) PostProjectUUIDInbound(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDInboundRequestObject
 
 	request.UUID = uUID
 
-	var body PostProjectUUIDCatalogJSONRequestBody
+	var body PostProjectUUIDInboundJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjectUUIDCatalog(ctx.Request().Context(), request.(PostProjectUUIDCatalogRequestObject))
+		return sh.ssi.PostProjectUUIDInbound(ctx.Request().Context(), request.(PostProjectUUIDInboundRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjectUUIDCatalog")
+		handler = middleware(handler, "PostProjectUUIDInbound")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectUUIDCatalogResponseObject); ok {
-		return validResponse.VisitPostProjectUUIDCatalogResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDInboundResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDInboundResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProjectUUIDCatalogEntityName operation middleware
-func (sh *strictHandler) GetProjectUUIDCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error {
-	var request GetProjectUUIDCatalogEntityNameRequestObject
+// DeleteProjectUUIDInboundEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDInboundEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDInboundEntityUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityName = entityName
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectUUIDCatalogEntityName(ctx.Request().Context(), request.(GetProjectUUIDCatalogEntityNameRequestObject))
+		return sh.ssi.DeleteProjectUUIDInboundEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDInboundEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectUUIDCatalogEntityName")
+		handler = middleware(handler, "DeleteProjectUUIDInboundEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProjectUUIDCatalogEntityNameResponseObject); ok {
-		return validResponse.VisitGetProjectUUIDCatalogEntityNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDInboundEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDInboundEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUIDCatalogEntityUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteProjectUUIDCatalogEntityUUIDRequestObject
+// PatchProjectUUIDInboundEntityUUID operation middleware
+func (sh *strictHandler) PatchProjectUUIDInboundEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchProjectUUIDInboundEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
+	var body PatchProjectUUIDInboundEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUIDCatalogEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDCatalogEntityUUIDRequestObject))
+		return sh.ssi.PatchProjectUUIDInboundEntityUUID(ctx.Request().Context(), request.(PatchProjectUUIDInboundEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUIDCatalogEntityUUID")
+		handler = middleware(handler, "PatchProjectUUIDInboundEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDCatalogEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDCatalogEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDInboundEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDInboundEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDDescription operation middleware
-func (sh *strictHandler) PatchProjectUUIDDescription(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDDescriptionRequestObject
+// GetProjectUUIDMailbox operation middleware
+func (sh *strictHandler) GetProjectUUIDMailbox(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDMailboxRequestObject
 
 	request.UUID = uUID
 
-	var body PatchProjectUUIDDescriptionJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDDescription(ctx.Request().Context(), request.(PatchProjectUUIDDescriptionRequestObject))
+		return sh.ssi.GetProjectUUIDMailbox(ctx.Request().Context(), request.(GetProjectUUIDMailboxRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDDescription")
+		handler = middleware(handler, "GetProjectUUIDMailbox")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDDescriptionResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDDescriptionResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProjectUUIDMailboxResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDMailboxResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProjectUUIDFieldEntityUUID operation middleware
-func (sh *strictHandler) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteProjectUUIDFieldEntityUUIDRequestObject
+// PostProjectUUIDMailbox operation middleware
+func (sh *strictHandler) PostProjectUUIDMailbox(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDMailboxRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+
+	var body PostProjectUUIDMailboxJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDFieldEntityUUIDRequestObject))
+		return sh.ssi.PostProjectUUIDMailbox(ctx.Request().Context(), request.(PostProjectUUIDMailboxRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectUUIDFieldEntityUUID")
+		handler = middleware(handler, "PostProjectUUIDMailbox")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProjectUUIDFieldEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteProjectUUIDFieldEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProjectUUIDMailboxResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDMailboxResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProjectUUIDFieldEntityUUID operation middleware
-func (sh *strictHandler) PostProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PostProjectUUIDFieldEntityUUIDRequestObject
+// DeleteProjectUUIDMailboxEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDMailboxEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDMailboxEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
-	var body PostProjectUUIDFieldEntityUUIDJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjectUUIDFieldEntityUUID(ctx.Request().Context(), request.(PostProjectUUIDFieldEntityUUIDRequestObject))
+		return sh.ssi.DeleteProjectUUIDMailboxEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDMailboxEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjectUUIDFieldEntityUUID")
+		handler = middleware(handler, "DeleteProjectUUIDMailboxEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProjectUUIDFieldEntityUUIDResponseObject); ok {
-		return validResponse.VisitPostProjectUUIDFieldEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProjectUUIDMailboxEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDMailboxEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProjectUUIDGraph operation middleware
-func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error {
-	var request PatchProjectUUIDGraphRequestObject
+// PatchProjectUUIDMailboxEntityUUID operation middleware
+func (sh *strictHandler) PatchProjectUUIDMailboxEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchProjectUUIDMailboxEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	var body PatchProjectUUIDGraphJSONRequestBody
+	var body PatchProjectUUIDMailboxEntityUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProjectUUIDGraph(ctx.Request().Context(), request.(PatchProjectUUIDGraphRequestObject))
+		return sh.ssi.PatchProjectUUIDMailboxEntityUUID(ctx.Request().Context(), request.(PatchProjectUUIDMailboxEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProjectUUIDGraph")
+		handler = middleware(handler, "PatchProjectUUIDMailboxEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProjectUUIDGraphResponseObject); ok {
-		return validResponse.VisitPatchProjectUUIDGraphResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProjectUUIDMailboxEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchProjectUUIDMailboxEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -5457,6 +7489,32 @@ func (sh *strictHandler) PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) er
 	return nil
 }
 
+// GetProjectUUIDStatisticHistory operation middleware
+func (sh *strictHandler) GetProjectUUIDStatisticHistory(ctx echo.Context, uUID Uuid, params GetProjectUUIDStatisticHistoryParams) error {
+	var request GetProjectUUIDStatisticHistoryRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDStatisticHistory(ctx.Request().Context(), request.(GetProjectUUIDStatisticHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDStatisticHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDStatisticHistoryResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDStatisticHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetProjectUUIDStatus operation middleware
 func (sh *strictHandler) GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error {
 	var request GetProjectUUIDStatusRequestObject
@@ -5571,6 +7629,32 @@ func (sh *strictHandler) PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID
 	return nil
 }
 
+// GetProjectUUIDTimeline operation middleware
+func (sh *strictHandler) GetProjectUUIDTimeline(ctx echo.Context, uUID Uuid, params GetProjectUUIDTimelineParams) error {
+	var request GetProjectUUIDTimelineRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDTimeline(ctx.Request().Context(), request.(GetProjectUUIDTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDTimeline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDTimelineResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDTimelineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostProjectUUIDUser operation middleware
 func (sh *strictHandler) PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error {
 	var request PostProjectUUIDUserRequestObject
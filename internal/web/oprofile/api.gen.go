@@ -53,6 +53,9 @@ type FederationDTOs = dto.FederationDTOs
 // InviteDTO defines model for InviteDTO.
 type InviteDTO = dto.InviteDTO
 
+// MyDayDTO defines model for MyDayDTO.
+type MyDayDTO = dto.MyDayDTO
+
 // NotificationReminderDTO defines model for NotificationReminderDTO.
 type NotificationReminderDTO = dto.NotificationReminderDTO
 
@@ -187,7 +190,8 @@ type PatchProfilePhotoMultipartBody struct {
 
 // PatchProfilePreferencesJSONBody defines parameters for PatchProfilePreferences.
 type PatchProfilePreferencesJSONBody struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone       *string `json:"timezone,omitempty"`
+	WeeklyCapacity *int    `json:"weekly_capacity,omitempty" validate:"omitempty,gte=1,lte=100"`
 }
 
 // PostProfileJSONRequestBody defines body for PostProfile for application/json ContentType.
@@ -286,6 +290,9 @@ type ServerInterface interface {
 	// (GET /profile/logout)
 	GetProfileLogout(ctx echo.Context) error
 
+	// (GET /profile/my-day)
+	GetProfileMyDay(ctx echo.Context) error
+
 	// (DELETE /profile/notifications)
 	DeleteProfileNotifications(ctx echo.Context) error
 
@@ -508,6 +515,17 @@ func (w *ServerInterfaceWrapper) GetProfileLogout(ctx echo.Context) error {
 	return err
 }
 
+// GetProfileMyDay converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileMyDay(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileMyDay(ctx)
+	return err
+}
+
 // DeleteProfileNotifications converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteProfileNotifications(ctx echo.Context) error {
 	var err error
@@ -747,6 +765,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/profile/login", wrapper.PostProfileLogin)
 	router.POST(baseURL+"/profile/login_as", wrapper.PostProfileLoginAs)
 	router.GET(baseURL+"/profile/logout", wrapper.GetProfileLogout)
+	router.GET(baseURL+"/profile/my-day", wrapper.GetProfileMyDay)
 	router.DELETE(baseURL+"/profile/notifications", wrapper.DeleteProfileNotifications)
 	router.GET(baseURL+"/profile/notifications", wrapper.GetProfileNotifications)
 	router.POST(baseURL+"/profile/notifications/task/:UUID/hide", wrapper.PostProfileNotificationsTaskUUIDHide)
@@ -1035,6 +1054,22 @@ func (response GetProfileLogout200Response) VisitGetProfileLogoutResponse(w http
 	return nil
 }
 
+type GetProfileMyDayRequestObject struct {
+}
+
+type GetProfileMyDayResponseObject interface {
+	VisitGetProfileMyDayResponse(w http.ResponseWriter) error
+}
+
+type GetProfileMyDay200JSONResponse MyDayDTO
+
+func (response GetProfileMyDay200JSONResponse) VisitGetProfileMyDayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteProfileNotificationsRequestObject struct {
 }
 
@@ -1372,6 +1407,9 @@ type StrictServerInterface interface {
 	// (GET /profile/logout)
 	GetProfileLogout(ctx context.Context, request GetProfileLogoutRequestObject) (GetProfileLogoutResponseObject, error)
 
+	// (GET /profile/my-day)
+	GetProfileMyDay(ctx context.Context, request GetProfileMyDayRequestObject) (GetProfileMyDayResponseObject, error)
+
 	// (DELETE /profile/notifications)
 	DeleteProfileNotifications(ctx context.Context, request DeleteProfileNotificationsRequestObject) (DeleteProfileNotificationsResponseObject, error)
 
@@ -1801,6 +1839,29 @@ func (sh *strictHandler) GetProfileLogout(ctx echo.Context) error {
 	return nil
 }
 
+// GetProfileMyDay operation middleware
+func (sh *strictHandler) GetProfileMyDay(ctx echo.Context) error {
+	var request GetProfileMyDayRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileMyDay(ctx.Request().Context(), request.(GetProfileMyDayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileMyDay")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileMyDayResponseObject); ok {
+		return validResponse.VisitGetProfileMyDayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteProfileNotifications operation middleware
 func (sh *strictHandler) DeleteProfileNotifications(ctx echo.Context) error {
 	var request DeleteProfileNotificationsRequestObject
@@ -24,27 +24,59 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for PostTaskUUIDApprovalVoteJSONBodyDecision.
+const (
+	Approve PostTaskUUIDApprovalVoteJSONBodyDecision = "approve"
+	Reject  PostTaskUUIDApprovalVoteJSONBodyDecision = "reject"
+)
+
+// Defines values for PatchTaskUUIDVisibilityJSONBodyVisibility.
+const (
+	Acl     PatchTaskUUIDVisibilityJSONBodyVisibility = "acl"
+	Project PatchTaskUUIDVisibilityJSONBodyVisibility = "project"
+	Team    PatchTaskUUIDVisibilityJSONBodyVisibility = "team"
+)
+
 // ActivityDTO defines model for ActivityDTO.
 type ActivityDTO = dto.ActivityDTO
 
 // CommentDTO defines model for CommentDTO.
 type CommentDTO = dto.CommentDTO
 
+// CommentRevisionDTO defines model for CommentRevisionDTO.
+type CommentRevisionDTO = dto.CommentRevisionDTO
+
+// EpicProgressDTO defines model for EpicProgressDTO.
+type EpicProgressDTO = dto.EpicProgressDTO
+
+// FileVersionDTO defines model for FileVersionDTO.
+type FileVersionDTO = dto.FileVersionDTO
+
 // NameRequest defines model for NameRequest.
 type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
 }
 
+// PollDTO defines model for PollDTO.
+type PollDTO = dto.PollDTO
+
+// ReactionDTO defines model for ReactionDTO.
+type ReactionDTO = dto.ReactionDTO
+
 // StatusRequest defines model for StatusRequest.
 type StatusRequest struct {
 	Comment string `json:"comment" validate:"trim,min=0,max=300"`
 	Status  int    `json:"status" validate:"gte=0,lte=20"`
 }
 
+// TaskApprovalDTO defines model for TaskApprovalDTO.
+type TaskApprovalDTO = dto.TaskApprovalDTO
+
 // TaskCreateRequest defines model for TaskCreateRequest.
 type TaskCreateRequest struct {
 	CoworkersBy   []string               `json:"coworkers_by" validate:"dive,email"`
 	Description   string                 `json:"description" validate:"trim,max=5000"`
+	Duration      *int                   `json:"duration,omitempty" validate:"gte=0,lte=3650"`
 	Fields        map[string]interface{} `json:"fields"`
 	FinishTo      *time.Time             `json:"finish_to,omitempty"`
 	Icon          string                 `json:"icon" validate:"trim,max=50"`
@@ -55,6 +87,7 @@ type TaskCreateRequest struct {
 	Priority      int                    `json:"priority" validate:"gte=0,lte=30"`
 	ProjectUuid   openapi_types.UUID     `json:"project_uuid" validate:"uuid"`
 	ResponsibleBy string                 `json:"responsible_by" validate:"omitempty,email"`
+	StartAt       *time.Time             `json:"start_at,omitempty"`
 	Tags          []string               `json:"tags" validate:"dive,trim,name,max=40"`
 	TaskEntities  []domain.TaskEntity    `json:"task_entities"`
 }
@@ -68,11 +101,13 @@ type TaskDTOs = dto.TaskDTOs
 // TaskPutRequest defines model for TaskPutRequest.
 type TaskPutRequest struct {
 	Description *string                 `json:"description,omitempty" validate:"trim,max=5000"`
+	Duration    *int                    `json:"duration,omitempty" validate:"gte=0,lte=3650"`
 	Fields      *map[string]interface{} `json:"fields,omitempty"`
 	FinishTo    *time.Time              `json:"finish_to,omitempty"`
 	Icon        *string                 `json:"icon,omitempty" validate:"omitempty,trim,lte=20"`
 	ManagedBy   *string                 `json:"managed_by,omitempty" validate:"omitempty,email"`
 	Priority    *int                    `json:"priority,omitempty" validate:"gte=0,lte=30"`
+	StartAt     *time.Time              `json:"start_at,omitempty"`
 	Tags        *[]string               `json:"tags,omitempty" validate:"dive,trim,name,max=40"`
 }
 
@@ -82,6 +117,9 @@ type UploadDTO = dto.UploadDTO
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// Cursor defines model for cursor.
+type Cursor = string
+
 // EntityUUID defines model for entityUUID.
 type EntityUUID = openapi_types.UUID
 
@@ -91,6 +129,9 @@ type FileUUID = openapi_types.UUID
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// WithTotal defines model for withTotal.
+type WithTotal = bool
+
 // GetTaskParams defines parameters for GetTask.
 type GetTaskParams struct {
 	Offset         *int               `form:"offset,omitempty" json:"offset,omitempty"`
@@ -108,12 +149,44 @@ type GetTaskParams struct {
 	Order          *string            `form:"order,omitempty" json:"order,omitempty"`
 	By             *string            `form:"by,omitempty" json:"by,omitempty"`
 	Format         *string            `form:"format,omitempty" json:"format,omitempty"`
+
+	// Cursor next_cursor from the previous page, offset is ignored
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WithTotal count total with cursor, otherwise total is -1
+	WithTotal *WithTotal `form:"with_total,omitempty" json:"with_total,omitempty"`
 }
 
 // GetTaskUUIDActivityParams defines parameters for GetTaskUUIDActivity.
 type GetTaskUUIDActivityParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor from the previous page, offset is ignored
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WithTotal count total with cursor, otherwise total is -1
+	WithTotal *WithTotal `form:"with_total,omitempty" json:"with_total,omitempty"`
+}
+
+// PostTaskUUIDApprovalVoteJSONBody defines parameters for PostTaskUUIDApprovalVote.
+type PostTaskUUIDApprovalVoteJSONBody struct {
+	Comment  *string                                  `json:"comment,omitempty" validate:"omitempty,max=5000"`
+	Decision PostTaskUUIDApprovalVoteJSONBodyDecision `json:"decision"`
+}
+
+// PostTaskUUIDApprovalVoteJSONBodyDecision defines parameters for PostTaskUUIDApprovalVote.
+type PostTaskUUIDApprovalVoteJSONBodyDecision string
+
+// GetTaskUUIDCommentParams defines parameters for GetTaskUUIDComment.
+type GetTaskUUIDCommentParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor from the previous page, offset is ignored
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WithTotal count total with cursor, otherwise total is -1
+	WithTotal *WithTotal `form:"with_total,omitempty" json:"with_total,omitempty"`
 }
 
 // PostTaskUUIDCommentMultipartBody defines parameters for PostTaskUUIDComment.
@@ -124,6 +197,15 @@ type PostTaskUUIDCommentMultipartBody struct {
 	ReplyUuid *openapi_types.UUID `json:"reply_uuid,omitempty"`
 }
 
+// PostTaskUUIDCommentPollJSONBody defines parameters for PostTaskUUIDCommentPoll.
+type PostTaskUUIDCommentPollJSONBody struct {
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	Multiple *bool      `json:"multiple,omitempty"`
+	Options  []string   `json:"options"`
+	People   *[]string  `json:"people,omitempty"`
+	Question string     `json:"question"`
+}
+
 // PatchTaskUUIDCommentEntityUUIDMultipartBody defines parameters for PatchTaskUUIDCommentEntityUUID.
 type PatchTaskUUIDCommentEntityUUIDMultipartBody struct {
 	Comment   *string             `json:"comment,omitempty"`
@@ -132,11 +214,37 @@ type PatchTaskUUIDCommentEntityUUIDMultipartBody struct {
 	ReplyUuid *openapi_types.UUID `json:"reply_uuid,omitempty"`
 }
 
+// PostTaskUUIDCommentEntityUUIDPollVoteJSONBody defines parameters for PostTaskUUIDCommentEntityUUIDPollVote.
+type PostTaskUUIDCommentEntityUUIDPollVoteJSONBody struct {
+	Options []int `json:"options"`
+}
+
+// PatchTaskUUIDCommentEntityUUIDReactionJSONBody defines parameters for PatchTaskUUIDCommentEntityUUIDReaction.
+type PatchTaskUUIDCommentEntityUUIDReactionJSONBody struct {
+	Emoji string `json:"emoji"`
+}
+
+// PostTaskUUIDCommentEntityUUIDTaskJSONBody defines parameters for PostTaskUUIDCommentEntityUUIDTask.
+type PostTaskUUIDCommentEntityUUIDTaskJSONBody struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// PostTaskUUIDDependencyJSONBody defines parameters for PostTaskUUIDDependency.
+type PostTaskUUIDDependencyJSONBody struct {
+	Uuid openapi_types.UUID `json:"uuid" validate:"uuid"`
+}
+
 // PatchTaskUUIDParentJSONBody defines parameters for PatchTaskUUIDParent.
 type PatchTaskUUIDParentJSONBody struct {
 	Uuid *openapi_types.UUID `json:"uuid,omitempty" validate:"omitempty,uuid"`
 }
 
+// GetTaskUUIDProgressParams defines parameters for GetTaskUUIDProgress.
+type GetTaskUUIDProgressParams struct {
+	// WeightField Hash of the numeric task field with story points, each task weighs 1 if empty
+	WeightField *string `form:"weight_field,omitempty" json:"weight_field,omitempty"`
+}
+
 // PatchTaskUUIDProjectJSONBody defines parameters for PatchTaskUUIDProject.
 type PatchTaskUUIDProjectJSONBody struct {
 	Comment string             `json:"comment" validate:"trim,min=0,max=300"`
@@ -144,6 +252,14 @@ type PatchTaskUUIDProjectJSONBody struct {
 	Uuid    openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// PatchTaskUUIDScheduleJSONBody defines parameters for PatchTaskUUIDSchedule.
+type PatchTaskUUIDScheduleJSONBody struct {
+	AutoSchedule *bool      `json:"auto_schedule,omitempty"`
+	Duration     *int       `json:"duration,omitempty" validate:"omitempty,gte=0,lte=3650"`
+	FinishTo     *time.Time `json:"finish_to,omitempty"`
+	StartAt      *time.Time `json:"start_at,omitempty"`
+}
+
 // PatchTaskUUIDTeamJSONBody defines parameters for PatchTaskUUIDTeam.
 type PatchTaskUUIDTeamJSONBody struct {
 	CoworkersBy   *[]string `json:"coworkers_by,omitempty" validate:"omitempty,dive,email"`
@@ -158,23 +274,72 @@ type PatchTaskUUIDUploadMultipartBody struct {
 	File *openapi_types.File `json:"file,omitempty"`
 }
 
+// PostTaskUUIDUploadSha256JSONBody defines parameters for PostTaskUUIDUploadSha256.
+type PostTaskUUIDUploadSha256JSONBody struct {
+	// Name name of the file, the name of the stored file by default
+	Name   *string `json:"name,omitempty" validate:"max=50"`
+	Sha256 string  `json:"sha256" validate:"len=64,hexadecimal"`
+}
+
+// GetTaskUUIDUploadZipParams defines parameters for GetTaskUUIDUploadZip.
+type GetTaskUUIDUploadZipParams struct {
+	// Comments put the files of the task comments into the comments folder
+	Comments *bool `form:"comments,omitempty" json:"comments,omitempty"`
+
+	// Subtasks put the files of the visible subtasks into their folders
+	Subtasks *bool `form:"subtasks,omitempty" json:"subtasks,omitempty"`
+}
+
 // PostTaskUUIDUploadEntityUUIDRenameJSONBody defines parameters for PostTaskUUIDUploadEntityUUIDRename.
 type PostTaskUUIDUploadEntityUUIDRenameJSONBody struct {
 	Name string `json:"name" validate:"trim,min=1,max=50"`
 }
 
+// PostTaskUUIDUploadEntityUUIDVersionMultipartBody defines parameters for PostTaskUUIDUploadEntityUUIDVersion.
+type PostTaskUUIDUploadEntityUUIDVersionMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// PatchTaskUUIDVisibilityJSONBody defines parameters for PatchTaskUUIDVisibility.
+type PatchTaskUUIDVisibilityJSONBody struct {
+	Groups     *[]openapi_types.UUID                     `json:"groups,omitempty" validate:"omitempty,max=100"`
+	Users      *[]string                                 `json:"users,omitempty" validate:"omitempty,max=100,dive,email"`
+	Visibility PatchTaskUUIDVisibilityJSONBodyVisibility `json:"visibility"`
+}
+
+// PatchTaskUUIDVisibilityJSONBodyVisibility defines parameters for PatchTaskUUIDVisibility.
+type PatchTaskUUIDVisibilityJSONBodyVisibility string
+
 // PostTaskJSONRequestBody defines body for PostTask for application/json ContentType.
 type PostTaskJSONRequestBody = TaskCreateRequest
 
 // PutTaskUUIDJSONRequestBody defines body for PutTaskUUID for application/json ContentType.
 type PutTaskUUIDJSONRequestBody = TaskPutRequest
 
+// PostTaskUUIDApprovalVoteJSONRequestBody defines body for PostTaskUUIDApprovalVote for application/json ContentType.
+type PostTaskUUIDApprovalVoteJSONRequestBody PostTaskUUIDApprovalVoteJSONBody
+
 // PostTaskUUIDCommentMultipartRequestBody defines body for PostTaskUUIDComment for multipart/form-data ContentType.
 type PostTaskUUIDCommentMultipartRequestBody PostTaskUUIDCommentMultipartBody
 
+// PostTaskUUIDCommentPollJSONRequestBody defines body for PostTaskUUIDCommentPoll for application/json ContentType.
+type PostTaskUUIDCommentPollJSONRequestBody PostTaskUUIDCommentPollJSONBody
+
 // PatchTaskUUIDCommentEntityUUIDMultipartRequestBody defines body for PatchTaskUUIDCommentEntityUUID for multipart/form-data ContentType.
 type PatchTaskUUIDCommentEntityUUIDMultipartRequestBody PatchTaskUUIDCommentEntityUUIDMultipartBody
 
+// PostTaskUUIDCommentEntityUUIDPollVoteJSONRequestBody defines body for PostTaskUUIDCommentEntityUUIDPollVote for application/json ContentType.
+type PostTaskUUIDCommentEntityUUIDPollVoteJSONRequestBody PostTaskUUIDCommentEntityUUIDPollVoteJSONBody
+
+// PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody defines body for PatchTaskUUIDCommentEntityUUIDReaction for application/json ContentType.
+type PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody PatchTaskUUIDCommentEntityUUIDReactionJSONBody
+
+// PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody defines body for PostTaskUUIDCommentEntityUUIDTask for application/json ContentType.
+type PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody PostTaskUUIDCommentEntityUUIDTaskJSONBody
+
+// PostTaskUUIDDependencyJSONRequestBody defines body for PostTaskUUIDDependency for application/json ContentType.
+type PostTaskUUIDDependencyJSONRequestBody PostTaskUUIDDependencyJSONBody
+
 // PatchTaskUUIDNameJSONRequestBody defines body for PatchTaskUUIDName for application/json ContentType.
 type PatchTaskUUIDNameJSONRequestBody = NameRequest
 
@@ -184,6 +349,9 @@ type PatchTaskUUIDParentJSONRequestBody PatchTaskUUIDParentJSONBody
 // PatchTaskUUIDProjectJSONRequestBody defines body for PatchTaskUUIDProject for application/json ContentType.
 type PatchTaskUUIDProjectJSONRequestBody PatchTaskUUIDProjectJSONBody
 
+// PatchTaskUUIDScheduleJSONRequestBody defines body for PatchTaskUUIDSchedule for application/json ContentType.
+type PatchTaskUUIDScheduleJSONRequestBody PatchTaskUUIDScheduleJSONBody
+
 // PatchTaskUUIDStatusJSONRequestBody defines body for PatchTaskUUIDStatus for application/json ContentType.
 type PatchTaskUUIDStatusJSONRequestBody = StatusRequest
 
@@ -193,9 +361,18 @@ type PatchTaskUUIDTeamJSONRequestBody PatchTaskUUIDTeamJSONBody
 // PatchTaskUUIDUploadMultipartRequestBody defines body for PatchTaskUUIDUpload for multipart/form-data ContentType.
 type PatchTaskUUIDUploadMultipartRequestBody PatchTaskUUIDUploadMultipartBody
 
+// PostTaskUUIDUploadSha256JSONRequestBody defines body for PostTaskUUIDUploadSha256 for application/json ContentType.
+type PostTaskUUIDUploadSha256JSONRequestBody PostTaskUUIDUploadSha256JSONBody
+
 // PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody defines body for PostTaskUUIDUploadEntityUUIDRename for application/json ContentType.
 type PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody PostTaskUUIDUploadEntityUUIDRenameJSONBody
 
+// PostTaskUUIDUploadEntityUUIDVersionMultipartRequestBody defines body for PostTaskUUIDUploadEntityUUIDVersion for multipart/form-data ContentType.
+type PostTaskUUIDUploadEntityUUIDVersionMultipartRequestBody PostTaskUUIDUploadEntityUUIDVersionMultipartBody
+
+// PatchTaskUUIDVisibilityJSONRequestBody defines body for PatchTaskUUIDVisibility for application/json ContentType.
+type PatchTaskUUIDVisibilityJSONRequestBody PatchTaskUUIDVisibilityJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -217,12 +394,24 @@ type ServerInterface interface {
 	// (GET /task/{UUID}/activity)
 	GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error
 
+	// (GET /task/{UUID}/approval)
+	GetTaskUUIDApproval(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/approval/vote)
+	PostTaskUUIDApprovalVote(ctx echo.Context, uUID Uuid) error
+
 	// (GET /task/{UUID}/comment)
-	GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error
+	GetTaskUUIDComment(ctx echo.Context, uUID Uuid, params GetTaskUUIDCommentParams) error
 
 	// (POST /task/{UUID}/comment)
 	PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error
 
+	// (GET /task/{UUID}/comment/deleted)
+	GetTaskUUIDCommentDeleted(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/comment/poll)
+	PostTaskUUIDCommentPoll(ctx echo.Context, uUID Uuid) error
+
 	// (DELETE /task/{UUID}/comment/{entityUUID})
 	DeleteTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
@@ -238,15 +427,39 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (POST /task/{UUID}/comment/{entityUUID}/poll/vote)
+	PostTaskUUIDCommentEntityUUIDPollVote(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /task/{UUID}/comment/{entityUUID}/reaction)
+	PatchTaskUUIDCommentEntityUUIDReaction(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/comment/{entityUUID}/revisions)
+	GetTaskUUIDCommentEntityUUIDRevisions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/comment/{entityUUID}/task)
+	PostTaskUUIDCommentEntityUUIDTask(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/dependency)
+	PostTaskUUIDDependency(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/dependency/{entityUUID})
+	DeleteTaskUUIDDependencyEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error
 
 	// (PATCH /task/{UUID}/parent)
 	PatchTaskUUIDParent(ctx echo.Context, uUID Uuid) error
 
+	// (GET /task/{UUID}/progress)
+	GetTaskUUIDProgress(ctx echo.Context, uUID Uuid, params GetTaskUUIDProgressParams) error
+
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 
+	// (PATCH /task/{UUID}/schedule)
+	PatchTaskUUIDSchedule(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -262,6 +475,12 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/upload)
 	PatchTaskUUIDUpload(ctx echo.Context, uUID Uuid) error
 
+	// (POST /task/{UUID}/upload/sha256)
+	PostTaskUUIDUploadSha256(ctx echo.Context, uUID Uuid) error
+
+	// (GET /task/{UUID}/upload/zip)
+	GetTaskUUIDUploadZip(ctx echo.Context, uUID Uuid, params GetTaskUUIDUploadZipParams) error
+
 	// (DELETE /task/{UUID}/upload/{entityUUID})
 	DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
@@ -270,6 +489,18 @@ type ServerInterface interface {
 
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/upload/{entityUUID}/restore)
+	PostTaskUUIDUploadEntityUUIDRestore(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/upload/{entityUUID}/version)
+	PostTaskUUIDUploadEntityUUIDVersion(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/upload/{entityUUID}/versions)
+	GetTaskUUIDUploadEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /task/{UUID}/visibility)
+	PatchTaskUUIDVisibility(ctx echo.Context, uUID Uuid) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -390,6 +621,20 @@ func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "with_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with_total", ctx.QueryParams(), &params.WithTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter with_total: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetTask(ctx, params)
 	return err
@@ -489,11 +734,61 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDActivity(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "with_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with_total", ctx.QueryParams(), &params.WithTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter with_total: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetTaskUUIDActivity(ctx, uUID, params)
 	return err
 }
 
+// GetTaskUUIDApproval converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDApproval(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDApproval(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDApprovalVote converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDApprovalVote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDApprovalVote(ctx, uUID)
+	return err
+}
+
 // GetTaskUUIDComment converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
 	var err error
@@ -507,8 +802,31 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDCommentParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "with_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with_total", ctx.QueryParams(), &params.WithTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter with_total: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDComment(ctx, uUID)
+	err = w.Handler.GetTaskUUIDComment(ctx, uUID, params)
 	return err
 }
 
@@ -530,6 +848,42 @@ func (w *ServerInterfaceWrapper) PostTaskUUIDComment(ctx echo.Context) error {
 	return err
 }
 
+// GetTaskUUIDCommentDeleted converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDCommentDeleted(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDCommentDeleted(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDCommentPoll converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDCommentPoll(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDCommentPoll(ctx, uUID)
+	return err
+}
+
 // DeleteTaskUUIDCommentEntityUUID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context) error {
 	var err error
@@ -668,8 +1022,8 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Cont
 	return err
 }
 
-// PatchTaskUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
+// PostTaskUUIDCommentEntityUUIDPollVote converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDCommentEntityUUIDPollVote(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -679,33 +1033,23 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDName(ctx, uUID)
-	return err
-}
-
-// PatchTaskUUIDParent converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDParent(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDParent(ctx, uUID)
+	err = w.Handler.PostTaskUUIDCommentEntityUUIDPollVote(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchTaskUUIDProject converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
+// PatchTaskUUIDCommentEntityUUIDReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDReaction(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -715,15 +1059,23 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDProject(ctx, uUID)
+	err = w.Handler.PatchTaskUUIDCommentEntityUUIDReaction(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchTaskUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
+// GetTaskUUIDCommentEntityUUIDRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDCommentEntityUUIDRevisions(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -733,15 +1085,23 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDStatus(ctx, uUID)
+	err = w.Handler.GetTaskUUIDCommentEntityUUIDRevisions(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteTaskUUIDStopEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUIDStopEntityUUID(ctx echo.Context) error {
+// PostTaskUUIDCommentEntityUUIDTask converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDCommentEntityUUIDTask(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -762,12 +1122,12 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDStopEntityUUID(ctx echo.Context)
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDStopEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PostTaskUUIDCommentEntityUUIDTask(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchTaskUUIDTeam converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
+// PostTaskUUIDDependency converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDDependency(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -780,12 +1140,12 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDTeam(ctx, uUID)
+	err = w.Handler.PostTaskUUIDDependency(ctx, uUID)
 	return err
 }
 
-// GetTaskUUIDUpload converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
+// DeleteTaskUUIDDependencyEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDDependencyEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -795,15 +1155,23 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDUpload(ctx, uUID)
+	err = w.Handler.DeleteTaskUUIDDependencyEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchTaskUUIDUpload converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDUpload(ctx echo.Context) error {
+// PatchTaskUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -816,12 +1184,12 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDUpload(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDUpload(ctx, uUID)
+	err = w.Handler.PatchTaskUUIDName(ctx, uUID)
 	return err
 }
 
-// DeleteTaskUUIDUploadEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+// PatchTaskUUIDParent converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDParent(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -831,23 +1199,15 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PatchTaskUUIDParent(ctx, uUID)
 	return err
 }
 
-// GetTaskUUIDUploadEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+// GetTaskUUIDProgress converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDProgress(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -857,10 +1217,249 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDProgressParams
+	// ------------- Optional query parameter "weight_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weight_field", ctx.QueryParams(), &params.WeightField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter weight_field: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDProgress(ctx, uUID, params)
+	return err
+}
+
+// PatchTaskUUIDProject converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDProject(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDSchedule(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDStatus(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDStopEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDStopEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDStopEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchTaskUUIDTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDTeam(ctx, uUID)
+	return err
+}
+
+// GetTaskUUIDUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUpload(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDUpload(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDUploadSha256 converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDUploadSha256(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDUploadSha256(ctx, uUID)
+	return err
+}
+
+// GetTaskUUIDUploadZip converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadZip(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDUploadZipParams
+	// ------------- Optional query parameter "comments" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comments", ctx.QueryParams(), &params.Comments)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comments: %s", err))
+	}
+
+	// ------------- Optional query parameter "subtasks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subtasks", ctx.QueryParams(), &params.Subtasks)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subtasks: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUploadZip(ctx, uUID, params)
+	return err
+}
+
+// DeleteTaskUUIDUploadEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDUploadEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
@@ -898,6 +1497,102 @@ func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Con
 	return err
 }
 
+// PostTaskUUIDUploadEntityUUIDRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDUploadEntityUUIDRestore(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDUploadEntityUUIDVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDUploadEntityUUIDVersion(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDUploadEntityUUIDVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUIDVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUploadEntityUUIDVersions(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchTaskUUIDVisibility converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDVisibility(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDVisibility(ctx, uUID)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -932,24 +1627,42 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/task/:UUID", wrapper.GetTaskUUID)
 	router.PUT(baseURL+"/task/:UUID", wrapper.PutTaskUUID)
 	router.GET(baseURL+"/task/:UUID/activity", wrapper.GetTaskUUIDActivity)
+	router.GET(baseURL+"/task/:UUID/approval", wrapper.GetTaskUUIDApproval)
+	router.POST(baseURL+"/task/:UUID/approval/vote", wrapper.PostTaskUUIDApprovalVote)
 	router.GET(baseURL+"/task/:UUID/comment", wrapper.GetTaskUUIDComment)
 	router.POST(baseURL+"/task/:UUID/comment", wrapper.PostTaskUUIDComment)
+	router.GET(baseURL+"/task/:UUID/comment/deleted", wrapper.GetTaskUUIDCommentDeleted)
+	router.POST(baseURL+"/task/:UUID/comment/poll", wrapper.PostTaskUUIDCommentPoll)
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.DeleteTaskUUIDCommentEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.PatchTaskUUIDCommentEntityUUID)
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID/file/:fileUUID", wrapper.DeleteTaskUUIDCommentEntityUUIDFileFileUUID)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/like", wrapper.PatchTaskUUIDCommentEntityUUIDLike)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/pin", wrapper.PatchTaskUUIDCommentEntityUUIDPin)
+	router.POST(baseURL+"/task/:UUID/comment/:entityUUID/poll/vote", wrapper.PostTaskUUIDCommentEntityUUIDPollVote)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/reaction", wrapper.PatchTaskUUIDCommentEntityUUIDReaction)
+	router.GET(baseURL+"/task/:UUID/comment/:entityUUID/revisions", wrapper.GetTaskUUIDCommentEntityUUIDRevisions)
+	router.POST(baseURL+"/task/:UUID/comment/:entityUUID/task", wrapper.PostTaskUUIDCommentEntityUUIDTask)
+	router.POST(baseURL+"/task/:UUID/dependency", wrapper.PostTaskUUIDDependency)
+	router.DELETE(baseURL+"/task/:UUID/dependency/:entityUUID", wrapper.DeleteTaskUUIDDependencyEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/name", wrapper.PatchTaskUUIDName)
 	router.PATCH(baseURL+"/task/:UUID/parent", wrapper.PatchTaskUUIDParent)
+	router.GET(baseURL+"/task/:UUID/progress", wrapper.GetTaskUUIDProgress)
 	router.PATCH(baseURL+"/task/:UUID/project", wrapper.PatchTaskUUIDProject)
+	router.PATCH(baseURL+"/task/:UUID/schedule", wrapper.PatchTaskUUIDSchedule)
 	router.PATCH(baseURL+"/task/:UUID/status", wrapper.PatchTaskUUIDStatus)
 	router.DELETE(baseURL+"/task/:UUID/stop/:entityUUID", wrapper.DeleteTaskUUIDStopEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/team", wrapper.PatchTaskUUIDTeam)
 	router.GET(baseURL+"/task/:UUID/upload", wrapper.GetTaskUUIDUpload)
 	router.PATCH(baseURL+"/task/:UUID/upload", wrapper.PatchTaskUUIDUpload)
+	router.POST(baseURL+"/task/:UUID/upload/sha256", wrapper.PostTaskUUIDUploadSha256)
+	router.GET(baseURL+"/task/:UUID/upload/zip", wrapper.GetTaskUUIDUploadZip)
 	router.DELETE(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.DeleteTaskUUIDUploadEntityUUID)
 	router.GET(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.GetTaskUUIDUploadEntityUUID)
 	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/rename", wrapper.PostTaskUUIDUploadEntityUUIDRename)
+	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/restore", wrapper.PostTaskUUIDUploadEntityUUIDRestore)
+	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/version", wrapper.PostTaskUUIDUploadEntityUUIDVersion)
+	router.GET(baseURL+"/task/:UUID/upload/:entityUUID/versions", wrapper.GetTaskUUIDUploadEntityUUIDVersions)
+	router.PATCH(baseURL+"/task/:UUID/visibility", wrapper.PatchTaskUUIDVisibility)
 
 }
 
@@ -971,7 +1684,10 @@ type GetTask200JSONResponse struct {
 	Body struct {
 		Count int        `json:"count"`
 		Items []TaskDTOs `json:"items"`
-		Total int64      `json:"total"`
+
+		// NextCursor empty on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+		Total      int64   `json:"total"`
 	}
 	Headers GetTask200ResponseHeaders
 }
@@ -1075,34 +1791,75 @@ type PutTaskUUIDRequestObject struct {
 	Body *PutTaskUUIDJSONRequestBody
 }
 
-type PutTaskUUIDResponseObject interface {
-	VisitPutTaskUUIDResponse(w http.ResponseWriter) error
+type PutTaskUUIDResponseObject interface {
+	VisitPutTaskUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutTaskUUID200Response struct {
+}
+
+func (response PutTaskUUID200Response) VisitPutTaskUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDActivityRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDActivityParams
+}
+
+type GetTaskUUIDActivityResponseObject interface {
+	VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDActivity200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []ActivityDTO `json:"items"`
+
+	// NextCursor empty on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Total      int64   `json:"total"`
+}
+
+func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDApprovalRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDApprovalResponseObject interface {
+	VisitGetTaskUUIDApprovalResponse(w http.ResponseWriter) error
 }
 
-type PutTaskUUID200Response struct {
+type GetTaskUUIDApproval200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []TaskApprovalDTO `json:"items"`
 }
 
-func (response PutTaskUUID200Response) VisitPutTaskUUIDResponse(w http.ResponseWriter) error {
+func (response GetTaskUUIDApproval200JSONResponse) VisitGetTaskUUIDApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
 
-type GetTaskUUIDActivityRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetTaskUUIDActivityParams
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetTaskUUIDActivityResponseObject interface {
-	VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error
+type PostTaskUUIDApprovalVoteRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDApprovalVoteJSONRequestBody
 }
 
-type GetTaskUUIDActivity200JSONResponse struct {
-	Count int           `json:"count"`
-	Items []ActivityDTO `json:"items"`
-	Total int64         `json:"total"`
+type PostTaskUUIDApprovalVoteResponseObject interface {
+	VisitPostTaskUUIDApprovalVoteResponse(w http.ResponseWriter) error
 }
 
-func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error {
+type PostTaskUUIDApprovalVote200JSONResponse TaskApprovalDTO
+
+func (response PostTaskUUIDApprovalVote200JSONResponse) VisitPostTaskUUIDApprovalVoteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
@@ -1110,7 +1867,8 @@ func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityRespo
 }
 
 type GetTaskUUIDCommentRequestObject struct {
-	UUID Uuid `json:"UUID"`
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDCommentParams
 }
 
 type GetTaskUUIDCommentResponseObject interface {
@@ -1120,6 +1878,10 @@ type GetTaskUUIDCommentResponseObject interface {
 type GetTaskUUIDComment200JSONResponse struct {
 	Count int          `json:"count"`
 	Items []CommentDTO `json:"items"`
+
+	// NextCursor empty on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Total      *int64  `json:"total,omitempty"`
 }
 
 func (response GetTaskUUIDComment200JSONResponse) VisitGetTaskUUIDCommentResponse(w http.ResponseWriter) error {
@@ -1153,6 +1915,48 @@ func (response PostTaskUUIDComment200JSONResponse) VisitPostTaskUUIDCommentRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetTaskUUIDCommentDeletedRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDCommentDeletedResponseObject interface {
+	VisitGetTaskUUIDCommentDeletedResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDCommentDeleted200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []CommentDTO `json:"items"`
+}
+
+func (response GetTaskUUIDCommentDeleted200JSONResponse) VisitGetTaskUUIDCommentDeletedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDCommentPollRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDCommentPollJSONRequestBody
+}
+
+type PostTaskUUIDCommentPollResponseObject interface {
+	VisitPostTaskUUIDCommentPollResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDCommentPoll200JSONResponse struct {
+	Comment string             `json:"comment"`
+	Poll    PollDTO            `json:"poll"`
+	Uuid    openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDCommentPoll200JSONResponse) VisitPostTaskUUIDCommentPollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteTaskUUIDCommentEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
@@ -1251,6 +2055,124 @@ func (response PatchTaskUUIDCommentEntityUUIDPin200Response) VisitPatchTaskUUIDC
 	return nil
 }
 
+type PostTaskUUIDCommentEntityUUIDPollVoteRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostTaskUUIDCommentEntityUUIDPollVoteJSONRequestBody
+}
+
+type PostTaskUUIDCommentEntityUUIDPollVoteResponseObject interface {
+	VisitPostTaskUUIDCommentEntityUUIDPollVoteResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDCommentEntityUUIDPollVote200JSONResponse PollDTO
+
+func (response PostTaskUUIDCommentEntityUUIDPollVote200JSONResponse) VisitPostTaskUUIDCommentEntityUUIDPollVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDCommentEntityUUIDReactionRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody
+}
+
+type PatchTaskUUIDCommentEntityUUIDReactionResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDReactionResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUIDReaction200JSONResponse struct {
+	Added     bool          `json:"added"`
+	Reactions []ReactionDTO `json:"reactions"`
+}
+
+func (response PatchTaskUUIDCommentEntityUUIDReaction200JSONResponse) VisitPatchTaskUUIDCommentEntityUUIDReactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDCommentEntityUUIDRevisionsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDCommentEntityUUIDRevisionsResponseObject interface {
+	VisitGetTaskUUIDCommentEntityUUIDRevisionsResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDCommentEntityUUIDRevisions200JSONResponse struct {
+	Count int                  `json:"count"`
+	Items []CommentRevisionDTO `json:"items"`
+}
+
+func (response GetTaskUUIDCommentEntityUUIDRevisions200JSONResponse) VisitGetTaskUUIDCommentEntityUUIDRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDCommentEntityUUIDTaskRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody
+}
+
+type PostTaskUUIDCommentEntityUUIDTaskResponseObject interface {
+	VisitPostTaskUUIDCommentEntityUUIDTaskResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDCommentEntityUUIDTask200JSONResponse struct {
+	Id   int                `json:"id"`
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDCommentEntityUUIDTask200JSONResponse) VisitPostTaskUUIDCommentEntityUUIDTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDDependencyRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDDependencyJSONRequestBody
+}
+
+type PostTaskUUIDDependencyResponseObject interface {
+	VisitPostTaskUUIDDependencyResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDDependency200Response struct {
+}
+
+func (response PostTaskUUIDDependency200Response) VisitPostTaskUUIDDependencyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteTaskUUIDDependencyEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDDependencyEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDDependencyEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDDependencyEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDDependencyEntityUUID200Response) VisitDeleteTaskUUIDDependencyEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 type PatchTaskUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDNameJSONRequestBody
@@ -1301,6 +2223,24 @@ func (response PatchTaskUUIDParent200Response) VisitPatchTaskUUIDParentResponse(
 	return nil
 }
 
+type GetTaskUUIDProgressRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDProgressParams
+}
+
+type GetTaskUUIDProgressResponseObject interface {
+	VisitGetTaskUUIDProgressResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDProgress200JSONResponse EpicProgressDTO
+
+func (response GetTaskUUIDProgress200JSONResponse) VisitGetTaskUUIDProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchTaskUUIDProjectRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDProjectJSONRequestBody
@@ -1318,6 +2258,26 @@ func (response PatchTaskUUIDProject200Response) VisitPatchTaskUUIDProjectRespons
 	return nil
 }
 
+type PatchTaskUUIDScheduleRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDScheduleJSONRequestBody
+}
+
+type PatchTaskUUIDScheduleResponseObject interface {
+	VisitPatchTaskUUIDScheduleResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDSchedule200JSONResponse struct {
+	Moved []openapi_types.UUID `json:"moved"`
+}
+
+func (response PatchTaskUUIDSchedule200JSONResponse) VisitPatchTaskUUIDScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchTaskUUIDStatusRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDStatusJSONRequestBody
@@ -1418,6 +2378,60 @@ func (response PatchTaskUUIDUpload200JSONResponse) VisitPatchTaskUUIDUploadRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostTaskUUIDUploadSha256RequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDUploadSha256JSONRequestBody
+}
+
+type PostTaskUUIDUploadSha256ResponseObject interface {
+	VisitPostTaskUUIDUploadSha256Response(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDUploadSha256200JSONResponse UploadDTO
+
+func (response PostTaskUUIDUploadSha256200JSONResponse) VisitPostTaskUUIDUploadSha256Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDUploadZipRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDUploadZipParams
+}
+
+type GetTaskUUIDUploadZipResponseObject interface {
+	VisitGetTaskUUIDUploadZipResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUploadZip200ResponseHeaders struct {
+	ContentDisposition string
+	CacheControl       string
+}
+
+type GetTaskUUIDUploadZip200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       GetTaskUUIDUploadZip200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaskUUIDUploadZip200ApplicationzipResponse) VisitGetTaskUUIDUploadZipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
 type DeleteTaskUUIDUploadEntityUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
@@ -1476,6 +2490,81 @@ func (response PostTaskUUIDUploadEntityUUIDRename200Response) VisitPostTaskUUIDU
 	return nil
 }
 
+type PostTaskUUIDUploadEntityUUIDRestoreRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PostTaskUUIDUploadEntityUUIDRestoreResponseObject interface {
+	VisitPostTaskUUIDUploadEntityUUIDRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDUploadEntityUUIDRestore200JSONResponse UploadDTO
+
+func (response PostTaskUUIDUploadEntityUUIDRestore200JSONResponse) VisitPostTaskUUIDUploadEntityUUIDRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDUploadEntityUUIDVersionRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *multipart.Reader
+}
+
+type PostTaskUUIDUploadEntityUUIDVersionResponseObject interface {
+	VisitPostTaskUUIDUploadEntityUUIDVersionResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDUploadEntityUUIDVersion200JSONResponse UploadDTO
+
+func (response PostTaskUUIDUploadEntityUUIDVersion200JSONResponse) VisitPostTaskUUIDUploadEntityUUIDVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDUploadEntityUUIDVersionsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDUploadEntityUUIDVersionsResponseObject interface {
+	VisitGetTaskUUIDUploadEntityUUIDVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUploadEntityUUIDVersions200JSONResponse struct {
+	Count int              `json:"count"`
+	Items []FileVersionDTO `json:"items"`
+}
+
+func (response GetTaskUUIDUploadEntityUUIDVersions200JSONResponse) VisitGetTaskUUIDUploadEntityUUIDVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDVisibilityRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDVisibilityJSONRequestBody
+}
+
+type PatchTaskUUIDVisibilityResponseObject interface {
+	VisitPatchTaskUUIDVisibilityResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDVisibility200Response struct {
+}
+
+func (response PatchTaskUUIDVisibility200Response) VisitPatchTaskUUIDVisibilityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
@@ -1497,12 +2586,24 @@ type StrictServerInterface interface {
 	// (GET /task/{UUID}/activity)
 	GetTaskUUIDActivity(ctx context.Context, request GetTaskUUIDActivityRequestObject) (GetTaskUUIDActivityResponseObject, error)
 
+	// (GET /task/{UUID}/approval)
+	GetTaskUUIDApproval(ctx context.Context, request GetTaskUUIDApprovalRequestObject) (GetTaskUUIDApprovalResponseObject, error)
+
+	// (POST /task/{UUID}/approval/vote)
+	PostTaskUUIDApprovalVote(ctx context.Context, request PostTaskUUIDApprovalVoteRequestObject) (PostTaskUUIDApprovalVoteResponseObject, error)
+
 	// (GET /task/{UUID}/comment)
 	GetTaskUUIDComment(ctx context.Context, request GetTaskUUIDCommentRequestObject) (GetTaskUUIDCommentResponseObject, error)
 
 	// (POST /task/{UUID}/comment)
 	PostTaskUUIDComment(ctx context.Context, request PostTaskUUIDCommentRequestObject) (PostTaskUUIDCommentResponseObject, error)
 
+	// (GET /task/{UUID}/comment/deleted)
+	GetTaskUUIDCommentDeleted(ctx context.Context, request GetTaskUUIDCommentDeletedRequestObject) (GetTaskUUIDCommentDeletedResponseObject, error)
+
+	// (POST /task/{UUID}/comment/poll)
+	PostTaskUUIDCommentPoll(ctx context.Context, request PostTaskUUIDCommentPollRequestObject) (PostTaskUUIDCommentPollResponseObject, error)
+
 	// (DELETE /task/{UUID}/comment/{entityUUID})
 	DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDResponseObject, error)
 
@@ -1518,15 +2619,39 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDPinRequestObject) (PatchTaskUUIDCommentEntityUUIDPinResponseObject, error)
 
+	// (POST /task/{UUID}/comment/{entityUUID}/poll/vote)
+	PostTaskUUIDCommentEntityUUIDPollVote(ctx context.Context, request PostTaskUUIDCommentEntityUUIDPollVoteRequestObject) (PostTaskUUIDCommentEntityUUIDPollVoteResponseObject, error)
+
+	// (PATCH /task/{UUID}/comment/{entityUUID}/reaction)
+	PatchTaskUUIDCommentEntityUUIDReaction(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDReactionRequestObject) (PatchTaskUUIDCommentEntityUUIDReactionResponseObject, error)
+
+	// (GET /task/{UUID}/comment/{entityUUID}/revisions)
+	GetTaskUUIDCommentEntityUUIDRevisions(ctx context.Context, request GetTaskUUIDCommentEntityUUIDRevisionsRequestObject) (GetTaskUUIDCommentEntityUUIDRevisionsResponseObject, error)
+
+	// (POST /task/{UUID}/comment/{entityUUID}/task)
+	PostTaskUUIDCommentEntityUUIDTask(ctx context.Context, request PostTaskUUIDCommentEntityUUIDTaskRequestObject) (PostTaskUUIDCommentEntityUUIDTaskResponseObject, error)
+
+	// (POST /task/{UUID}/dependency)
+	PostTaskUUIDDependency(ctx context.Context, request PostTaskUUIDDependencyRequestObject) (PostTaskUUIDDependencyResponseObject, error)
+
+	// (DELETE /task/{UUID}/dependency/{entityUUID})
+	DeleteTaskUUIDDependencyEntityUUID(ctx context.Context, request DeleteTaskUUIDDependencyEntityUUIDRequestObject) (DeleteTaskUUIDDependencyEntityUUIDResponseObject, error)
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx context.Context, request PatchTaskUUIDNameRequestObject) (PatchTaskUUIDNameResponseObject, error)
 
 	// (PATCH /task/{UUID}/parent)
 	PatchTaskUUIDParent(ctx context.Context, request PatchTaskUUIDParentRequestObject) (PatchTaskUUIDParentResponseObject, error)
 
+	// (GET /task/{UUID}/progress)
+	GetTaskUUIDProgress(ctx context.Context, request GetTaskUUIDProgressRequestObject) (GetTaskUUIDProgressResponseObject, error)
+
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx context.Context, request PatchTaskUUIDProjectRequestObject) (PatchTaskUUIDProjectResponseObject, error)
 
+	// (PATCH /task/{UUID}/schedule)
+	PatchTaskUUIDSchedule(ctx context.Context, request PatchTaskUUIDScheduleRequestObject) (PatchTaskUUIDScheduleResponseObject, error)
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx context.Context, request PatchTaskUUIDStatusRequestObject) (PatchTaskUUIDStatusResponseObject, error)
 
@@ -1542,6 +2667,12 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/upload)
 	PatchTaskUUIDUpload(ctx context.Context, request PatchTaskUUIDUploadRequestObject) (PatchTaskUUIDUploadResponseObject, error)
 
+	// (POST /task/{UUID}/upload/sha256)
+	PostTaskUUIDUploadSha256(ctx context.Context, request PostTaskUUIDUploadSha256RequestObject) (PostTaskUUIDUploadSha256ResponseObject, error)
+
+	// (GET /task/{UUID}/upload/zip)
+	GetTaskUUIDUploadZip(ctx context.Context, request GetTaskUUIDUploadZipRequestObject) (GetTaskUUIDUploadZipResponseObject, error)
+
 	// (DELETE /task/{UUID}/upload/{entityUUID})
 	DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request DeleteTaskUUIDUploadEntityUUIDRequestObject) (DeleteTaskUUIDUploadEntityUUIDResponseObject, error)
 
@@ -1550,6 +2681,18 @@ type StrictServerInterface interface {
 
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request PostTaskUUIDUploadEntityUUIDRenameRequestObject) (PostTaskUUIDUploadEntityUUIDRenameResponseObject, error)
+
+	// (POST /task/{UUID}/upload/{entityUUID}/restore)
+	PostTaskUUIDUploadEntityUUIDRestore(ctx context.Context, request PostTaskUUIDUploadEntityUUIDRestoreRequestObject) (PostTaskUUIDUploadEntityUUIDRestoreResponseObject, error)
+
+	// (POST /task/{UUID}/upload/{entityUUID}/version)
+	PostTaskUUIDUploadEntityUUIDVersion(ctx context.Context, request PostTaskUUIDUploadEntityUUIDVersionRequestObject) (PostTaskUUIDUploadEntityUUIDVersionResponseObject, error)
+
+	// (GET /task/{UUID}/upload/{entityUUID}/versions)
+	GetTaskUUIDUploadEntityUUIDVersions(ctx context.Context, request GetTaskUUIDUploadEntityUUIDVersionsRequestObject) (GetTaskUUIDUploadEntityUUIDVersionsResponseObject, error)
+
+	// (PATCH /task/{UUID}/visibility)
+	PatchTaskUUIDVisibility(ctx context.Context, request PatchTaskUUIDVisibilityRequestObject) (PatchTaskUUIDVisibilityResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -1725,193 +2868,485 @@ func (sh *strictHandler) GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params
 	return nil
 }
 
-// GetTaskUUIDComment operation middleware
-func (sh *strictHandler) GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
-	var request GetTaskUUIDCommentRequestObject
+// GetTaskUUIDApproval operation middleware
+func (sh *strictHandler) GetTaskUUIDApproval(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDApprovalRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDApproval(ctx.Request().Context(), request.(GetTaskUUIDApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDApproval")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDApprovalResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDApprovalResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDApprovalVote operation middleware
+func (sh *strictHandler) PostTaskUUIDApprovalVote(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDApprovalVoteRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDApprovalVoteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDApprovalVote(ctx.Request().Context(), request.(PostTaskUUIDApprovalVoteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDApprovalVote")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDApprovalVoteResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDApprovalVoteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDComment operation middleware
+func (sh *strictHandler) GetTaskUUIDComment(ctx echo.Context, uUID Uuid, params GetTaskUUIDCommentParams) error {
+	var request GetTaskUUIDCommentRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDComment(ctx.Request().Context(), request.(GetTaskUUIDCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDComment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDCommentResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDComment operation middleware
+func (sh *strictHandler) PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDCommentRequestObject
+
+	request.UUID = uUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDComment(ctx.Request().Context(), request.(PostTaskUUIDCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDComment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDCommentResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCommentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDCommentDeleted operation middleware
+func (sh *strictHandler) GetTaskUUIDCommentDeleted(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDCommentDeletedRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDCommentDeleted(ctx.Request().Context(), request.(GetTaskUUIDCommentDeletedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDCommentDeleted")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDCommentDeletedResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentDeletedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDCommentPoll operation middleware
+func (sh *strictHandler) PostTaskUUIDCommentPoll(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDCommentPollRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDCommentPollJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDCommentPoll(ctx.Request().Context(), request.(PostTaskUUIDCommentPollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDCommentPoll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDCommentPollResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCommentPollResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTaskUUIDCommentEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDCommentEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchTaskUUIDCommentEntityUUID operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTaskUUIDCommentEntityUUIDFileFileUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
+	var request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.FileUUID = fileUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUIDFileFileUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchTaskUUIDCommentEntityUUIDLike operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDLikeRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDLike(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDLikeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDLike")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDLikeResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchTaskUUIDCommentEntityUUIDPin operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDPinRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUIDComment(ctx.Request().Context(), request.(GetTaskUUIDCommentRequestObject))
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDPin(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDPinRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUIDComment")
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDPin")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDCommentResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDCommentResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDPinResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDPinResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTaskUUIDComment operation middleware
-func (sh *strictHandler) PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
-	var request PostTaskUUIDCommentRequestObject
+// PostTaskUUIDCommentEntityUUIDPollVote operation middleware
+func (sh *strictHandler) PostTaskUUIDCommentEntityUUIDPollVote(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDCommentEntityUUIDPollVoteRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	if reader, err := ctx.Request().MultipartReader(); err != nil {
+	var body PostTaskUUIDCommentEntityUUIDPollVoteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
 		return err
-	} else {
-		request.Body = reader
 	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTaskUUIDComment(ctx.Request().Context(), request.(PostTaskUUIDCommentRequestObject))
+		return sh.ssi.PostTaskUUIDCommentEntityUUIDPollVote(ctx.Request().Context(), request.(PostTaskUUIDCommentEntityUUIDPollVoteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTaskUUIDComment")
+		handler = middleware(handler, "PostTaskUUIDCommentEntityUUIDPollVote")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTaskUUIDCommentResponseObject); ok {
-		return validResponse.VisitPostTaskUUIDCommentResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDCommentEntityUUIDPollVoteResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCommentEntityUUIDPollVoteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUIDCommentEntityUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteTaskUUIDCommentEntityUUIDRequestObject
+// PatchTaskUUIDCommentEntityUUIDReaction operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDReaction(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDReactionRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
+	var body PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDRequestObject))
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDReaction(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDReactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUID")
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDReaction")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDReactionResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDReactionResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDCommentEntityUUID operation middleware
-func (sh *strictHandler) PatchTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchTaskUUIDCommentEntityUUIDRequestObject
+// GetTaskUUIDCommentEntityUUIDRevisions operation middleware
+func (sh *strictHandler) GetTaskUUIDCommentEntityUUIDRevisions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDCommentEntityUUIDRevisionsRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
-	if reader, err := ctx.Request().MultipartReader(); err != nil {
-		return err
-	} else {
-		request.Body = reader
-	}
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDRequestObject))
+		return sh.ssi.GetTaskUUIDCommentEntityUUIDRevisions(ctx.Request().Context(), request.(GetTaskUUIDCommentEntityUUIDRevisionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUID")
+		handler = middleware(handler, "GetTaskUUIDCommentEntityUUIDRevisions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskUUIDCommentEntityUUIDRevisionsResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentEntityUUIDRevisionsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUIDCommentEntityUUIDFileFileUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
-	var request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject
+// PostTaskUUIDCommentEntityUUIDTask operation middleware
+func (sh *strictHandler) PostTaskUUIDCommentEntityUUIDTask(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDCommentEntityUUIDTaskRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
-	request.FileUUID = fileUUID
+
+	var body PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject))
+		return sh.ssi.PostTaskUUIDCommentEntityUUIDTask(ctx.Request().Context(), request.(PostTaskUUIDCommentEntityUUIDTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUIDFileFileUUID")
+		handler = middleware(handler, "PostTaskUUIDCommentEntityUUIDTask")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDCommentEntityUUIDTaskResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCommentEntityUUIDTaskResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDCommentEntityUUIDLike operation middleware
-func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchTaskUUIDCommentEntityUUIDLikeRequestObject
+// PostTaskUUIDDependency operation middleware
+func (sh *strictHandler) PostTaskUUIDDependency(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDDependencyRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+
+	var body PostTaskUUIDDependencyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDCommentEntityUUIDLike(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDLikeRequestObject))
+		return sh.ssi.PostTaskUUIDDependency(ctx.Request().Context(), request.(PostTaskUUIDDependencyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDLike")
+		handler = middleware(handler, "PostTaskUUIDDependency")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDLikeResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDDependencyResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDDependencyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDCommentEntityUUIDPin operation middleware
-func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchTaskUUIDCommentEntityUUIDPinRequestObject
+// DeleteTaskUUIDDependencyEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDDependencyEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDDependencyEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDCommentEntityUUIDPin(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDPinRequestObject))
+		return sh.ssi.DeleteTaskUUIDDependencyEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDDependencyEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDPin")
+		handler = middleware(handler, "DeleteTaskUUIDDependencyEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDPinResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDPinResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTaskUUIDDependencyEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDDependencyEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -1980,6 +3415,32 @@ func (sh *strictHandler) PatchTaskUUIDParent(ctx echo.Context, uUID Uuid) error
 	return nil
 }
 
+// GetTaskUUIDProgress operation middleware
+func (sh *strictHandler) GetTaskUUIDProgress(ctx echo.Context, uUID Uuid, params GetTaskUUIDProgressParams) error {
+	var request GetTaskUUIDProgressRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDProgress(ctx.Request().Context(), request.(GetTaskUUIDProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDProgress")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDProgressResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDProgressResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDProject operation middleware
 func (sh *strictHandler) PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDProjectRequestObject
@@ -2011,6 +3472,37 @@ func (sh *strictHandler) PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 	return nil
 }
 
+// PatchTaskUUIDSchedule operation middleware
+func (sh *strictHandler) PatchTaskUUIDSchedule(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDScheduleRequestObject
+
+	request.UUID = uUID
+
+	var body PatchTaskUUIDScheduleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDSchedule(ctx.Request().Context(), request.(PatchTaskUUIDScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDScheduleResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDScheduleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDStatus operation middleware
 func (sh *strictHandler) PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDStatusRequestObject
@@ -2155,6 +3647,63 @@ func (sh *strictHandler) PatchTaskUUIDUpload(ctx echo.Context, uUID Uuid) error
 	return nil
 }
 
+// PostTaskUUIDUploadSha256 operation middleware
+func (sh *strictHandler) PostTaskUUIDUploadSha256(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDUploadSha256RequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDUploadSha256JSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDUploadSha256(ctx.Request().Context(), request.(PostTaskUUIDUploadSha256RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDUploadSha256")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDUploadSha256ResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDUploadSha256Response(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDUploadZip operation middleware
+func (sh *strictHandler) GetTaskUUIDUploadZip(ctx echo.Context, uUID Uuid, params GetTaskUUIDUploadZipParams) error {
+	var request GetTaskUUIDUploadZipRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDUploadZip(ctx.Request().Context(), request.(GetTaskUUIDUploadZipRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDUploadZip")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDUploadZipResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadZipResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteTaskUUIDUploadEntityUUID operation middleware
 func (sh *strictHandler) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
 	var request DeleteTaskUUIDUploadEntityUUIDRequestObject
@@ -2238,3 +3787,118 @@ func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uU
 	}
 	return nil
 }
+
+// PostTaskUUIDUploadEntityUUIDRestore operation middleware
+func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRestore(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDUploadEntityUUIDRestoreRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDUploadEntityUUIDRestore(ctx.Request().Context(), request.(PostTaskUUIDUploadEntityUUIDRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDUploadEntityUUIDRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDUploadEntityUUIDRestoreResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDUploadEntityUUIDRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDUploadEntityUUIDVersion operation middleware
+func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDVersion(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDUploadEntityUUIDVersionRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDUploadEntityUUIDVersion(ctx.Request().Context(), request.(PostTaskUUIDUploadEntityUUIDVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDUploadEntityUUIDVersion")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDUploadEntityUUIDVersionResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDUploadEntityUUIDVersionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDUploadEntityUUIDVersions operation middleware
+func (sh *strictHandler) GetTaskUUIDUploadEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDUploadEntityUUIDVersionsRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDUploadEntityUUIDVersions(ctx.Request().Context(), request.(GetTaskUUIDUploadEntityUUIDVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDUploadEntityUUIDVersions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDUploadEntityUUIDVersionsResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadEntityUUIDVersionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchTaskUUIDVisibility operation middleware
+func (sh *strictHandler) PatchTaskUUIDVisibility(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDVisibilityRequestObject
+
+	request.UUID = uUID
+
+	var body PatchTaskUUIDVisibilityJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDVisibility(ctx.Request().Context(), request.(PatchTaskUUIDVisibilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDVisibility")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDVisibilityResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDVisibilityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
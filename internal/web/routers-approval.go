@@ -0,0 +1,126 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDApprovalPolicy(ctx context.Context, request oapi.GetProjectUUIDApprovalPolicyRequestObject) (oapi.GetProjectUUIDApprovalPolicyResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.ApprovalPolicyManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.ApprovalsService.GetPolicy(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDApprovalPolicy200JSONResponse(dto.NewApprovalPolicyDTO(dm)), nil
+}
+
+func (a *Web) PutProjectUUIDApprovalPolicy(ctx context.Context, request oapi.PutProjectUUIDApprovalPolicyRequestObject) (oapi.PutProjectUUIDApprovalPolicyResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.ApprovalPolicyManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, dto.NotFoundErr("проект не найден")
+	}
+
+	dm, err := domain.NewApprovalPolicy(project.FederationUUID, project.CompanyUUID, project.UUID, domain.Me{
+		Email: claims.Email,
+		UUID:  claims.UUID,
+	}, lo.FromPtrOr(request.Body.Approvers, []string{}), lo.FromPtrOr(request.Body.Groups, []uuid.UUID{}), string(request.Body.Rule), lo.FromPtr(request.Body.Quorum))
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.ApprovalsService.SavePolicy(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProjectUUIDApprovalPolicy200JSONResponse(dto.NewApprovalPolicyDTO(*dm)), nil
+}
+
+func (a *Web) DeleteProjectUUIDApprovalPolicy(ctx context.Context, request oapi.DeleteProjectUUIDApprovalPolicyRequestObject) (oapi.DeleteProjectUUIDApprovalPolicyResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.ApprovalPolicyManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.ApprovalsService.DeletePolicy(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProjectUUIDApprovalPolicy200Response{}, nil
+}
+
+func (a *Web) GetTaskUUIDApproval(ctx context.Context, request otask.GetTaskUUIDApprovalRequestObject) (otask.GetTaskUUIDApprovalResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.ApprovalsService.GetApprovals(ctx, task.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return otask.GetTaskUUIDApproval200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.TaskApproval, _ int) dto.TaskApprovalDTO {
+			return dto.NewTaskApprovalDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDApprovalVote(ctx context.Context, request otask.PostTaskUUIDApprovalVoteRequestObject) (otask.PostTaskUUIDApprovalVoteResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.ApprovalsService.Vote(ctx, domain.NewCreatorFromUser(&claims), task, string(request.Body.Decision), lo.FromPtr(request.Body.Comment))
+	if err != nil {
+		return nil, err
+	}
+
+	return otask.PostTaskUUIDApprovalVote200JSONResponse(dto.NewTaskApprovalDTO(dm)), nil
+}
@@ -350,6 +350,9 @@ func (a *Web) GetCatalogUUIDData(ctx context.Context, request oapi.GetCatalogUUI
 
 		Order: request.Params.Order,
 		By:    request.Params.By,
+
+		Cursor:    request.Params.Cursor,
+		WithTotal: helpers.Deref(request.Params.WithTotal, false),
 	}
 
 	err = search.Validate()
@@ -357,7 +360,7 @@ func (a *Web) GetCatalogUUIDData(ctx context.Context, request oapi.GetCatalogUUI
 		return nil, err
 	}
 
-	dmns, total, err := a.app.CatalogService.GetData(search)
+	dmns, total, next, err := a.app.CatalogService.GetData(search)
 	if err != nil {
 		return nil, err
 	}
@@ -407,13 +410,15 @@ func (a *Web) GetCatalogUUIDData(ctx context.Context, request oapi.GetCatalogUUI
 
 	return oapi.GetCatalogUUIDData200JSONResponse{
 		Body: struct {
-			Count int                      `json:"count"`
-			Items []map[string]interface{} `json:"items"`
-			Total int64                    `json:"total"`
+			Count      int                      `json:"count"`
+			Items      []map[string]interface{} `json:"items"`
+			NextCursor *string                  `json:"next_cursor,omitempty"`
+			Total      int64                    `json:"total"`
 		}{
-			Count: len(dtos),
-			Items: dtos,
-			Total: total,
+			Count:      len(dtos),
+			Items:      dtos,
+			NextCursor: lo.EmptyableToPtr(next),
+			Total:      total,
 		},
 		Headers: oapi.GetCatalogUUIDData200ResponseHeaders{
 			CacheControl: "no-cache",
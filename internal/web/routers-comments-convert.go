@@ -0,0 +1,40 @@
+package web
+
+import (
+	"context"
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskUUIDCommentEntityUUIDTask(ctx context.Context, request oapi.PostTaskUUIDCommentEntityUUIDTaskRequestObject) (oapi.PostTaskUUIDCommentEntityUUIDTaskResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskCreate(task, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(lo.FromPtr(request.Body.Name))
+
+	child, err := a.app.TaskService.ConvertCommentToTask(ctx, domain.NewCreatorFromUser(&claims), task.UUID, request.EntityUUID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDCommentEntityUUIDTask200JSONResponse{
+		Uuid: child.UUID,
+		Id:   child.ID,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+)
+
+func (a *Web) PostTaskUUIDCommentPoll(ctx context.Context, request oapi.PostTaskUUIDCommentPollRequestObject) (oapi.PostTaskUUIDCommentPollResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	question := strings.TrimSpace(request.Body.Question)
+	if l := len([]rune(question)); l < 2 || l > 500 {
+		return nil, errors.New("вопрос должен быть от 2 до 500 символов")
+	}
+
+	poll, err := domain.NewPoll(request.Body.Options, helpers.Deref(request.Body.Multiple, false), request.Body.ClosesAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	dm := domain.NewComment(claims.Email, request.UUID, uuid.Nil, helpers.Deref(request.Body.People, []string{}), question)
+	dm.Poll = poll
+
+	err = a.app.TaskService.CreateComment(ctx, request.UUID, *dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDCommentPoll200JSONResponse{
+		Uuid:    dm.UUID,
+		Comment: dm.Comment,
+		Poll:    *dto.NewPollDTO(*poll, a.app.DictionaryService, a.app.ProfileService),
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDCommentEntityUUIDPollVote(ctx context.Context, request oapi.PostTaskUUIDCommentEntityUUIDPollVoteRequestObject) (oapi.PostTaskUUIDCommentEntityUUIDPollVoteResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	cm, err := a.app.TaskService.VotePoll(ctx, request.UUID, request.EntityUUID, claims.Email, request.Body.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDCommentEntityUUIDPollVote200JSONResponse(*dto.NewPollDTO(*cm.Poll, a.app.DictionaryService, a.app.ProfileService)), nil
+}
+
+func (a *Web) PatchTaskUUIDCommentEntityUUIDReaction(ctx context.Context, request oapi.PatchTaskUUIDCommentEntityUUIDReactionRequestObject) (oapi.PatchTaskUUIDCommentEntityUUIDReactionResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	reactions, added, err := a.app.TaskService.ReactComment(ctx, request.UUID, request.EntityUUID, claims.Email, request.Body.Emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDCommentEntityUUIDReaction200JSONResponse{
+		Added:     added,
+		Reactions: dto.NewReactionDTOs(reactions, a.app.ProfileService),
+	}, nil
+}
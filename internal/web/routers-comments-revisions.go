@@ -0,0 +1,84 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetTaskUUIDCommentEntityUUIDRevisions(ctx context.Context, request oapi.GetTaskUUIDCommentEntityUUIDRevisionsRequestObject) (oapi.GetTaskUUIDCommentEntityUUIDRevisionsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := a.app.CommentService.GetCommentWithDeleted(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cm.TaskUUID != task.UUID {
+		return nil, dto.NotFoundErr("комментарий не найден")
+	}
+
+	if cm.DeletedAt != nil {
+		err = a.app.GateService.CommentAudit(task.FederationUUID, claims.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	revisions, err := a.app.CommentService.GetRevisions(cm.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(revisions, func(item domain.CommentRevision, _ int) dto.CommentRevisionDTO {
+		return dto.NewCommentRevisionDTO(item, a.app.DictionaryService)
+	})
+
+	return oapi.GetTaskUUIDCommentEntityUUIDRevisions200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
+
+func (a *Web) GetTaskUUIDCommentDeleted(ctx context.Context, request oapi.GetTaskUUIDCommentDeletedRequestObject) (oapi.GetTaskUUIDCommentDeletedResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.CommentAudit(task.FederationUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := a.app.CommentService.GetDeletedComments(task.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(comments, func(item domain.Comment, _ int) dto.CommentDTO {
+		return dto.NewCommentDTO(item, a.app.DictionaryService, a.app.ProfileService)
+	})
+
+	return oapi.GetTaskUUIDCommentDeleted200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
@@ -46,6 +46,9 @@ func (a *Web) PostPermissions(ctx context.Context, request oapi.PostPermissionsR
 			TaskCreate: request.Body.Rules.TaskCreate,
 			TaskDelete: request.Body.Rules.TaskDelete,
 			TaskPatch:  request.Body.Rules.TaskPatch,
+
+			CommentAudit:  request.Body.Rules.CommentAudit,
+			WebhookManage: request.Body.Rules.WebhookManage,
 		},
 	}
 
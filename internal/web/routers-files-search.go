@@ -0,0 +1,39 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetFederationUUIDFileSearch(ctx context.Context, request oapi.GetFederationUUIDFileSearchRequestObject) (oapi.GetFederationUUIDFileSearchResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.FilesSearch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := lo.Clamp(helpers.Deref(request.Params.Limit, 20), 1, 100)
+	offset := lo.Max([]int{helpers.Deref(request.Params.Offset, 0), 0})
+
+	hits, total, err := a.app.S3PrivateService.SearchFiles(request.UUID, request.Params.Q, claims.Email, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDFileSearch200JSONResponse{
+		Count: int(total),
+		Items: lo.Map(hits, func(item domain.FileSearchHit, _ int) dto.FileSearchDTO {
+			return dto.NewFileSearchDTO(item)
+		}),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+)
+
+func (a *Web) GetFederationUUIDStorage(ctx context.Context, request oapi.GetFederationUUIDStorageRequestObject) (oapi.GetFederationUUIDStorageResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.StorageUsage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := a.app.S3PrivateService.GetUsage(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDStorage200JSONResponse(dto.NewStorageUsageDTO(usage, a.app.DictionaryService)), nil
+}
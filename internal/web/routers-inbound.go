@@ -0,0 +1,163 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/inbound"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	echo "github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+const maxInboundBody = 1 << 20
+
+func (a *Web) GetProjectUUIDInbound(ctx context.Context, request oapi.GetProjectUUIDInboundRequestObject) (oapi.GetProjectUUIDInboundResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.InboundManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.InboundService.GetByProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDInbound200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.InboundWebhook, _ int) dto.InboundWebhookDTO {
+			return dto.NewInboundWebhookDTO(item, a.app.Options.URL_BACKEND)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProjectUUIDInbound(ctx context.Context, request oapi.PostProjectUUIDInboundRequestObject) (oapi.PostProjectUUIDInboundResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.InboundManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, dto.NotFoundErr("проект не найден")
+	}
+
+	dm := domain.NewInboundWebhook(project.FederationUUID, project.CompanyUUID, project.UUID, domain.Me{
+		Email: claims.Email,
+		UUID:  claims.UUID,
+	}, request.Body.Name, request.Body.Mapping.ToDomain())
+
+	err = a.app.InboundService.Create(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDInbound200JSONResponse(dto.NewInboundWebhookDTO(*dm, a.app.Options.URL_BACKEND)), nil
+}
+
+func (a *Web) PatchProjectUUIDInboundEntityUUID(ctx context.Context, request oapi.PatchProjectUUIDInboundEntityUUIDRequestObject) (oapi.PatchProjectUUIDInboundEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.InboundManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapping *domain.InboundMapping
+	if request.Body.Mapping != nil {
+		mapping = lo.ToPtr(request.Body.Mapping.ToDomain())
+	}
+
+	dm, err := a.app.InboundService.Update(ctx, request.UUID, request.EntityUUID, request.Body.Name, mapping, request.Body.IsActive, lo.FromPtr(request.Body.RotateToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchProjectUUIDInboundEntityUUID200JSONResponse(dto.NewInboundWebhookDTO(dm, a.app.Options.URL_BACKEND)), nil
+}
+
+func (a *Web) DeleteProjectUUIDInboundEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDInboundEntityUUIDRequestObject) (oapi.DeleteProjectUUIDInboundEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.InboundManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.InboundService.Delete(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProjectUUIDInboundEntityUUID200Response{}, nil
+}
+
+// PostInbound accepts an external payload authorized by the webhook token
+// instead of a jwt, so it is registered outside the openapi routers.
+func (a *Web) PostInbound(c echo.Context) error {
+	uid, err := uuid.Parse(c.Param("uuid"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid UUID format")
+	}
+
+	// the token is not accepted in the query, it would be written to the
+	// access logs
+	token := c.Request().Header.Get("X-Webhook-Token")
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxInboundBody))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	taskUUID, created, err := a.app.InboundService.Receive(c.Request().Context(), uid, token, body)
+	if errors.Is(err, inbound.ErrInvalidToken) {
+		return c.JSON(http.StatusUnauthorized, RequestError{
+			StatusCode: http.StatusUnauthorized,
+			Message:    err.Error(),
+		})
+	}
+
+	var notFoundErr dto.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return err
+	}
+
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, RequestError{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	return c.JSON(status, map[string]interface{}{
+		"task_uuid": taskUUID,
+		"created":   created,
+	})
+}
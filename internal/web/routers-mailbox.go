@@ -0,0 +1,102 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDMailbox(ctx context.Context, request oapi.GetProjectUUIDMailboxRequestObject) (oapi.GetProjectUUIDMailboxResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.MailboxManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, err := a.app.MailboxService.GetByProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDMailbox200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Mailbox, _ int) dto.MailboxDTO {
+			return dto.NewMailboxDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProjectUUIDMailbox(ctx context.Context, request oapi.PostProjectUUIDMailboxRequestObject) (oapi.PostProjectUUIDMailboxResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.MailboxManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, found := a.app.DictionaryService.FindProject(request.UUID)
+	if !found {
+		return nil, dto.NotFoundErr("проект не найден")
+	}
+
+	dm := domain.NewMailbox(project.FederationUUID, project.CompanyUUID, project.UUID, domain.Me{
+		Email: claims.Email,
+		UUID:  claims.UUID,
+	}, request.Body.Email, request.Body.Password, request.Body.Domain)
+
+	err = a.app.MailboxService.Create(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDMailbox200JSONResponse(dto.NewMailboxDTO(*dm)), nil
+}
+
+func (a *Web) PatchProjectUUIDMailboxEntityUUID(ctx context.Context, request oapi.PatchProjectUUIDMailboxEntityUUIDRequestObject) (oapi.PatchProjectUUIDMailboxEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.MailboxManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.MailboxService.Update(ctx, request.UUID, request.EntityUUID, request.Body.Email, request.Body.Password, request.Body.Domain, request.Body.IsActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchProjectUUIDMailboxEntityUUID200JSONResponse(dto.NewMailboxDTO(dm)), nil
+}
+
+func (a *Web) DeleteProjectUUIDMailboxEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDMailboxEntityUUIDRequestObject) (oapi.DeleteProjectUUIDMailboxEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.MailboxManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.MailboxService.Delete(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProjectUUIDMailboxEntityUUID200Response{}, nil
+}
@@ -0,0 +1,23 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/oprofile"
+)
+
+func (a *Web) GetProfileMyDay(ctx context.Context, _ oapi.GetProfileMyDayRequestObject) (oapi.GetProfileMyDayResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.DashboardService.GetMyDay(ctx, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileMyDay200JSONResponse(dto.NewMyDayDTO(dm)), nil
+}
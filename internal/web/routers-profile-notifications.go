@@ -43,13 +43,13 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 			reminderUUIDSs = append(reminderUUIDSs, uid)
 		}
 
-		if item.Type == "task" {
+		if item.Type == "task" || item.Type == "approval" || item.Type == "file_infected" {
 			taskUUIDSs = append(taskUUIDSs, uid)
 		}
 	}
 
 	// Get Tasks
-	taskWithName, err := a.app.TaskService.GetTasksNames(ctx, taskUUIDSs)
+	taskWithName, err := a.app.TaskService.GetTasksNames(ctx, taskUUIDSs, claims.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +82,11 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 		}
 
 		if item.Type == "task" {
-			typeName := taskWithNameMap[item.UUID]
+			// deleted or hidden from the user
+			typeName, ok := taskWithNameMap[item.UUID]
+			if !ok {
+				continue
+			}
 
 			state, star, err := a.app.NotificationsService.GetTaskState(claims.Email, taskUUID)
 			if err != nil {
@@ -94,6 +98,8 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 			count["upload"] = len(state.NewUploads)
 			count["mensions"] = state.NewMentions
 			count["comment_like"] = state.NewLikes
+			count["comment_reaction"] = state.NewReactions
+			count["poll_vote"] = state.NewVotes
 			count["reminders"] = len(state.NewReminders)
 
 			group := 0
@@ -118,6 +124,36 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 				Uploads:   state.NewUploads,
 			})
 		}
+
+		if item.Type == "approval" {
+			typeName, ok := taskWithNameMap[item.UUID]
+			if !ok {
+				continue
+			}
+
+			items = append(items, dto.NotificationApprovalDTO{
+				UUID:  item.UUID,
+				Type:  item.Type,
+				Name:  typeName,
+				Score: item.Score,
+				Star:  item.Star,
+			})
+		}
+
+		if item.Type == "file_infected" {
+			typeName, ok := taskWithNameMap[item.UUID]
+			if !ok {
+				continue
+			}
+
+			items = append(items, dto.NotificationFileInfectedDTO{
+				UUID:  item.UUID,
+				Type:  item.Type,
+				Name:  typeName,
+				Score: item.Score,
+				Star:  item.Star,
+			})
+		}
 	}
 
 	return oapi.GetProfileNotifications200JSONResponse{
@@ -655,7 +655,8 @@ func (a *Web) PatchProfilePreferences(ctx context.Context, request oapi.PatchPro
 	}
 
 	err := a.app.ProfileService.ChangePreferences(claims.UUID, domain.ProfilePreferences{
-		Timezone: request.Body.Timezone,
+		Timezone:       request.Body.Timezone,
+		WeeklyCapacity: request.Body.WeeklyCapacity,
 	})
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -722,3 +723,88 @@ func (a *Web) PostProjectUUIDStatus(ctx context.Context, request oapi.PostProjec
 		Uuid: *dm.UUID,
 	}, nil
 }
+
+func (a *Web) GetProjectUUIDTimeline(ctx context.Context, request oapi.GetProjectUUIDTimelineRequestObject) (oapi.GetProjectUUIDTimelineResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	items, err := a.app.TaskService.GetTimeline(ctx, request.UUID, request.Params.Root, &claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDTimeline200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(item domain.TimelineItem, _ int) dto.TimelineItemDTO {
+			return dto.NewTimelineItemDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) GetProjectUUIDAnalytics(ctx context.Context, request oapi.GetProjectUUIDAnalyticsRequestObject) (oapi.GetProjectUUIDAnalyticsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.ProjectStatistic(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	to := helpers.Deref(request.Params.To, time.Now())
+	from := helpers.Deref(request.Params.From, to.AddDate(0, 0, -90))
+
+	dm, err := a.app.TaskService.GetAnalytics(ctx, domain.AnalyticsFilter{
+		ProjectUUID: request.UUID,
+		Tag:         request.Params.Tag,
+		Priority:    request.Params.Priority,
+		ImplementBy: request.Params.ImplementBy,
+		From:        from,
+		To:          to,
+	}, &claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDAnalytics200JSONResponse(dto.NewAnalyticsDTO(dm)), nil
+}
+
+func (a *Web) GetProjectUUIDStatisticHistory(ctx context.Context, request oapi.GetProjectUUIDStatisticHistoryRequestObject) (oapi.GetProjectUUIDStatisticHistoryResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.ProjectStatistic(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the snapshots count the hidden tasks too
+	all, err := a.app.TaskService.SeesAllTasks(ctx, request.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !all {
+		return nil, errors.New("история статистики доступна только тем, кто видит все задачи проекта")
+	}
+
+	to := helpers.Deref(request.Params.To, time.Now())
+	from := helpers.Deref(request.Params.From, to.AddDate(0, 0, -90))
+
+	dms, err := a.app.StatisticsService.GetSnapshots(ctx, request.UUID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDStatisticHistory200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.ProjectStatisticSnapshot, _ int) dto.ProjectStatisticSnapshotDTO {
+			return dto.NewProjectStatisticSnapshotDTO(item)
+		}),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/krisch/crm-backend/internal/s3"
+	echo "github.com/labstack/echo/v4"
+)
+
+// GetStorageObject serves the objects of the local and memory storages. The
+// links are signed by the storage, so it is registered outside the openapi
+// routers.
+func (a *Web) GetStorageObject(c echo.Context) error {
+	bucket := c.Param("bucket")
+
+	objectName, err := url.PathUnescape(c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid object name")
+	}
+
+	var storage s3.ServedStorage
+	for _, st := range []s3.Storage{a.app.S3Service.Storage(), a.app.S3PrivateService.Storage()} {
+		served, ok := st.(s3.ServedStorage)
+		if ok && st.Bucket() == bucket {
+			storage = served
+			break
+		}
+	}
+
+	if storage == nil {
+		return echo.NewHTTPError(http.StatusNotFound, s3.ErrObjectNotFound.Error())
+	}
+
+	err = storage.Verify(objectName, c.QueryParams(), time.Now())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+
+	obj, err := storage.Get(c.Request().Context(), objectName)
+	if errors.Is(err, s3.ErrObjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	if fileName := c.QueryParam("filename"); fileName != "" {
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("filename=%q", fileName))
+	}
+
+	return c.Stream(http.StatusOK, s3.ObjectContentType(objectName), obj)
+}
@@ -0,0 +1,60 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskUUIDUploadSha256(ctx context.Context, request oapi.PostTaskUUIDUploadSha256RequestObject) (oapi.PostTaskUUIDUploadSha256ResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the content of the tasks hidden from the user is unknown for them
+	taskUUIDs, err := a.app.S3PrivateService.GetHashTasks(task.FederationUUID, request.Body.Sha256)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := a.app.TaskService.FilterVisible(ctx, taskUUIDs, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	fileDTO, err := a.app.S3PrivateService.UploadTaskFileByHash(task.FederationUUID, task.UUID, request.Body.Sha256, visible, lo.FromPtr(request.Body.Name), claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.app.S3PrivateService.FileURL(fileDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != claims.Email
+	})
+
+	err = a.app.TaskService.TaskWasUpdatedOrCreated(request.UUID, notify)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+	upload.ScanStatus = fileDTO.ScanStatus
+	upload.SHA256 = fileDTO.SHA256
+
+	return oapi.PostTaskUUIDUploadSha256200JSONResponse(upload), nil
+}
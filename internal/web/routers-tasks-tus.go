@@ -0,0 +1,282 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	echo "github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+// Resumable uploads of task and comment files by the tus protocol, see
+// https://tus.io/protocols/resumable-upload. The protocol works with headers
+// and raw bodies, so the routes are registered outside the openapi routers.
+const (
+	tusVersion     = "1.0.0"
+	tusExtensions  = "creation,termination,expiration"
+	tusContentType = "application/offset+octet-stream"
+
+	headerTusResumable   = "Tus-Resumable"
+	headerTusVersion     = "Tus-Version"
+	headerTusExtension   = "Tus-Extension"
+	headerTusMaxSize     = "Tus-Max-Size"
+	headerUploadOffset   = "Upload-Offset"
+	headerUploadLength   = "Upload-Length"
+	headerUploadMetadata = "Upload-Metadata"
+	headerUploadExpires  = "Upload-Expires"
+	headerUploadFileUUID = "X-Upload-File-UUID"
+)
+
+var errTusVersion = errors.New("неподдерживаемая версия протокола загрузки")
+
+func (a *Web) OptionsTaskUploadTus(c echo.Context) error {
+	h := c.Response().Header()
+	h.Set(headerTusResumable, tusVersion)
+	h.Set(headerTusVersion, tusVersion)
+	h.Set(headerTusExtension, tusExtensions)
+	h.Set(headerTusMaxSize, strconv.FormatInt(a.app.UploadsService.MaxSize(), 10))
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PostTaskUploadTus creates the upload. The file name is the "filename"
+// metadata, "comment_uuid" attaches the file to the own comment of the task.
+func (a *Web) PostTaskUploadTus(c echo.Context) error {
+	ctx, claims, taskUUID, err := a.tusAuth(c)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	length, err := strconv.ParseInt(c.Request().Header.Get(headerUploadLength), 10, 64)
+	if err != nil {
+		return tusError(c, fmt.Errorf("неверный заголовок %s: %w", headerUploadLength, err))
+	}
+
+	meta, err := domain.ParseUploadMetadata(c.Request().Header.Get(headerUploadMetadata))
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	var commentUUID *uuid.UUID
+	if meta["comment_uuid"] != "" {
+		uid, err := uuid.Parse(meta["comment_uuid"])
+		if err != nil {
+			return tusError(c, fmt.Errorf("неверный комментарий: %w", err))
+		}
+
+		cm, err := a.app.CommentService.GetComment(ctx, uid)
+		if err != nil {
+			return tusError(c, err)
+		}
+
+		if cm.TaskUUID != task.UUID || cm.CreatedBy != claims.Email {
+			return tusError(c, dto.NotFoundErr("комментарий не найден"))
+		}
+
+		commentUUID = &uid
+	}
+
+	dm, err := a.app.UploadsService.Create(ctx, task, commentUUID, claims.UUID, meta["filename"], length)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	h := c.Response().Header()
+	h.Set(headerTusResumable, tusVersion)
+	h.Set(echo.HeaderLocation, strings.TrimSuffix(c.Request().URL.Path, "/")+"/"+dm.UUID.String())
+	h.Set(headerUploadOffset, "0")
+	h.Set(headerUploadExpires, dm.ExpiresAt.UTC().Format(http.TimeFormat))
+
+	return c.NoContent(http.StatusCreated)
+}
+
+func (a *Web) HeadTaskUploadTus(c echo.Context) error {
+	ctx, claims, taskUUID, err := a.tusAuth(c)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return tusError(c, dto.NotFoundErr("загрузка не найдена"))
+	}
+
+	dm, err := a.app.UploadsService.Get(ctx, taskUUID, uid, claims.UUID)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	if dm.Expired(time.Now()) {
+		return tusError(c, domain.ErrUploadExpired)
+	}
+
+	tusUploadHeaders(c, dm)
+	c.Response().Header().Set(headerUploadLength, strconv.FormatInt(dm.Length, 10))
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PatchTaskUploadTus appends the chunk, the last one stores the file and
+// returns its uuid in X-Upload-File-UUID.
+func (a *Web) PatchTaskUploadTus(c echo.Context) error {
+	ctx, claims, taskUUID, err := a.tusAuth(c)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return tusError(c, dto.NotFoundErr("загрузка не найдена"))
+	}
+
+	if c.Request().Header.Get(echo.HeaderContentType) != tusContentType {
+		return c.JSON(http.StatusUnsupportedMediaType, RequestError{
+			StatusCode: http.StatusUnsupportedMediaType,
+			Message:    "тип содержимого должен быть " + tusContentType,
+		})
+	}
+
+	offset, err := strconv.ParseInt(c.Request().Header.Get(headerUploadOffset), 10, 64)
+	if err != nil {
+		return tusError(c, fmt.Errorf("неверный заголовок %s: %w", headerUploadOffset, err))
+	}
+
+	dm, err := a.app.UploadsService.Write(ctx, taskUUID, uid, claims.UUID, offset, c.Request().ContentLength, c.Request().Body)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	tusUploadHeaders(c, dm)
+
+	if dm.FileUUID != nil {
+		c.Response().Header().Set(headerUploadFileUUID, dm.FileUUID.String())
+
+		err = a.tusUploaded(ctx, taskUUID, claims)
+		if err != nil {
+			return tusError(c, err)
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (a *Web) DeleteTaskUploadTus(c echo.Context) error {
+	ctx, claims, taskUUID, err := a.tusAuth(c)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return tusError(c, dto.NotFoundErr("загрузка не найдена"))
+	}
+
+	err = a.app.UploadsService.Terminate(ctx, taskUUID, uid, claims.UUID)
+	if err != nil {
+		return tusError(c, err)
+	}
+
+	c.Response().Header().Set(headerTusResumable, tusVersion)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// tusAuth checks the protocol version, the user and the task visibility.
+func (a *Web) tusAuth(c echo.Context) (context.Context, jwt.Claims, uuid.UUID, error) {
+	var claims jwt.Claims
+
+	if c.Request().Header.Get(headerTusResumable) != tusVersion {
+		return nil, claims, uuid.Nil, errTusVersion
+	}
+
+	taskUUID, err := uuid.Parse(c.Param("UUID"))
+	if err != nil {
+		return nil, claims, uuid.Nil, dto.NotFoundErr("задача не найдена")
+	}
+
+	authCtx, err := checkAuth(c, "tus", a.app.JWT)
+	if err != nil {
+		return nil, claims, uuid.Nil, errors.Join(ErrUnauthorized, err)
+	}
+
+	ctx := authCtx.Request().Context()
+
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, claims, uuid.Nil, ErrInvalidAuthHeader
+	}
+
+	err = a.app.TaskService.CheckVisible(ctx, taskUUID, claims.Email)
+	if err != nil {
+		return nil, claims, uuid.Nil, err
+	}
+
+	return ctx, claims, taskUUID, nil
+}
+
+// tusUploaded notifies the task people as the multipart upload does.
+func (a *Web) tusUploaded(ctx context.Context, taskUUID uuid.UUID, claims jwt.Claims) error {
+	a.app.TaskService.ResetCache(taskUUID)
+
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != claims.Email
+	})
+
+	return a.app.TaskService.TaskWasUpdatedOrCreated(taskUUID, notify)
+}
+
+func tusUploadHeaders(c echo.Context, dm domain.UploadSession) {
+	h := c.Response().Header()
+	h.Set(headerTusResumable, tusVersion)
+	h.Set(headerUploadOffset, strconv.FormatInt(dm.Offset, 10))
+	h.Set(headerUploadExpires, dm.ExpiresAt.UTC().Format(http.TimeFormat))
+}
+
+func tusError(c echo.Context, err error) error {
+	status := http.StatusBadRequest
+
+	var notFoundErr dto.NotFoundError
+
+	switch {
+	case errors.As(err, &notFoundErr):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrUnauthorized), errors.Is(err, ErrInvalidAuthHeader):
+		status = http.StatusUnauthorized
+	case errors.Is(err, errTusVersion):
+		status = http.StatusPreconditionFailed
+		c.Response().Header().Set(headerTusVersion, tusVersion)
+	case errors.Is(err, domain.ErrUploadOffsetMismatch), errors.Is(err, domain.ErrUploadFinished), errors.Is(err, domain.ErrUploadLocked):
+		status = http.StatusConflict
+	case errors.Is(err, domain.ErrUploadExpired):
+		status = http.StatusGone
+	case errors.Is(err, domain.ErrUploadTooLarge), errors.Is(err, domain.ErrStorageQuotaExceeded):
+		status = http.StatusRequestEntityTooLarge
+	}
+
+	c.Response().Header().Set(headerTusResumable, tusVersion)
+
+	return c.JSON(status, RequestError{
+		StatusCode: status,
+		Message:    err.Error(),
+	})
+}
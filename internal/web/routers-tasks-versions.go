@@ -0,0 +1,123 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskUUIDUploadEntityUUIDVersion(ctx context.Context, request oapi.PostTaskUUIDUploadEntityUUIDVersionRequestObject) (oapi.PostTaskUUIDUploadEntityUUIDVersionResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	file, err := request.Body.NextPart()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("file is required: %w", err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	storeFilePath := "/tmp/" + helpers.FakeString(10) + "-" + file.FileName()
+	dst, err := os.Create(storeFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(storeFilePath)
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, file); err != nil {
+		return nil, err
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	fileDTO, err := a.app.S3PrivateService.UploadTaskFileVersion(task.FederationUUID, task.UUID, request.EntityUUID, file.FileName(), storeFilePath, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.app.S3PrivateService.FileURL(fileDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != claims.Email
+	})
+
+	err = a.app.TaskService.TaskWasUpdatedOrCreated(request.UUID, notify)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+	upload.ScanStatus = fileDTO.ScanStatus
+	upload.SHA256 = fileDTO.SHA256
+
+	return oapi.PostTaskUUIDUploadEntityUUIDVersion200JSONResponse(upload), nil
+}
+
+func (a *Web) GetTaskUUIDUploadEntityUUIDVersions(ctx context.Context, request oapi.GetTaskUUIDUploadEntityUUIDVersionsRequestObject) (oapi.GetTaskUUIDUploadEntityUUIDVersionsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	versions, err := a.app.S3PrivateService.GetTaskFileVersions(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(versions, func(item domain.File, _ int) dto.FileVersionDTO {
+		return dto.NewFileVersionDTO(item, a.app.DictionaryService)
+	})
+
+	return oapi.GetTaskUUIDUploadEntityUUIDVersions200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDUploadEntityUUIDRestore(ctx context.Context, request oapi.PostTaskUUIDUploadEntityUUIDRestoreRequestObject) (oapi.PostTaskUUIDUploadEntityUUIDRestoreResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	fileDTO, err := a.app.S3PrivateService.RestoreTaskFileVersion(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := a.app.S3PrivateService.FileURL(fileDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	upload := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+	upload.ScanStatus = fileDTO.ScanStatus
+	upload.SHA256 = fileDTO.SHA256
+
+	return oapi.PostTaskUUIDUploadEntityUUIDRestore200JSONResponse(upload), nil
+}
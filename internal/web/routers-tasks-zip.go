@@ -0,0 +1,104 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime/debug"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/s3"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+// GetTaskUUIDUploadZip streams the files of the task as the zip. The task is
+// checked by the visibility middleware, the subtasks are filtered here.
+func (a *Web) GetTaskUUIDUploadZip(ctx context.Context, request oapi.GetTaskUUIDUploadZipRequestObject) (oapi.GetTaskUUIDUploadZipResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	withComments := helpers.Deref(request.Params.Comments, false)
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := a.taskArchiveFiles(task, "", withComments)
+	if err != nil {
+		return nil, err
+	}
+
+	if helpers.Deref(request.Params.Subtasks, false) {
+		visible, err := a.app.TaskService.FilterVisible(ctx, task.ChildrensUUID, claims.Email)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, uid := range visible {
+			child, err := a.app.TaskService.GetTask(ctx, uid, []string{})
+			if err != nil {
+				return nil, err
+			}
+
+			childItems, err := a.taskArchiveFiles(child, fmt.Sprintf("%d %s", child.ID, child.Name), withComments)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, childItems...)
+		}
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				pw.CloseWithError(fmt.Errorf("zip panic: %v: %s", r, debug.Stack()))
+			}
+		}()
+
+		pw.CloseWithError(a.app.S3PrivateService.WriteArchive(ctx, pw, items))
+	}()
+
+	return oapi.GetTaskUUIDUploadZip200ApplicationzipResponse{
+		Body: pr,
+
+		Headers: oapi.GetTaskUUIDUploadZip200ResponseHeaders{
+			CacheControl:       "no-cache",
+			ContentDisposition: fmt.Sprintf("attachment; filename=\"%d %s.zip\";", task.ID, helpers.Scientific(task.Name)),
+		},
+	}, nil
+}
+
+// taskArchiveFiles puts the task files into the folder and the comment files
+// into its comments subfolder.
+func (a *Web) taskArchiveFiles(task domain.Task, folder string, withComments bool) ([]s3.ArchiveFile, error) {
+	files, err := a.app.S3PrivateService.GetTaskFiles(task.UUID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(files, func(item domain.File, _ int) s3.ArchiveFile {
+		return s3.ArchiveFile{UUID: item.UUID, Folder: folder}
+	})
+
+	if !withComments {
+		return items, nil
+	}
+
+	commentFiles, err := a.app.CommentService.GetTaskCommentsFiles(task.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(items, lo.Map(commentFiles, func(item domain.File, _ int) s3.ArchiveFile {
+		return s3.ArchiveFile{UUID: item.UUID, Folder: folder + "/comments"}
+	})...), nil
+}
@@ -28,6 +28,7 @@ func initOpenAPITaskRouters(a *Web, e *echo.Echo) {
 
 	midlewares := []oapi.StrictMiddlewareFunc{
 		ValidateStructMiddeware,
+		TaskVisibilityMiddeware(a.app),
 		AuthMiddeware(a.app, []string{}),
 	}
 
@@ -109,12 +110,16 @@ func (a *Web) PatchTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.P
 
 			os.Remove(storeFilePath)
 
-			url, err := a.app.S3PrivateService.PresignedURL(fileDTO.Name, fileDTO.ObjectName)
+			url, err := a.app.S3PrivateService.FileURL(fileDTO)
 			if err != nil {
 				return nil, err
 			}
 
-			*uploadsDTO = append(*uploadsDTO, dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url))
+			upload := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+			upload.ScanStatus = fileDTO.ScanStatus
+			upload.SHA256 = fileDTO.SHA256
+
+			*uploadsDTO = append(*uploadsDTO, upload)
 		}
 	}
 
@@ -181,6 +186,11 @@ func (a *Web) PostTask(ctx context.Context, request oapi.PostTaskRequestObject)
 		return nil, err
 	}
 
+	err = task.PatchSchedule(request.Body.StartAt, nil, request.Body.Duration)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := a.app.TaskService.CreateTask(task)
 	if err != nil {
 		return nil, err
@@ -249,6 +259,21 @@ func (a *Web) PutTaskUUID(ctx context.Context, request oapi.PutTaskUUIDRequestOb
 		shouldUpdate = append(shouldUpdate, "icon")
 	}
 
+	if request.Body.StartAt != nil || request.Body.Duration != nil {
+		err = task.PatchSchedule(request.Body.StartAt, nil, request.Body.Duration)
+		if err != nil {
+			return nil, err
+		}
+
+		if request.Body.StartAt != nil {
+			shouldUpdate = append(shouldUpdate, "start_at")
+		}
+
+		if request.Body.Duration != nil {
+			shouldUpdate = append(shouldUpdate, "duration")
+		}
+	}
+
 	err = a.app.TaskService.UpdateTask(domain.NewCreatorFromUser(&claims), task, shouldUpdate)
 	if err != nil {
 		return nil, err
@@ -280,6 +305,11 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 	}
 
 	if err == nil && dtoFromCache.UUID != uuid.Nil {
+		err = a.hideRelatedTasks(ctx, &dtoFromCache, claims.Email)
+		if err != nil {
+			return nil, err
+		}
+
 		firstOpenDTO := a.patchFirstOpen(&dtoFromCache, claims.UUID)
 
 		dtoFromCache.IsLiked = &isLiked
@@ -342,7 +372,15 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 
 	taskDto := dto.NewTaskDTO(dm, comments, files, reminders, linkedFieldsData, a.app.DictionaryService, a.app.ProfileService)
 
-	go a.app.CacheService.CacheTask(ctx, &taskDto)
+	// the cached dto is shared by users, so it is filtered after caching
+	cached := taskDto
+	go a.app.CacheService.CacheTask(ctx, &cached)
+
+	err = a.hideRelatedTasks(ctx, &taskDto, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
 	taskDto.IsLiked = &isLiked
 
 	// First Open
@@ -358,6 +396,28 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 	}, nil
 }
 
+// hideRelatedTasks removes children and linked fields of the tasks hidden from
+// the user. It builds new slice and map, the shared ones are not changed.
+func (a *Web) hideRelatedTasks(ctx context.Context, taskDto *dto.TaskDTO, email string) error {
+	uids := append([]uuid.UUID{}, taskDto.ChildrensUUID...)
+	uids = append(uids, lo.Keys(taskDto.LinkedFieldsData)...)
+
+	if len(uids) == 0 {
+		return nil
+	}
+
+	visible, err := a.app.TaskService.FilterVisible(ctx, uids, email)
+	if err != nil {
+		return err
+	}
+
+	taskDto.ChildrensUUID = lo.Intersect(visible, taskDto.ChildrensUUID)
+	taskDto.ChildrensTotal = len(taskDto.ChildrensUUID)
+	taskDto.LinkedFieldsData = lo.PickByKeys(taskDto.LinkedFieldsData, visible)
+
+	return nil
+}
+
 func (a *Web) patchFirstOpen(dtoFromCache *dto.TaskDTO, me uuid.UUID) []dto.OpenByDTO {
 	// Search me in team
 	shouldAddToOpenBy := true
@@ -417,6 +477,11 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 
 		Order: request.Params.Order,
 		By:    request.Params.By,
+
+		Cursor:    request.Params.Cursor,
+		WithTotal: helpers.Deref(request.Params.WithTotal, false),
+
+		ViewerEmail: &claims.Email,
 	}
 
 	err = filter.Validate()
@@ -424,7 +489,7 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 		return nil, err
 	}
 
-	dtos, total, err := a.app.TaskService.GetTasksDto(ctx, filter)
+	dtos, total, next, err := a.app.TaskService.GetTasksDto(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -459,13 +524,15 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 
 	return oapi.GetTask200JSONResponse{
 		Body: struct {
-			Count int            `json:"count"`
-			Items []dto.TaskDTOs `json:"items"`
-			Total int64          `json:"total"`
+			Count      int            `json:"count"`
+			Items      []dto.TaskDTOs `json:"items"`
+			NextCursor *string        `json:"next_cursor,omitempty"`
+			Total      int64          `json:"total"`
 		}{
-			Count: len(dtos),
-			Items: dtos,
-			Total: total,
+			Count:      len(dtos),
+			Items:      dtos,
+			NextCursor: lo.EmptyableToPtr(next),
+			Total:      total,
 		},
 		Headers: oapi.GetTask200ResponseHeaders{
 			CacheControl: "no-cache",
@@ -668,11 +735,18 @@ func parseStruct(dt interface{}, rows []interface{}, level string, names []strin
 }
 
 func (a *Web) PatchTaskUUIDParent(ctx context.Context, request oapi.PatchTaskUUIDParentRequestObject) (oapi.PatchTaskUUIDParentResponseObject, error) {
-	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
 		return nil, ErrInvalidAuthHeader
 	}
 
+	if request.Body.Uuid != nil {
+		err := a.app.TaskService.CheckVisible(ctx, *request.Body.Uuid, claims.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := a.app.TaskService.PatchTaskParent(ctx, request.UUID, request.Body.Uuid)
 	if err != nil {
 		return nil, err
@@ -681,6 +755,95 @@ func (a *Web) PatchTaskUUIDParent(ctx context.Context, request oapi.PatchTaskUUI
 	return oapi.PatchTaskUUIDParent200Response{}, err
 }
 
+func (a *Web) PatchTaskUUIDSchedule(ctx context.Context, request oapi.PatchTaskUUIDScheduleRequestObject) (oapi.PatchTaskUUIDScheduleResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	moved, err := a.app.TaskService.PatchSchedule(ctx, domain.NewCreatorFromUser(&claims), request.UUID,
+		request.Body.StartAt, request.Body.FinishTo, request.Body.Duration, lo.FromPtr(request.Body.AutoSchedule))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDSchedule200JSONResponse{
+		Moved: lo.Ternary(moved == nil, []uuid.UUID{}, moved),
+	}, nil
+}
+
+func (a *Web) PatchTaskUUIDVisibility(ctx context.Context, request oapi.PatchTaskUUIDVisibilityRequestObject) (oapi.PatchTaskUUIDVisibilityResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.GateService.TaskVisibilityPatch(task, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.PatchVisibility(ctx, domain.NewCreatorFromUser(&claims), request.UUID,
+		string(request.Body.Visibility), lo.FromPtr(request.Body.Users), lo.FromPtr(request.Body.Groups))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDVisibility200Response{}, nil
+}
+
+func (a *Web) PostTaskUUIDDependency(ctx context.Context, request oapi.PostTaskUUIDDependencyRequestObject) (oapi.PostTaskUUIDDependencyResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.TaskService.CheckVisible(ctx, request.Body.Uuid, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.TaskService.AddDependency(ctx, domain.NewCreatorFromUser(&claims), request.UUID, request.Body.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDDependency200Response{}, nil
+}
+
+func (a *Web) DeleteTaskUUIDDependencyEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDDependencyEntityUUIDRequestObject) (oapi.DeleteTaskUUIDDependencyEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.TaskService.DeleteDependency(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDDependencyEntityUUID200Response{}, nil
+}
+
+func (a *Web) GetTaskUUIDProgress(ctx context.Context, request oapi.GetTaskUUIDProgressRequestObject) (oapi.GetTaskUUIDProgressResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.TaskService.GetEpicProgress(ctx, request.UUID, claims.Email, lo.FromPtr(request.Params.WeightField))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDProgress200JSONResponse(dto.NewEpicProgressDTO(dm)), nil
+}
+
 func (a *Web) PatchTaskUUIDProject(ctx context.Context, request oapi.PatchTaskUUIDProjectRequestObject) (oapi.PatchTaskUUIDProjectResponseObject, error) {
 	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -834,12 +997,16 @@ func (a *Web) PostTaskUUIDComment(ctx context.Context, request oapi.PostTaskUUID
 
 			os.Remove(storeFilePath)
 
-			url, err := a.app.S3PrivateService.PresignedURL(fileDTO.Name, fileDTO.ObjectName)
+			url, err := a.app.S3PrivateService.FileURL(fileDTO)
 			if err != nil {
 				return nil, err
 			}
 
-			*uploadsDTO = append(*uploadsDTO, dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url))
+			upload := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+			upload.ScanStatus = fileDTO.ScanStatus
+			upload.SHA256 = fileDTO.SHA256
+
+			*uploadsDTO = append(*uploadsDTO, upload)
 		}
 	}
 
@@ -914,7 +1081,7 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.CommentService.DeleteComment(ctx, request.UUID, request.EntityUUID, &claims.UUID)
+	err := a.app.CommentService.DeleteComment(ctx, request.UUID, request.EntityUUID, claims.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -924,7 +1091,19 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 
 // Web struct should implement the missing method from otask.StrictServerInterface.
 func (a *Web) GetTaskUUIDComment(_ context.Context, request oapi.GetTaskUUIDCommentRequestObject) (oapi.GetTaskUUIDCommentResponseObject, error) {
-	dms, err := a.app.CommentService.GetTaskComments(request.UUID, true, true)
+	var dms []domain.Comment
+	var total int64
+	var next string
+	var err error
+
+	if request.Params.Limit == nil && request.Params.Cursor == nil {
+		dms, err = a.app.CommentService.GetTaskComments(request.UUID, true, true)
+		total = int64(len(dms))
+	} else {
+		limit := helpers.Deref(request.Params.Limit, 20)
+		dms, total, next, err = a.app.CommentService.GetTaskCommentsPage(request.UUID, limit, request.Params.Cursor, helpers.Deref(request.Params.WithTotal, false), true, true)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -935,8 +1114,10 @@ func (a *Web) GetTaskUUIDComment(_ context.Context, request oapi.GetTaskUUIDComm
 	}
 
 	return oapi.GetTaskUUIDComment200JSONResponse{
-		Count: len(dtos),
-		Items: dtos,
+		Count:      len(dtos),
+		Items:      dtos,
+		NextCursor: lo.EmptyableToPtr(next),
+		Total:      &total,
 	}, nil
 }
 
@@ -1005,7 +1186,7 @@ func (a *Web) PatchTaskUUIDUpload(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, err
 	}
 
-	url, err := a.app.S3PrivateService.PresignedURL(fileDTO.Name, fileDTO.ObjectName)
+	url, err := a.app.S3PrivateService.FileURL(fileDTO)
 	if err != nil {
 		return nil, err
 	}
@@ -1022,7 +1203,11 @@ func (a *Web) PatchTaskUUIDUpload(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, err
 	}
 
-	return oapi.PatchTaskUUIDUpload200JSONResponse(dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)), nil
+	upload := dto.NewUploadDTO(fileDTO.UUID, fileDTO.Name, fileDTO.Ext, fileDTO.Size, url)
+	upload.ScanStatus = fileDTO.ScanStatus
+	upload.SHA256 = fileDTO.SHA256
+
+	return oapi.PatchTaskUUIDUpload200JSONResponse(upload), nil
 }
 
 func (a *Web) DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDUploadEntityUUIDRequestObject) (oapi.DeleteTaskUUIDUploadEntityUUIDResponseObject, error) {
@@ -1062,7 +1247,7 @@ func (a *Web) GetTaskUUIDUploadEntityUUID(ctx context.Context, request oapi.GetT
 		return nil, ErrInvalidAuthHeader
 	}
 
-	url, err := a.app.S3PrivateService.PresignedURLFromFile(request.EntityUUID)
+	url, err := a.app.S3PrivateService.PresignedURLFromTaskFile(request.UUID, request.EntityUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -1116,9 +1301,9 @@ func (a *Web) GetTaskUUIDActivity(ctx context.Context, request oapi.GetTaskUUIDA
 	}
 
 	offset := helpers.If(request.Params.Offset == nil, 0, *request.Params.Offset)
-	limit := helpers.If(request.Params.Limit == nil, 0, *request.Params.Limit)
+	limit := helpers.If(request.Params.Limit == nil, 20, *request.Params.Limit)
 
-	dms, total, err := a.app.TaskService.GetActivities(request.UUID, limit, offset)
+	dms, total, next, err := a.app.TaskService.GetActivities(request.UUID, limit, offset, request.Params.Cursor, helpers.Deref(request.Params.WithTotal, false))
 	if err != nil {
 		return nil, err
 	}
@@ -1134,7 +1319,8 @@ func (a *Web) GetTaskUUIDActivity(ctx context.Context, request oapi.GetTaskUUIDA
 
 			return *dto.NewActivityDTO(item, *createdBy)
 		}),
-		Total: total,
+		NextCursor: lo.EmptyableToPtr(next),
+		Total:      total,
 	}, nil
 }
 
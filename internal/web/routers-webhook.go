@@ -0,0 +1,166 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetFederationUUIDWebhook(ctx context.Context, request oapi.GetFederationUUIDWebhookRequestObject) (oapi.GetFederationUUIDWebhookResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dms, total, err := a.app.WebhooksService.Get(ctx, domain.WebhookFilter{
+		FederationUUID: request.UUID,
+		Offset:         request.Params.Offset,
+		Limit:          request.Params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDWebhook200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Webhook, _ int) dto.WebhookDTO {
+			return dto.NewWebhookDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDWebhook(ctx context.Context, request oapi.PostFederationUUIDWebhookRequestObject) (oapi.PostFederationUUIDWebhookResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := lo.Map(request.Body.Events, func(item oapi.WebhookCreateRequestEvents, _ int) string {
+		return string(item)
+	})
+
+	dm := domain.NewWebhook(request.UUID, domain.Me{
+		Email: claims.Email,
+		UUID:  claims.UUID,
+	}, request.Body.Url, events)
+
+	err = a.app.WebhooksService.Create(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDWebhook200JSONResponse{
+		Uuid:   dm.UUID,
+		Secret: dm.Secret,
+	}, nil
+}
+
+func (a *Web) PatchFederationUUIDWebhookEntityUUID(ctx context.Context, request oapi.PatchFederationUUIDWebhookEntityUUIDRequestObject) (oapi.PatchFederationUUIDWebhookEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.WebhooksService.Update(ctx, request.UUID, request.EntityUUID, request.Body.Url, request.Body.Events, request.Body.IsActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchFederationUUIDWebhookEntityUUID200JSONResponse(dto.NewWebhookDTO(dm)), nil
+}
+
+func (a *Web) DeleteFederationUUIDWebhookEntityUUID(ctx context.Context, request oapi.DeleteFederationUUIDWebhookEntityUUIDRequestObject) (oapi.DeleteFederationUUIDWebhookEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.WebhooksService.Delete(ctx, request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteFederationUUIDWebhookEntityUUID200Response{}, nil
+}
+
+func (a *Web) GetFederationUUIDWebhookEntityUUIDDelivery(ctx context.Context, request oapi.GetFederationUUIDWebhookEntityUUIDDeliveryRequestObject) (oapi.GetFederationUUIDWebhookEntityUUIDDeliveryResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.WebhookDeliveryFilter{
+		WebhookUUID: request.EntityUUID,
+		Offset:      request.Params.Offset,
+		Limit:       request.Params.Limit,
+	}
+
+	if request.Params.Status != nil {
+		status := string(*request.Params.Status)
+		filter.Status = &status
+	}
+
+	dms, total, err := a.app.WebhooksService.GetDeliveries(ctx, request.UUID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDWebhookEntityUUIDDelivery200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.WebhookDelivery, _ int) dto.WebhookDeliveryDTO {
+			return dto.NewWebhookDeliveryDTO(item)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver(ctx context.Context, request oapi.PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverRequestObject) (oapi.PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliverResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WebhookManage(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := a.app.WebhooksService.Redeliver(ctx, request.UUID, request.EntityUUID, request.DeliveryUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDWebhookEntityUUIDDeliveryDeliveryUUIDRedeliver200JSONResponse{
+		Uuid: dm.UUID,
+	}, nil
+}
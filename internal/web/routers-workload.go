@@ -0,0 +1,55 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetFederationUUIDWorkload(ctx context.Context, request oapi.GetFederationUUIDWorkloadRequestObject) (oapi.GetFederationUUIDWorkloadResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.WorkloadView(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := lo.Map(helpers.Deref(request.Params.Role, []oapi.GetFederationUUIDWorkloadParamsRole{}), func(item oapi.GetFederationUUIDWorkloadParamsRole, _ int) string {
+		return string(item)
+	})
+
+	weeks := lo.Clamp(helpers.Deref(request.Params.Weeks, 8), 1, 52)
+
+	dms, err := a.app.AgregateService.GetWorkload(ctx, request.UUID, request.Params.ProjectUuid, roles, weeks)
+	if err != nil {
+		return nil, err
+	}
+
+	if helpers.Deref(request.Params.OverAllocated, false) {
+		dms = lo.Filter(dms, func(item domain.Workload, _ int) bool {
+			return item.OverAllocated
+		})
+	}
+
+	items := lo.Map(dms, func(item domain.Workload, _ int) dto.WorkloadDTO {
+		user, found := a.app.DictionaryService.FindUser(item.Email)
+		if !found {
+			return dto.NewWorkloadDTO(item, dto.UserDTO{Email: item.Email})
+		}
+
+		return dto.NewWorkloadDTO(item, *user)
+	})
+
+	return oapi.GetFederationUUIDWorkload200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
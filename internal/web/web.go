@@ -436,6 +436,10 @@ func (a *Web) Init() *echo.Echo {
 				return true
 			}
 
+			if strings.Contains(c.Request().RequestURI, "/upload/tus") {
+				return true
+			}
+
 			return false
 		},
 		Limit: "2M",
@@ -448,7 +452,8 @@ func (a *Web) Init() *echo.Echo {
 		e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 			Level: a.app.Options.GZIP,
 			Skipper: func(c echo.Context) bool {
-				return c.Request().RequestURI == "/metrics"
+				// archives are compressed already
+				return c.Request().RequestURI == "/metrics" || strings.HasSuffix(c.Request().URL.Path, "/upload/zip")
 			},
 		}))
 	}
@@ -581,6 +586,13 @@ func (a *Web) Init() *echo.Echo {
 	initOpenAPIcatalogRouters(a, e)
 	olegalentities.RegisterHandlersWithBaseURL(e, olegalentities.NewStrictHandler(a, nil), "/api")
 	e.DELETE("/api/bank-accounts/:uuid", a.DeleteBankAccountsUuidEcho)
+	e.POST("/inbound/:uuid", a.PostInbound)
+	e.GET("/storage/:bucket/*", a.GetStorageObject)
+	e.OPTIONS("/task/:UUID/upload/tus", a.OptionsTaskUploadTus)
+	e.POST("/task/:UUID/upload/tus", a.PostTaskUploadTus)
+	e.HEAD("/task/:UUID/upload/tus/:id", a.HeadTaskUploadTus)
+	e.PATCH("/task/:UUID/upload/tus/:id", a.PatchTaskUploadTus)
+	e.DELETE("/task/:UUID/upload/tus/:id", a.DeleteTaskUploadTus)
 	e.File("/openapi.yaml", "./openapi.yaml", middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
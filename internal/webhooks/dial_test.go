@@ -0,0 +1,61 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for _, host := range []string{"8.8.8.8", "2a00:1450:4010:c05::8b"} {
+		if !isPublicIP(net.ParseIP(host)) {
+			t.Errorf("isPublicIP(%s) = false", host)
+		}
+	}
+
+	for _, host := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1"} {
+		if isPublicIP(net.ParseIP(host)) {
+			t.Errorf("isPublicIP(%s) = true", host)
+		}
+	}
+}
+
+func TestValidateInternalURL(t *testing.T) {
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "http://[::1]/hook", "http://169.254.169.254/latest/meta-data", "https://10.0.0.1/hook", "http://localhost/hook"} {
+		wh := &domain.Webhook{URL: url, Events: []string{string(domain.WebhookEventTaskCreated)}}
+
+		if err := validate(context.Background(), wh); err == nil {
+			t.Errorf("validate(%s) returned no error", url)
+		}
+	}
+
+	wh := &domain.Webhook{URL: "https://8.8.8.8/hook", Events: []string{string(domain.WebhookEventTaskCreated)}}
+	if err := validate(context.Background(), wh); err != nil {
+		t.Errorf("validate(%s) error = %v", wh.URL, err)
+	}
+}
+
+// The host may resolve to another address after the registration, the dial
+// is checked too.
+func TestClientRefusesInternalAddress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	resp, err := newClient(time.Second).Get(srv.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("the client reached the loopback server")
+	}
+
+	if !errors.Is(err, errInternalAddress) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
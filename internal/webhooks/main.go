@@ -0,0 +1,352 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/federation"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const responseBodyLimit = 1000
+
+type Service struct {
+	repo   *Repository
+	fs     *federation.Service
+	client *http.Client
+
+	maxAttempts  int
+	disableAfter int
+	batch        int
+	baseDelay    time.Duration
+	lease        time.Duration
+}
+
+func New(repo *Repository, fs *federation.Service, conf *configs.Configs) *Service {
+	timeout := time.Second * time.Duration(conf.WEBHOOKS_TIMEOUT)
+
+	return &Service{
+		repo:   repo,
+		fs:     fs,
+		client: newClient(timeout),
+
+		maxAttempts:  conf.WEBHOOKS_MAX_ATTEMPTS,
+		disableAfter: conf.WEBHOOKS_DISABLE_AFTER,
+		batch:        conf.WEBHOOKS_BATCH,
+		baseDelay:    time.Second * time.Duration(conf.WEBHOOKS_RETRY_DELAY),
+		lease:        timeout * 2,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, wh *domain.Webhook) error {
+	err := validate(ctx, wh)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Create(wh)
+}
+
+func (s *Service) Get(ctx context.Context, filter domain.WebhookFilter) ([]domain.Webhook, int64, error) {
+	return s.repo.Get(ctx, filter)
+}
+
+func (s *Service) GetByUUID(_ context.Context, federationUUID, uid uuid.UUID) (domain.Webhook, error) {
+	wh, err := s.repo.GetByUUID(uid)
+	if err != nil {
+		return wh, err
+	}
+
+	if wh.FederationUUID != federationUUID {
+		return wh, dto.NotFoundErr("вебхук не найден")
+	}
+
+	return wh, nil
+}
+
+func (s *Service) Update(ctx context.Context, federationUUID, uid uuid.UUID, url *string, events *[]string, isActive *bool) (wh domain.Webhook, err error) {
+	wh, err = s.GetByUUID(ctx, federationUUID, uid)
+	if err != nil {
+		return wh, err
+	}
+
+	if url != nil {
+		wh.URL = *url
+	}
+
+	if events != nil {
+		wh.Events = lo.Uniq(*events)
+	}
+
+	if isActive != nil {
+		// re-enabling gives the subscriber a fresh failures budget
+		if *isActive && !wh.IsActive {
+			wh.Failures = 0
+			wh.DisabledAt = nil
+		}
+
+		wh.IsActive = *isActive
+	}
+
+	err = validate(ctx, &wh)
+	if err != nil {
+		return wh, err
+	}
+
+	return wh, s.repo.Update(&wh)
+}
+
+func (s *Service) Delete(ctx context.Context, federationUUID, uid uuid.UUID) error {
+	_, err := s.GetByUUID(ctx, federationUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(uid)
+}
+
+func (s *Service) GetDeliveries(ctx context.Context, federationUUID uuid.UUID, filter domain.WebhookDeliveryFilter) ([]domain.WebhookDelivery, int64, error) {
+	_, err := s.GetByUUID(ctx, federationUUID, filter.WebhookUUID)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return s.repo.GetDeliveries(ctx, filter)
+}
+
+// Redeliver queues a copy of a logged delivery with the same payload.
+func (s *Service) Redeliver(ctx context.Context, federationUUID, webhookUUID, deliveryUUID uuid.UUID) (*domain.WebhookDelivery, error) {
+	wh, err := s.GetByUUID(ctx, federationUUID, webhookUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !wh.IsActive {
+		return nil, fmt.Errorf("вебхук отключен")
+	}
+
+	old, err := s.repo.GetDelivery(webhookUUID, deliveryUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	d := domain.NewWebhookDelivery(wh, domain.WebhookEvent(old.Event), old.Payload)
+
+	return d, s.repo.CreateDelivery(d)
+}
+
+// Emit queues a delivery of the task event for every active webhook of the
+// federation subscribed to it whose creator can see the task. Sending happens
+// in DeliverPending.
+func (s *Service) Emit(ctx context.Context, task domain.Task, event domain.WebhookEvent, data interface{}) error {
+	whs, err := s.repo.GetActive(task.FederationUUID, event)
+	if err != nil {
+		return err
+	}
+
+	if len(whs) == 0 {
+		return nil
+	}
+
+	for _, wh := range whs {
+		visible, err := s.visible(ctx, task, wh)
+		if err != nil {
+			return err
+		}
+
+		if !visible {
+			continue
+		}
+
+		body, err := json.Marshal(dto.WebhookPayloadDTO{
+			UUID:           uuid.New(),
+			Event:          string(event),
+			FederationUUID: task.FederationUUID,
+			CreatedAt:      time.Now(),
+			Data:           data,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.CreateDelivery(domain.NewWebhookDelivery(wh, event, body))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// visible reports whether the creator of the webhook can see the task, the
+// task is checked as is since the deleted task is sent too.
+func (s *Service) visible(ctx context.Context, task domain.Task, wh domain.Webhook) (bool, error) {
+	var groups []uuid.UUID
+	if task.Visibility == domain.TaskVisibilityACL && len(task.AccessGroups) > 0 {
+		items, err := s.fs.GetUserGroups(ctx, wh.CreatedByUUID)
+		if err != nil {
+			return false, err
+		}
+
+		groups = lo.Map(items, func(item domain.Group, _ int) uuid.UUID {
+			return item.UUID
+		})
+	}
+
+	return task.VisibleTo(wh.CreatedBy, groups), nil
+}
+
+// DeliverPending sends a batch of due deliveries and returns how many were
+// processed.
+func (s *Service) DeliverPending(ctx context.Context) (int, error) {
+	dms, err := s.repo.ClaimDeliveries(s.batch, s.lease)
+	if err != nil {
+		return 0, err
+	}
+
+	whs := map[uuid.UUID]domain.Webhook{}
+
+	for i, d := range dms {
+		// the rest of the claimed deliveries are sent after the lease
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+
+		wh, ok := whs[d.WebhookUUID]
+		if !ok {
+			wh, err = s.repo.GetByUUID(d.WebhookUUID)
+			if err != nil {
+				logrus.WithError(err).WithField("webhook_uuid", d.WebhookUUID).Error("webhook not found")
+				continue
+			}
+			whs[d.WebhookUUID] = wh
+		}
+
+		if !wh.IsActive {
+			continue
+		}
+
+		disabled, err := s.deliver(ctx, wh, d)
+		if err != nil {
+			logrus.WithError(err).WithField("delivery_uuid", d.UUID).Error("webhook delivery error")
+		}
+
+		if disabled {
+			wh.IsActive = false
+			whs[d.WebhookUUID] = wh
+		}
+	}
+
+	return len(dms), nil
+}
+
+func (s *Service) deliver(ctx context.Context, wh domain.Webhook, d domain.WebhookDelivery) (disabled bool, err error) {
+	d.Attempts++
+
+	code, body, sendErr := s.send(ctx, wh, d)
+
+	d.ResponseCode = code
+	d.ResponseBody = body
+	d.Error = ""
+
+	if sendErr == nil {
+		now := time.Now()
+		d.Status = domain.WebhookDeliverySuccess
+		d.DeliveredAt = &now
+		d.NextAttemptAt = nil
+
+		err = s.repo.SaveAttempt(d)
+		if err != nil {
+			return false, err
+		}
+
+		return false, s.repo.ResetFailures(wh.UUID)
+	}
+
+	d.Error = sendErr.Error()
+
+	if d.Attempts >= s.maxAttempts {
+		d.Status = domain.WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+	} else {
+		next := time.Now().Add(Backoff(s.baseDelay, d.Attempts))
+		d.NextAttemptAt = &next
+	}
+
+	err = s.repo.SaveAttempt(d)
+	if err != nil {
+		return false, err
+	}
+
+	disabled, err = s.repo.RegisterFailure(wh.UUID, s.disableAfter)
+	if disabled {
+		logrus.WithField("webhook_uuid", wh.UUID).Warn("webhook disabled after repeated failures")
+	}
+
+	return disabled, err
+}
+
+func (s *Service) send(ctx context.Context, wh domain.Webhook, d domain.WebhookDelivery) (code int, body string, err error) {
+	ts := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, d.Event)
+	req.Header.Set(HeaderDelivery, d.UUID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(wh.Secret, ts, d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, responseBodyLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, string(b), fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, string(b), nil
+}
+
+func validate(ctx context.Context, wh *domain.Webhook) error {
+	u, err := url.ParseRequestURI(wh.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("некорректный url: %s", wh.URL)
+	}
+
+	err = checkHost(ctx, u.Hostname())
+	if err != nil {
+		return err
+	}
+
+	if len(wh.Events) == 0 {
+		return fmt.Errorf("не указаны события")
+	}
+
+	for _, event := range wh.Events {
+		if !domain.IsWebhookEvent(event) {
+			return fmt.Errorf("неизвестное событие: %s", event)
+		}
+	}
+
+	return nil
+}
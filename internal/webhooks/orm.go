@@ -0,0 +1,96 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+type Webhook struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	CreatedBy     string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	URL    string         `gorm:"type:varchar(500);not null;"`
+	Secret string         `gorm:"type:varchar(100);not null;"`
+	Events pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+
+	IsActive   bool       `gorm:"type:bool;default:true;not null;"`
+	Failures   int        `gorm:"type:int;default:0;not null;"`
+	DisabledAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	Total int64 `gorm:"->"`
+}
+
+func (o Webhook) toDomain() domain.Webhook {
+	return domain.Webhook{
+		UUID:           o.UUID,
+		FederationUUID: o.FederationUUID,
+		CreatedBy:      o.CreatedBy,
+		CreatedByUUID:  o.CreatedByUUID,
+		URL:            o.URL,
+		Secret:         o.Secret,
+		Events:         o.Events,
+		IsActive:       o.IsActive,
+		Failures:       o.Failures,
+		DisabledAt:     o.DisabledAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+		DeletedAt:      o.DeletedAt,
+	}
+}
+
+type WebhookDelivery struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	WebhookUUID    uuid.UUID `gorm:"type:uuid;not null;"`
+	FederationUUID uuid.UUID `gorm:"type:uuid;not null;"`
+
+	Event   string         `gorm:"type:varchar(50);not null;"`
+	Payload datatypes.JSON `gorm:"type:jsonb;default:'{}';not null;"`
+
+	Status       string `gorm:"type:varchar(20);default:'pending';not null;"`
+	Attempts     int    `gorm:"type:int;default:0;not null;"`
+	ResponseCode int    `gorm:"type:int;default:0;not null;"`
+	ResponseBody string `gorm:"type:text;default:'';not null;"`
+	Error        string `gorm:"type:text;default:'';not null;"`
+
+	NextAttemptAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	DeliveredAt   *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+
+	Total int64 `gorm:"->"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (o WebhookDelivery) toDomain() domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		UUID:           o.UUID,
+		WebhookUUID:    o.WebhookUUID,
+		FederationUUID: o.FederationUUID,
+		Event:          o.Event,
+		Payload:        []byte(o.Payload),
+		Status:         o.Status,
+		Attempts:       o.Attempts,
+		ResponseCode:   o.ResponseCode,
+		ResponseBody:   o.ResponseBody,
+		Error:          o.Error,
+		NextAttemptAt:  o.NextAttemptAt,
+		DeliveredAt:    o.DeliveredAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+}
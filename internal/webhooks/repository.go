@@ -0,0 +1,285 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm *domain.Webhook) error {
+	return r.gorm.DB.Create(&Webhook{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CreatedBy:      dm.CreatedBy,
+		CreatedByUUID:  dm.CreatedByUUID,
+		URL:            dm.URL,
+		Secret:         dm.Secret,
+		Events:         dm.Events,
+		IsActive:       dm.IsActive,
+	}).Error
+}
+
+func (r *Repository) Get(_ context.Context, filter domain.WebhookFilter) (dms []domain.Webhook, total int64, err error) {
+	if filter.FederationUUID == uuid.Nil {
+		return nil, -1, errors.New("federation uuid is required")
+	}
+
+	orms := []Webhook{}
+
+	query := r.gorm.DB.
+		Order("created_at desc").
+		Where("federation_uuid = ?", filter.FederationUUID).
+		Where("deleted_at is null")
+
+	query = query.Limit(helpers.Deref(filter.Limit, 5))
+	query = query.Offset(helpers.Deref(filter.Offset, 0))
+
+	res := query.Select("*, count(*) OVER() AS total").Find(&orms)
+	if res.Error != nil {
+		return dms, -1, res.Error
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	dms = helpers.Map(orms, func(item Webhook, _ int) domain.Webhook {
+		return item.toDomain()
+	})
+
+	return dms, total, nil
+}
+
+func (r *Repository) GetByUUID(uid uuid.UUID) (dm domain.Webhook, err error) {
+	orm := Webhook{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("вебхук не найден")
+	}
+
+	return orm.toDomain(), nil
+}
+
+func (r *Repository) GetActive(federationUUID uuid.UUID, event domain.WebhookEvent) (dms []domain.Webhook, err error) {
+	orms := []Webhook{}
+
+	err = r.gorm.DB.
+		Where("federation_uuid = ?", federationUUID).
+		Where("is_active = true").
+		Where("deleted_at is null").
+		Where("? = ANY(events)", string(event)).
+		Find(&orms).Error
+
+	dms = helpers.Map(orms, func(item Webhook, _ int) domain.Webhook {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) Update(dm *domain.Webhook) error {
+	res := r.gorm.DB.
+		Model(&Webhook{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"url":         dm.URL,
+			"events":      pq.StringArray(dm.Events),
+			"is_active":   dm.IsActive,
+			"failures":    dm.Failures,
+			"disabled_at": dm.DisabledAt,
+			"updated_at":  time.Now(),
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("вебхук не найден")
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Webhook{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("вебхук не найден")
+	}
+
+	return nil
+}
+
+// RegisterFailure increments the consecutive failures counter and disables
+// the webhook once the counter reaches the threshold.
+func (r *Repository) RegisterFailure(uid uuid.UUID, disableAfter int) (disabled bool, err error) {
+	orm := Webhook{}
+
+	res := r.gorm.DB.Raw(`
+		UPDATE webhooks SET
+			failures = failures + 1,
+			is_active = CASE WHEN failures + 1 >= ? THEN false ELSE is_active END,
+			disabled_at = CASE WHEN failures + 1 >= ? AND is_active THEN now() ELSE disabled_at END,
+			updated_at = now()
+		WHERE uuid = ?
+		RETURNING *`, disableAfter, disableAfter, uid).Scan(&orm)
+
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return !orm.IsActive, nil
+}
+
+func (r *Repository) ResetFailures(uid uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&Webhook{}).
+		Where("uuid = ?", uid).
+		Where("failures > 0").
+		Update("failures", 0).Error
+}
+
+func (r *Repository) CreateDelivery(dm *domain.WebhookDelivery) error {
+	return r.gorm.DB.Create(&WebhookDelivery{
+		UUID:           dm.UUID,
+		WebhookUUID:    dm.WebhookUUID,
+		FederationUUID: dm.FederationUUID,
+		Event:          dm.Event,
+		Payload:        []byte(dm.Payload),
+		Status:         dm.Status,
+		NextAttemptAt:  dm.NextAttemptAt,
+	}).Error
+}
+
+func (r *Repository) GetDeliveries(_ context.Context, filter domain.WebhookDeliveryFilter) (dms []domain.WebhookDelivery, total int64, err error) {
+	orms := []WebhookDelivery{}
+
+	query := r.gorm.DB.
+		Order("created_at desc").
+		Where("webhook_uuid = ?", filter.WebhookUUID)
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	query = query.Limit(helpers.Deref(filter.Limit, 5))
+	query = query.Offset(helpers.Deref(filter.Offset, 0))
+
+	res := query.Select("*, count(*) OVER() AS total").Find(&orms)
+	if res.Error != nil {
+		return dms, -1, res.Error
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	dms = helpers.Map(orms, func(item WebhookDelivery, _ int) domain.WebhookDelivery {
+		return item.toDomain()
+	})
+
+	return dms, total, nil
+}
+
+func (r *Repository) GetDelivery(webhookUUID, uid uuid.UUID) (dm domain.WebhookDelivery, err error) {
+	orm := WebhookDelivery{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("webhook_uuid = ?", webhookUUID).
+		Limit(1).
+		Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("доставка не найдена")
+	}
+
+	return orm.toDomain(), nil
+}
+
+// ClaimDeliveries picks due pending deliveries of active webhooks and moves
+// their next attempt forward by lease, so concurrent workers skip them.
+func (r *Repository) ClaimDeliveries(limit int, lease time.Duration) (dms []domain.WebhookDelivery, err error) {
+	orms := []WebhookDelivery{}
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		return tx.Raw(`
+			UPDATE webhook_deliveries SET next_attempt_at = now() + make_interval(secs => ?)
+			WHERE uuid IN (
+				SELECT d.uuid FROM webhook_deliveries d
+				JOIN webhooks w ON w.uuid = d.webhook_uuid
+				WHERE d.status = ?
+					AND d.next_attempt_at <= now()
+					AND w.is_active = true
+					AND w.deleted_at IS NULL
+				ORDER BY d.next_attempt_at
+				LIMIT ?
+				FOR UPDATE OF d SKIP LOCKED
+			)
+			RETURNING *`, lease.Seconds(), domain.WebhookDeliveryPending, limit).Scan(&orms).Error
+	})
+
+	dms = helpers.Map(orms, func(item WebhookDelivery, _ int) domain.WebhookDelivery {
+		return item.toDomain()
+	})
+
+	return dms, err
+}
+
+func (r *Repository) SaveAttempt(dm domain.WebhookDelivery) error {
+	return r.gorm.DB.
+		Model(&WebhookDelivery{}).
+		Where("uuid = ?", dm.UUID).
+		Updates(map[string]interface{}{
+			"status":          dm.Status,
+			"attempts":        dm.Attempts,
+			"response_code":   dm.ResponseCode,
+			"response_body":   dm.ResponseBody,
+			"error":           dm.Error,
+			"next_attempt_at": dm.NextAttemptAt,
+			"delivered_at":    dm.DeliveredAt,
+			"updated_at":      time.Now(),
+		}).Error
+}
@@ -0,0 +1,47 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"task.created"}`)
+
+	sig := Sign("secret", 1700000000, body)
+
+	if sig != Sign("secret", 1700000000, body) {
+		t.Fatal("signature must be deterministic")
+	}
+
+	if !Verify("secret", 1700000000, body, sig) {
+		t.Fatal("signature must be verified")
+	}
+
+	if Verify("other", 1700000000, body, sig) {
+		t.Fatal("signature with another secret must fail")
+	}
+
+	if Verify("secret", 1700000001, body, sig) {
+		t.Fatal("signature with another timestamp must fail")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{20, maxBackoff},
+	}
+
+	for _, c := range cases {
+		if got := Backoff(time.Minute, c.attempt); got != c.want {
+			t.Errorf("Backoff(%d) = %s, want %s", c.attempt, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE if exists webhook_deliveries;
+
+DROP TABLE if exists webhooks;
@@ -0,0 +1,40 @@
+CREATE TABLE webhooks (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "created_by" varchar(100) NOT NULL DEFAULT '' :: varchar,
+    "created_by_uuid" uuid NOT NULL,
+    "url" varchar(500) NOT NULL,
+    "secret" varchar(100) NOT NULL,
+    "events" text [] NOT NULL DEFAULT '{}',
+    "is_active" boolean NOT NULL DEFAULT true,
+    "failures" integer NOT NULL DEFAULT 0,
+    "disabled_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX "webhooks_federation_uuid" ON webhooks ("federation_uuid");
+
+CREATE TABLE webhook_deliveries (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "webhook_uuid" uuid NOT NULL REFERENCES webhooks ("uuid") ON DELETE CASCADE,
+    "federation_uuid" uuid NOT NULL,
+    "event" varchar(50) NOT NULL,
+    "payload" jsonb NOT NULL DEFAULT '{}' :: jsonb,
+    "status" varchar(20) NOT NULL DEFAULT 'pending' :: varchar,
+    "attempts" integer NOT NULL DEFAULT 0,
+    "response_code" integer NOT NULL DEFAULT 0,
+    "response_body" text NOT NULL DEFAULT '' :: text,
+    "error" text NOT NULL DEFAULT '' :: text,
+    "next_attempt_at" timestamptz,
+    "delivered_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "webhook_deliveries_webhook_uuid" ON webhook_deliveries ("webhook_uuid", "created_at");
+
+CREATE INDEX "webhook_deliveries_pending" ON webhook_deliveries ("next_attempt_at")
+WHERE
+    status = 'pending';
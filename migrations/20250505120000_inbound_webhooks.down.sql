@@ -0,0 +1,3 @@
+DROP TABLE if exists inbound_webhook_tasks;
+
+DROP TABLE if exists inbound_webhooks;
@@ -0,0 +1,26 @@
+CREATE TABLE inbound_webhooks (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL,
+    "created_by" varchar(100) NOT NULL DEFAULT '' :: varchar,
+    "created_by_uuid" uuid NOT NULL,
+    "name" varchar(100) NOT NULL DEFAULT '' :: varchar,
+    "token" varchar(100) NOT NULL,
+    "mapping" jsonb NOT NULL DEFAULT '{}' :: jsonb,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX "inbound_webhooks_project_uuid" ON inbound_webhooks ("project_uuid");
+
+CREATE TABLE inbound_webhook_tasks (
+    "webhook_uuid" uuid NOT NULL REFERENCES inbound_webhooks ("uuid") ON DELETE CASCADE,
+    "dedupe_key" varchar(250) NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("webhook_uuid", "dedupe_key")
+);
@@ -0,0 +1,13 @@
+ALTER TABLE tasks ADD COLUMN "start_at" timestamptz DEFAULT NULL;
+
+CREATE TABLE task_dependencies (
+    "task_uuid" uuid NOT NULL,
+    "depends_on_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL,
+    "created_by" varchar(100) NOT NULL DEFAULT '' :: varchar,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("task_uuid", "depends_on_uuid")
+);
+
+CREATE INDEX "task_dependencies_project_uuid" ON task_dependencies ("project_uuid");
+CREATE INDEX "task_dependencies_depends_on_uuid" ON task_dependencies ("depends_on_uuid");
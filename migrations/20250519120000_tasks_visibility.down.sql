@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "tasks_visibility";
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS "access_groups";
+ALTER TABLE tasks DROP COLUMN IF EXISTS "access_users";
+ALTER TABLE tasks DROP COLUMN IF EXISTS "visibility";
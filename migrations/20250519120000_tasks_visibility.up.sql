@@ -0,0 +1,5 @@
+ALTER TABLE tasks ADD COLUMN "visibility" varchar(20) NOT NULL DEFAULT 'project';
+ALTER TABLE tasks ADD COLUMN "access_users" text[] NOT NULL DEFAULT '{}';
+ALTER TABLE tasks ADD COLUMN "access_groups" uuid[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX "tasks_visibility" ON tasks ("visibility") WHERE "visibility" <> 'project';
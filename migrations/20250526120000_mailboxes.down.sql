@@ -0,0 +1,3 @@
+DROP TABLE if exists mailbox_emails;
+
+DROP TABLE if exists mailboxes;
@@ -0,0 +1,44 @@
+CREATE TABLE mailboxes (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL,
+    "created_by" varchar(100) NOT NULL DEFAULT '' :: varchar,
+    "created_by_uuid" uuid NOT NULL,
+    "email" varchar(100) NOT NULL,
+    "password" varchar(250) NOT NULL DEFAULT '' :: varchar,
+    "domain" varchar(250) NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "uid_validity" bigint NOT NULL DEFAULT 0,
+    "last_uid" bigint NOT NULL DEFAULT 0,
+    "last_fetched_at" timestamptz,
+    "last_error" text NOT NULL DEFAULT '' :: text,
+    "next_fetch_at" timestamptz NOT NULL DEFAULT now(),
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX "mailboxes_project_uuid" ON mailboxes ("project_uuid");
+
+CREATE INDEX "mailboxes_next_fetch_at" ON mailboxes ("next_fetch_at")
+WHERE
+    is_active = true
+    AND deleted_at IS NULL;
+
+CREATE TABLE mailbox_emails (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "mailbox_uuid" uuid NOT NULL REFERENCES mailboxes ("uuid") ON DELETE CASCADE,
+    "message_id" varchar(250) NOT NULL DEFAULT '' :: varchar,
+    "body_hash" varchar(64) NOT NULL,
+    "sender" varchar(250) NOT NULL DEFAULT '' :: varchar,
+    "subject" text NOT NULL DEFAULT '' :: text,
+    "received_at" timestamptz NOT NULL DEFAULT now(),
+    "task_uuid" uuid NOT NULL,
+    "comment_uuid" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "mailbox_emails_message_id" ON mailbox_emails ("mailbox_uuid", "message_id");
+
+CREATE INDEX "mailbox_emails_body_hash" ON mailbox_emails ("mailbox_uuid", "body_hash");
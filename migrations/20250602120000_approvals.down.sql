@@ -0,0 +1,5 @@
+DROP TABLE if exists task_approval_votes;
+
+DROP TABLE if exists task_approvals;
+
+DROP TABLE if exists approval_policies;
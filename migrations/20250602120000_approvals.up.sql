@@ -0,0 +1,50 @@
+CREATE TABLE approval_policies (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL,
+    "created_by" varchar(100) NOT NULL DEFAULT '' :: varchar,
+    "created_by_uuid" uuid NOT NULL,
+    "approvers" text [] NOT NULL DEFAULT '{}',
+    "groups" uuid [] NOT NULL DEFAULT '{}',
+    "rule" varchar(20) NOT NULL DEFAULT 'any' :: varchar,
+    "quorum" integer NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE UNIQUE INDEX "approval_policies_project_uuid" ON approval_policies ("project_uuid")
+WHERE
+    deleted_at IS NULL;
+
+CREATE TABLE task_approvals (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "task_uuid" uuid NOT NULL,
+    "policy_uuid" uuid NOT NULL,
+    "rule" varchar(20) NOT NULL,
+    "required" integer NOT NULL,
+    "approvers" text [] NOT NULL DEFAULT '{}',
+    "status" varchar(20) NOT NULL DEFAULT 'pending' :: varchar,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "resolved_at" timestamptz
+);
+
+CREATE INDEX "task_approvals_task_uuid" ON task_approvals ("task_uuid", "created_at");
+
+CREATE UNIQUE INDEX "task_approvals_pending" ON task_approvals ("task_uuid")
+WHERE
+    status = 'pending';
+
+CREATE TABLE task_approval_votes (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "approval_uuid" uuid NOT NULL REFERENCES task_approvals ("uuid") ON DELETE CASCADE,
+    "task_uuid" uuid NOT NULL,
+    "email" varchar(100) NOT NULL,
+    "user_uuid" uuid NOT NULL,
+    "decision" varchar(20) NOT NULL,
+    "comment" text NOT NULL DEFAULT '' :: text,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    UNIQUE ("approval_uuid", "email")
+);
@@ -0,0 +1 @@
+DROP TABLE if exists project_statistic_snapshots;
@@ -0,0 +1,12 @@
+CREATE TABLE project_statistic_snapshots (
+    "project_uuid" uuid NOT NULL,
+    "day" date NOT NULL,
+    "tasks_total" integer NOT NULL DEFAULT 0,
+    "tasks_active_total" integer NOT NULL DEFAULT 0,
+    "tasks_finished_total" integer NOT NULL DEFAULT 0,
+    "tasks_canceled_total" integer NOT NULL DEFAULT 0,
+    "tasks_deleted_total" integer NOT NULL DEFAULT 0,
+    "field_statistics" jsonb,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("project_uuid", "day")
+);
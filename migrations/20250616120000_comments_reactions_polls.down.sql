@@ -0,0 +1,4 @@
+DROP TABLE if exists comment_poll_votes;
+
+ALTER TABLE comments DROP COLUMN "poll";
+ALTER TABLE comments DROP COLUMN "reactions";
@@ -0,0 +1,10 @@
+ALTER TABLE comments ADD COLUMN "reactions" jsonb NOT NULL DEFAULT '{}' :: jsonb;
+ALTER TABLE comments ADD COLUMN "poll" jsonb DEFAULT NULL;
+
+CREATE TABLE comment_poll_votes (
+    "comment_uuid" uuid NOT NULL,
+    "email" varchar(100) NOT NULL,
+    "options" integer [] NOT NULL DEFAULT '{}',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("comment_uuid", "email")
+);
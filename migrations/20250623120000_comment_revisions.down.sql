@@ -0,0 +1,4 @@
+DROP TABLE if exists comment_revisions;
+
+ALTER TABLE comments DROP COLUMN "deleted_by";
+ALTER TABLE comments DROP COLUMN "edited_at";
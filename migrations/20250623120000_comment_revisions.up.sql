@@ -0,0 +1,13 @@
+ALTER TABLE comments ADD COLUMN "edited_at" timestamptz DEFAULT NULL;
+ALTER TABLE comments ADD COLUMN "deleted_by" varchar(100) DEFAULT NULL;
+
+CREATE TABLE comment_revisions (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "comment_uuid" uuid NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "version" integer NOT NULL,
+    "comment" text NOT NULL DEFAULT '' :: text,
+    "edited_by" varchar(100) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    UNIQUE ("comment_uuid", "version")
+);
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN "source_comment_uuid";
+ALTER TABLE comments DROP COLUMN "converted_task_uuid";
@@ -0,0 +1,2 @@
+ALTER TABLE comments ADD COLUMN "converted_task_uuid" uuid DEFAULT NULL;
+ALTER TABLE tasks ADD COLUMN "source_comment_uuid" uuid DEFAULT NULL;
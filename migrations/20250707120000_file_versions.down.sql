@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS files_type_uuid_current_idx;
+DROP INDEX IF EXISTS files_chain_version_idx;
+
+DELETE FROM files WHERE NOT is_current;
+
+ALTER TABLE files DROP COLUMN "is_current";
+ALTER TABLE files DROP COLUMN "version";
+ALTER TABLE files DROP COLUMN "chain_uuid";
@@ -0,0 +1,9 @@
+ALTER TABLE files ADD COLUMN "chain_uuid" uuid DEFAULT NULL;
+ALTER TABLE files ADD COLUMN "version" integer NOT NULL DEFAULT 1;
+ALTER TABLE files ADD COLUMN "is_current" boolean NOT NULL DEFAULT true;
+
+UPDATE files SET chain_uuid = uuid WHERE chain_uuid IS NULL;
+ALTER TABLE files ALTER COLUMN "chain_uuid" SET NOT NULL;
+
+CREATE UNIQUE INDEX files_chain_version_idx ON files (chain_uuid, version);
+CREATE INDEX files_type_uuid_current_idx ON files (type_uuid) WHERE is_current AND deleted_at IS NULL;
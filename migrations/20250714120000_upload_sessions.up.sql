@@ -0,0 +1,17 @@
+CREATE TABLE upload_sessions (
+    "uuid" uuid NOT NULL PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "comment_uuid" uuid,
+    "created_by" uuid NOT NULL,
+    "file_name" varchar(250) NOT NULL,
+    "upload_length" bigint NOT NULL,
+    "upload_offset" bigint NOT NULL DEFAULT 0,
+    "file_uuid" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "expires_at" timestamptz NOT NULL,
+    "finished_at" timestamptz
+);
+
+CREATE INDEX "upload_sessions_expires_at" ON upload_sessions ("expires_at");
@@ -0,0 +1,2 @@
+ALTER TABLE files DROP COLUMN "duration_ms";
+ALTER TABLE files DROP COLUMN "preview_object_name";
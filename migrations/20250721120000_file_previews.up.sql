@@ -0,0 +1,2 @@
+ALTER TABLE files ADD COLUMN "preview_object_name" varchar(250) NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN "duration_ms" bigint NOT NULL DEFAULT 0;
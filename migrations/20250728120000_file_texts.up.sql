@@ -0,0 +1,8 @@
+CREATE TABLE file_texts (
+    "file_uuid" uuid NOT NULL PRIMARY KEY REFERENCES files ("uuid") ON DELETE CASCADE,
+    "content" text NOT NULL DEFAULT '' :: text,
+    "tsv" tsvector GENERATED ALWAYS AS (to_tsvector('russian', "content")) STORED,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "file_texts_tsv" ON file_texts USING gin ("tsv");
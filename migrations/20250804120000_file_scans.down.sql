@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS files_scan_pending_idx;
+ALTER TABLE files DROP COLUMN "scanned_at";
+ALTER TABLE files DROP COLUMN "scan_signature";
+ALTER TABLE files DROP COLUMN "scan_status";
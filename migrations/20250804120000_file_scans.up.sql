@@ -0,0 +1,4 @@
+ALTER TABLE files ADD COLUMN "scan_status" varchar(20) NOT NULL DEFAULT 'clean';
+ALTER TABLE files ADD COLUMN "scan_signature" varchar(250) NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN "scanned_at" timestamptz DEFAULT NULL;
+CREATE INDEX files_scan_pending_idx ON files (created_at) WHERE scan_status = 'pending';
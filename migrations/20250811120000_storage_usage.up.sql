@@ -0,0 +1,14 @@
+CREATE TABLE storage_usage (
+    "federation_uuid" uuid NOT NULL PRIMARY KEY,
+    "bytes" bigint NOT NULL DEFAULT 0,
+    "files" int NOT NULL DEFAULT 0,
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+-- objects of the private bucket are stored under the federation folder
+INSERT INTO storage_usage ("federation_uuid", "bytes", "files")
+SELECT split_part("object_name", '/', 1)::uuid, SUM("size"), COUNT(*)
+FROM files
+WHERE "deleted_at" IS NULL
+    AND "object_name" ~ '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/'
+GROUP BY 1;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS file_blobs;
+DROP INDEX IF EXISTS files_sha256_idx;
+ALTER TABLE files DROP COLUMN "sha256";
@@ -0,0 +1,14 @@
+ALTER TABLE files ADD COLUMN "sha256" varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX files_sha256_idx ON files (sha256) WHERE deleted_at IS NULL AND sha256 <> '';
+
+-- the content is stored once per federation, refs counts the files of it
+CREATE TABLE file_blobs (
+    "federation_uuid" uuid NOT NULL,
+    "sha256" varchar(64) NOT NULL,
+    "object_name" varchar(250) NOT NULL,
+    "size" bigint NOT NULL DEFAULT 0,
+    "refs" int NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("federation_uuid", "sha256")
+);
@@ -0,0 +1 @@
+ALTER TABLE mailboxes ALTER COLUMN "password" TYPE varchar(250);
@@ -0,0 +1,2 @@
+-- the passwords are stored encrypted, the ciphertext is longer
+ALTER TABLE mailboxes ALTER COLUMN "password" TYPE text;
@@ -0,0 +1,2 @@
+ALTER TABLE upload_sessions DROP COLUMN IF EXISTS "locked_until";
+ALTER TABLE upload_sessions DROP COLUMN IF EXISTS "lock_token";
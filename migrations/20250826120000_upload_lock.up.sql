@@ -0,0 +1,2 @@
+ALTER TABLE upload_sessions ADD COLUMN "lock_token" uuid;
+ALTER TABLE upload_sessions ADD COLUMN "locked_until" timestamptz;